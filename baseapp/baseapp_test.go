@@ -1263,16 +1263,99 @@ func TestP2PQuery(t *testing.T) {
 
 func TestGetMaximumBlockGas(t *testing.T) {
 	app := setupBaseApp(t)
+	ctx := app.NewContext(true, abci.Header{})
 
 	app.setConsensusParams(&abci.ConsensusParams{Block: &abci.BlockParams{MaxGas: 0}})
-	require.Equal(t, uint64(0), app.getMaximumBlockGas())
+	require.Equal(t, uint64(0), app.getMaximumBlockGas(ctx))
 
 	app.setConsensusParams(&abci.ConsensusParams{Block: &abci.BlockParams{MaxGas: -1}})
-	require.Equal(t, uint64(0), app.getMaximumBlockGas())
+	require.Equal(t, uint64(0), app.getMaximumBlockGas(ctx))
 
 	app.setConsensusParams(&abci.ConsensusParams{Block: &abci.BlockParams{MaxGas: 5000000}})
-	require.Equal(t, uint64(5000000), app.getMaximumBlockGas())
+	require.Equal(t, uint64(5000000), app.getMaximumBlockGas(ctx))
 
 	app.setConsensusParams(&abci.ConsensusParams{Block: &abci.BlockParams{MaxGas: -5000000}})
-	require.Panics(t, func() { app.getMaximumBlockGas() })
+	require.Panics(t, func() { app.getMaximumBlockGas(ctx) })
+}
+
+// paramStore is a minimal ParamStore keeping the parameters in a KVStore
+type paramStore struct {
+	cdc *codec.Codec
+	key sdk.StoreKey
+}
+
+func (ps paramStore) Get(ctx sdk.Context, key []byte, ptr interface{}) {
+	ps.cdc.MustUnmarshalJSON(ctx.KVStore(ps.key).Get(key), ptr)
+}
+
+func (ps paramStore) Has(ctx sdk.Context, key []byte) bool {
+	return ctx.KVStore(ps.key).Has(key)
+}
+
+func (ps paramStore) Set(ctx sdk.Context, key []byte, param interface{}) error {
+	ctx.KVStore(ps.key).Set(key, ps.cdc.MustMarshalJSON(param))
+	return nil
+}
+
+func TestConsensusParamsUpdate(t *testing.T) {
+	ps := paramStore{codec.New(), capKey2}
+
+	// update the block params in the end blocker when told to
+	var newBlockParams *abci.BlockParams
+	endBlockerOpt := func(bapp *BaseApp) {
+		bapp.SetParamStore(ps)
+		bapp.SetEndBlocker(func(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+			if newBlockParams != nil {
+				require.NoError(t, ps.Set(ctx, ParamStoreKeyBlockParams, *newBlockParams))
+			}
+			return abci.ResponseEndBlock{}
+		})
+	}
+
+	app := setupBaseApp(t, endBlockerOpt)
+
+	cp := &abci.ConsensusParams{
+		Block:     &abci.BlockParams{MaxBytes: 1024, MaxGas: 100},
+		Evidence:  &abci.EvidenceParams{MaxAge: 1000},
+		Validator: &abci.ValidatorParams{PubKeyTypes: []string{"ed25519"}},
+	}
+	app.InitChain(abci.RequestInitChain{ConsensusParams: cp})
+	require.True(t, cp.Equal(app.GetConsensusParams(app.deliverState.ctx)))
+
+	// unchanged params are not returned to Tendermint
+	header := abci.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	res := app.EndBlock(abci.RequestEndBlock{Height: 1})
+	require.Nil(t, res.ConsensusParamUpdates)
+	app.Commit()
+
+	// the params stored by InitChain are committed
+	require.True(t, cp.Equal(app.GetConsensusParams(app.checkState.ctx)))
+
+	// changed params are returned and used from then on
+	newBlockParams = &abci.BlockParams{MaxBytes: 2048, MaxGas: 200}
+	header = abci.Header{Height: 2}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	require.Equal(t, uint64(100), app.getMaximumBlockGas(app.deliverState.ctx))
+	res = app.EndBlock(abci.RequestEndBlock{Height: 2})
+	require.Equal(t, &abci.ConsensusParams{Block: newBlockParams}, res.ConsensusParamUpdates)
+	require.Equal(t, uint64(200), app.getMaximumBlockGas(app.deliverState.ctx))
+	app.Commit()
+
+	header = abci.Header{Height: 3}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	require.Equal(t, uint64(200), app.getMaximumBlockGas(app.deliverState.ctx))
+	res = app.EndBlock(abci.RequestEndBlock{Height: 3})
+	require.Nil(t, res.ConsensusParamUpdates)
+	app.Commit()
+
+	// the updated params are memoized from the main store on restart
+	expected := app.GetConsensusParams(app.checkState.ctx)
+	require.Equal(t, newBlockParams, expected.Block)
+	require.Equal(t, cp.Evidence, expected.Evidence)
+
+	app2 := NewBaseApp(t.Name(), defaultLogger(), app.db, nil)
+	app2.MountStores(capKey1, capKey2)
+	require.NoError(t, app2.LoadLatestVersion(capKey1))
+	require.True(t, expected.Equal(app2.consensusParams))
 }
@@ -104,6 +104,15 @@ func (app *BaseApp) SetIDPeerFilter(pf sdk.PeerFilter) {
 	app.idPeerFilter = pf
 }
 
+// SetParamStore sets a parameter store on the BaseApp, in which the consensus
+// params are stored so that they can be updated.
+func (app *BaseApp) SetParamStore(ps ParamStore) {
+	if app.sealed {
+		panic("SetParamStore() on sealed BaseApp")
+	}
+	app.paramStore = ps
+}
+
 func (app *BaseApp) SetFauxMerkleMode() {
 	if app.sealed {
 		panic("SetFauxMerkleMode() on sealed BaseApp")
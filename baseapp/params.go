@@ -0,0 +1,195 @@
+package baseapp
+
+import (
+	"errors"
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Paramspace defines the parameter subspace to be used for the paramstore.
+const Paramspace = "baseapp"
+
+// Parameter store keys for all the consensus parameter types.
+var (
+	ParamStoreKeyBlockParams     = []byte("BlockParams")
+	ParamStoreKeyEvidenceParams  = []byte("EvidenceParams")
+	ParamStoreKeyValidatorParams = []byte("ValidatorParams")
+)
+
+// ParamStore defines the interface the parameter store used by the BaseApp must
+// fulfill.
+type ParamStore interface {
+	Get(ctx sdk.Context, key []byte, ptr interface{})
+	Has(ctx sdk.Context, key []byte) bool
+	Set(ctx sdk.Context, key []byte, param interface{}) error
+}
+
+// ValidateBlockParams defines a stateless validation on BlockParams. This
+// function is called whenever the parameters are updated or stored.
+func ValidateBlockParams(i interface{}) error {
+	v, ok := i.(abci.BlockParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.MaxBytes <= 0 {
+		return fmt.Errorf("block maximum bytes must be positive: %d", v.MaxBytes)
+	}
+	if v.MaxBytes > tmtypes.MaxBlockSizeBytes {
+		return fmt.Errorf("block maximum bytes must be less than or equal to %d: %d", tmtypes.MaxBlockSizeBytes, v.MaxBytes)
+	}
+	if v.MaxGas < -1 {
+		return fmt.Errorf("block maximum gas must be greater than or equal to -1: %d", v.MaxGas)
+	}
+
+	return nil
+}
+
+// ValidateEvidenceParams defines a stateless validation on EvidenceParams. This
+// function is called whenever the parameters are updated or stored.
+func ValidateEvidenceParams(i interface{}) error {
+	v, ok := i.(abci.EvidenceParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.MaxAge <= 0 {
+		return fmt.Errorf("evidence maximum age must be positive: %d", v.MaxAge)
+	}
+
+	return nil
+}
+
+// ValidateValidatorParams defines a stateless validation on ValidatorParams.
+// This function is called whenever the parameters are updated or stored.
+func ValidateValidatorParams(i interface{}) error {
+	v, ok := i.(abci.ValidatorParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if len(v.PubKeyTypes) == 0 {
+		return errors.New("validator allowed pubkey types must not be empty")
+	}
+	for _, keyType := range v.PubKeyTypes {
+		if _, ok := tmtypes.ABCIPubKeyTypesToAminoNames[keyType]; !ok {
+			return fmt.Errorf("unknown validator pubkey type: %s", keyType)
+		}
+	}
+
+	return nil
+}
+
+// GetConsensusParams returns the current consensus parameters. When a param
+// store is set, the parameters it holds take precedence over the ones last
+// given to Tendermint, so that changes made during a block are visible right
+// away. It returns nil if no consensus parameters are known.
+func (app *BaseApp) GetConsensusParams(ctx sdk.Context) *abci.ConsensusParams {
+	if app.paramStore == nil {
+		return app.consensusParams
+	}
+
+	cp := new(abci.ConsensusParams)
+	if app.consensusParams != nil {
+		*cp = *app.consensusParams
+	}
+
+	if app.paramStore.Has(ctx, ParamStoreKeyBlockParams) {
+		var bp abci.BlockParams
+		app.paramStore.Get(ctx, ParamStoreKeyBlockParams, &bp)
+		cp.Block = &bp
+	}
+
+	if app.paramStore.Has(ctx, ParamStoreKeyEvidenceParams) {
+		var ep abci.EvidenceParams
+		app.paramStore.Get(ctx, ParamStoreKeyEvidenceParams, &ep)
+		cp.Evidence = &ep
+	}
+
+	if app.paramStore.Has(ctx, ParamStoreKeyValidatorParams) {
+		var vp abci.ValidatorParams
+		app.paramStore.Get(ctx, ParamStoreKeyValidatorParams, &vp)
+		cp.Validator = &vp
+	}
+
+	if cp.Block == nil && cp.Evidence == nil && cp.Validator == nil {
+		return nil
+	}
+
+	return cp
+}
+
+// StoreConsensusParams sets the consensus parameters to the BaseApp's param
+// store. It panics if no param store is set or if a parameter is invalid.
+func (app *BaseApp) StoreConsensusParams(ctx sdk.Context, cp *abci.ConsensusParams) {
+	if app.paramStore == nil {
+		panic("cannot store consensus params with no params store set")
+	}
+
+	if cp == nil {
+		return
+	}
+
+	if cp.Block != nil {
+		if err := app.paramStore.Set(ctx, ParamStoreKeyBlockParams, *cp.Block); err != nil {
+			panic(err)
+		}
+	}
+	if cp.Evidence != nil {
+		if err := app.paramStore.Set(ctx, ParamStoreKeyEvidenceParams, *cp.Evidence); err != nil {
+			panic(err)
+		}
+	}
+	if cp.Validator != nil {
+		if err := app.paramStore.Set(ctx, ParamStoreKeyValidatorParams, *cp.Validator); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// consensusParamUpdates returns the consensus parameters of the param store
+// that differ from the ones last given to Tendermint, or nil if there are none.
+// The updated parameters are memoized and stored to the main store, as they
+// are the ones Tendermint uses from the next block on.
+func (app *BaseApp) consensusParamUpdates(ctx sdk.Context) *abci.ConsensusParams {
+	current := app.GetConsensusParams(ctx)
+	if current == nil {
+		return nil
+	}
+
+	last := app.consensusParams
+	if last == nil {
+		last = &abci.ConsensusParams{}
+	}
+
+	var (
+		updates abci.ConsensusParams
+		updated bool
+	)
+
+	if current.Block != nil && !current.Block.Equal(last.Block) {
+		updates.Block = current.Block
+		updated = true
+	}
+	if current.Evidence != nil && !current.Evidence.Equal(last.Evidence) {
+		updates.Evidence = current.Evidence
+		updated = true
+	}
+	if current.Validator != nil && !current.Validator.Equal(last.Validator) {
+		updates.Validator = current.Validator
+		updated = true
+	}
+
+	if !updated {
+		return nil
+	}
+
+	app.setConsensusParams(current)
+	app.storeConsensusParams(current)
+
+	return &updates
+}
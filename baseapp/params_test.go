@@ -0,0 +1,65 @@
+package baseapp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestValidateBlockParams(t *testing.T) {
+	testCases := []struct {
+		arg       interface{}
+		expectErr bool
+	}{
+		{nil, true},
+		{&abci.BlockParams{}, true},
+		{abci.BlockParams{}, true},
+		{abci.BlockParams{MaxBytes: -1, MaxGas: -1}, true},
+		{abci.BlockParams{MaxBytes: 2000000, MaxGas: -5}, true},
+		{abci.BlockParams{MaxBytes: 200000000, MaxGas: -1}, true},
+		{abci.BlockParams{MaxBytes: 2000000, MaxGas: -1}, false},
+		{abci.BlockParams{MaxBytes: 2000000, MaxGas: 5000000}, false},
+	}
+
+	for i, tc := range testCases {
+		require.Equal(t, tc.expectErr, ValidateBlockParams(tc.arg) != nil, "tc #%d", i)
+	}
+}
+
+func TestValidateEvidenceParams(t *testing.T) {
+	testCases := []struct {
+		arg       interface{}
+		expectErr bool
+	}{
+		{nil, true},
+		{&abci.EvidenceParams{}, true},
+		{abci.EvidenceParams{}, true},
+		{abci.EvidenceParams{MaxAge: -1}, true},
+		{abci.EvidenceParams{MaxAge: 100000}, false},
+	}
+
+	for i, tc := range testCases {
+		require.Equal(t, tc.expectErr, ValidateEvidenceParams(tc.arg) != nil, "tc #%d", i)
+	}
+}
+
+func TestValidateValidatorParams(t *testing.T) {
+	testCases := []struct {
+		arg       interface{}
+		expectErr bool
+	}{
+		{nil, true},
+		{&abci.ValidatorParams{}, true},
+		{abci.ValidatorParams{}, true},
+		{abci.ValidatorParams{PubKeyTypes: []string{}}, true},
+		{abci.ValidatorParams{PubKeyTypes: []string{"unknown"}}, true},
+		{abci.ValidatorParams{PubKeyTypes: []string{"ed25519", "unknown"}}, true},
+		{abci.ValidatorParams{PubKeyTypes: []string{"ed25519"}}, false},
+		{abci.ValidatorParams{PubKeyTypes: []string{"ed25519", "secp256k1"}}, false},
+	}
+
+	for i, tc := range testCases {
+		require.Equal(t, tc.expectErr, ValidateValidatorParams(tc.arg) != nil, "tc #%d", i)
+	}
+}
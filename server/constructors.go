@@ -20,8 +20,15 @@ type (
 	AppCreator func(log.Logger, dbm.DB, io.Writer) abci.Application
 
 	// AppExporter is a function that dumps all app state to
-	// JSON-serializable structure and returns the current validator set.
-	AppExporter func(log.Logger, dbm.DB, io.Writer, int64, bool, []string) (json.RawMessage, []tmtypes.GenesisValidator, error)
+	// JSON-serializable structure and returns the current validator set and
+	// consensus params.
+	AppExporter func(log.Logger, dbm.DB, io.Writer, int64, bool, []string) (json.RawMessage, []tmtypes.GenesisValidator, *abci.ConsensusParams, error)
+
+	// AppInvariantsLoader is a function that loads the app state at the given
+	// height, or from the given genesis document when it is not nil, and
+	// returns a context over that state along with the app's registered
+	// invariants.
+	AppInvariantsLoader func(log.Logger, dbm.DB, io.Writer, int64, *tmtypes.GenesisDoc) (sdk.Context, []InvariantRoute, error)
 )
 
 func openDB(rootDir string) (dbm.DB, error) {
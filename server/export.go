@@ -11,6 +11,7 @@ import (
 
 	"io/ioutil"
 
+	abci "github.com/tendermint/tendermint/abci/types"
 	dbm "github.com/tendermint/tendermint/libs/db"
 	tmtypes "github.com/tendermint/tendermint/types"
 
@@ -63,7 +64,7 @@ func ExportCmd(ctx *Context, cdc *codec.Codec, appExporter AppExporter) *cobra.C
 			forZeroHeight := viper.GetBool(flagForZeroHeight)
 			jailWhiteList := viper.GetStringSlice(flagJailWhitelist)
 
-			appState, validators, err := appExporter(ctx.Logger, db, traceWriter, height, forZeroHeight, jailWhiteList)
+			appState, validators, cp, err := appExporter(ctx.Logger, db, traceWriter, height, forZeroHeight, jailWhiteList)
 			if err != nil {
 				return fmt.Errorf("error exporting state: %v", err)
 			}
@@ -75,6 +76,7 @@ func ExportCmd(ctx *Context, cdc *codec.Codec, appExporter AppExporter) *cobra.C
 
 			doc.AppState = appState
 			doc.Validators = validators
+			updateConsensusParams(doc, cp)
 
 			encoded, err := codec.MarshalJSONIndent(cdc, doc)
 			if err != nil {
@@ -92,6 +94,20 @@ func ExportCmd(ctx *Context, cdc *codec.Codec, appExporter AppExporter) *cobra.C
 	return cmd
 }
 
+// updateConsensusParams sets the exported consensus params on the genesis
+// document, keeping the ones the app does not handle
+func updateConsensusParams(doc *tmtypes.GenesisDoc, cp *abci.ConsensusParams) {
+	if cp == nil {
+		return
+	}
+
+	if doc.ConsensusParams == nil {
+		doc.ConsensusParams = tmtypes.DefaultConsensusParams()
+	}
+
+	*doc.ConsensusParams = doc.ConsensusParams.Update(cp)
+}
+
 func isEmptyState(db dbm.DB) bool {
 	if db.Stats()["leveldb.sstables"] != "" {
 		return false
@@ -0,0 +1,205 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	dbm "github.com/tendermint/tendermint/libs/db"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	flagGenesis = "genesis"
+	flagWorkers = "workers"
+)
+
+// InvariantRoute is an invariant registered by a module under a route
+type InvariantRoute struct {
+	Route     string
+	Invariant sdk.Invariant
+}
+
+// NewInvariantRoute creates a new InvariantRoute instance
+func NewInvariantRoute(route string, invariant sdk.Invariant) InvariantRoute {
+	return InvariantRoute{
+		Route:     route,
+		Invariant: invariant,
+	}
+}
+
+// InvariantResult is the outcome of checking a single invariant
+type InvariantResult struct {
+	Route    string
+	Err      error
+	Duration time.Duration
+}
+
+// Broken returns true if the invariant does not hold
+func (r InvariantResult) Broken() bool {
+	return r.Err != nil
+}
+
+// String implements the Stringer interface
+func (r InvariantResult) String() string {
+	if r.Broken() {
+		return fmt.Sprintf("FAIL %s (%s)\n%s", r.Route, r.Duration, r.Err)
+	}
+	return fmt.Sprintf("PASS %s (%s)", r.Route, r.Duration)
+}
+
+// CheckInvariantsCmd checks the app invariants against a stopped node's state
+// or a genesis file.
+func CheckInvariantsCmd(ctx *Context, appLoader AppInvariantsLoader) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-invariants",
+		Short: "Check all registered invariants against the app state",
+		Long: `Check all the invariants registered in the app against the state of a stopped
+node at a given height, or against a genesis file such as an exported state.
+The invariants are checked in parallel and the command exits with an error if
+any of them is broken.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(flags.FlagHome))
+
+			traceWriter, err := openTraceWriter(viper.GetString(flagTraceStore))
+			if err != nil {
+				return err
+			}
+
+			db, doc, err := loadInvariantsState(config.RootDir, viper.GetString(flagGenesis))
+			if err != nil {
+				return err
+			}
+
+			sdkCtx, routes, err := appLoader(ctx.Logger, db, traceWriter, viper.GetInt64(flagHeight), doc)
+			if err != nil {
+				return fmt.Errorf("error loading app state: %v", err)
+			}
+
+			results := CheckInvariants(sdkCtx, routes, viper.GetInt(flagWorkers), os.Stderr)
+
+			broken := 0
+			for _, res := range results {
+				if res.Broken() {
+					broken++
+				}
+				fmt.Println(res)
+			}
+
+			if broken > 0 {
+				return fmt.Errorf("%d of %d invariants broken at height %d", broken, len(results), sdkCtx.BlockHeight())
+			}
+
+			fmt.Printf("all %d invariants hold at height %d\n", len(results), sdkCtx.BlockHeight())
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, -1, "Check the state at a particular height (-1 means latest height)")
+	cmd.Flags().String(flagGenesis, "", "Check the state in the given genesis file instead of the node's database")
+	cmd.Flags().Int(flagWorkers, runtime.NumCPU(), "Number of invariants checked in parallel")
+	return cmd
+}
+
+// loadInvariantsState opens the node's database, or an in-memory database
+// along with the genesis document when a genesis file is given.
+func loadInvariantsState(rootDir, genesisFile string) (dbm.DB, *tmtypes.GenesisDoc, error) {
+	if genesisFile != "" {
+		doc, err := tmtypes.GenesisDocFromFile(genesisFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		return dbm.NewMemDB(), doc, nil
+	}
+
+	db, err := openDB(rootDir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if isEmptyState(db) {
+		return nil, nil, fmt.Errorf("state is not initialized, use --%s to check a genesis file", flagGenesis)
+	}
+
+	return db, nil, nil
+}
+
+// CheckInvariants checks the given invariants in parallel using up to
+// workers goroutines. Each invariant runs on its own cached view of the state,
+// so none of them can affect the others. A line is written to progress as
+// each check completes, and the results are returned in the routes order.
+func CheckInvariants(ctx sdk.Context, routes []InvariantRoute, workers int, progress io.Writer) []InvariantResult {
+	if workers < 1 {
+		workers = 1
+	}
+
+	results := make([]InvariantResult, len(routes))
+	indexes := make(chan int)
+
+	var (
+		wg   sync.WaitGroup
+		mtx  sync.Mutex
+		done int
+	)
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for index := range indexes {
+				res := checkInvariant(ctx, routes[index])
+				results[index] = res
+
+				mtx.Lock()
+				done++
+				status := "ok"
+				if res.Broken() {
+					status = "broken"
+				}
+				fmt.Fprintf(progress, "[%d/%d] %s %s (%s)\n", done, len(routes), res.Route, status, res.Duration)
+				mtx.Unlock()
+			}
+		}()
+	}
+
+	for i := range routes {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return results
+}
+
+// checkInvariant runs a single invariant, reporting a panic as a broken
+// invariant.
+func checkInvariant(ctx sdk.Context, route InvariantRoute) (res InvariantResult) {
+	res.Route = route.Route
+
+	// each invariant gets its own cache and gas meter
+	cacheCtx := ctx.
+		WithMultiStore(ctx.MultiStore().CacheMultiStore()).
+		WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	start := time.Now()
+	defer func() {
+		if r := recover(); r != nil {
+			res.Err = fmt.Errorf("invariant panicked: %v", r)
+		}
+		res.Duration = time.Since(start)
+	}()
+
+	res.Err = route.Invariant(cacheCtx)
+	return res
+}
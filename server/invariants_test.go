@@ -0,0 +1,106 @@
+package server
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestCheckInvariants(t *testing.T) {
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	ctx := sdk.NewContext(ms, abci.Header{Height: 10}, false, log.NewNopLogger())
+
+	routes := []InvariantRoute{
+		NewInvariantRoute("bank/pass", func(sdk.Context) error { return nil }),
+		NewInvariantRoute("bank/fail", func(sdk.Context) error { return errors.New("broken balance") }),
+		NewInvariantRoute("staking/panic", func(sdk.Context) error { panic("boom") }),
+		NewInvariantRoute("staking/height", func(ctx sdk.Context) error {
+			if ctx.BlockHeight() != 10 {
+				return errors.New("unexpected height")
+			}
+			return nil
+		}),
+	}
+
+	for _, workers := range []int{0, 1, 2, 8} {
+		progress := new(bytes.Buffer)
+		results := CheckInvariants(ctx, routes, workers, progress)
+		require.Len(t, results, len(routes))
+
+		for i, res := range results {
+			require.Equal(t, routes[i].Route, res.Route)
+		}
+
+		require.False(t, results[0].Broken())
+		require.True(t, results[1].Broken())
+		require.EqualError(t, results[1].Err, "broken balance")
+		require.True(t, results[2].Broken())
+		require.Contains(t, results[2].Err.Error(), "boom")
+		require.False(t, results[3].Broken())
+
+		require.True(t, strings.HasPrefix(results[0].String(), "PASS bank/pass"))
+		require.True(t, strings.HasPrefix(results[1].String(), "FAIL bank/fail"))
+
+		lines := strings.Split(strings.TrimSpace(progress.String()), "\n")
+		require.Len(t, lines, len(routes))
+		require.True(t, strings.HasPrefix(lines[len(lines)-1], "[4/4] "))
+	}
+}
+
+func TestCheckInvariantsCmd(t *testing.T) {
+	cleanup := SetupViper(t)
+	defer cleanup()
+
+	genesisFile := filepath.Join(viper.GetString(flags.FlagHome), "genesis.json")
+	doc := &tmtypes.GenesisDoc{ChainID: "test-chain", AppState: []byte("{}")}
+	require.NoError(t, doc.SaveAs(genesisFile))
+	viper.Set(flagGenesis, genesisFile)
+	defer viper.Set(flagGenesis, "")
+
+	var broken bool
+	appLoader := func(logger log.Logger, db dbm.DB, _ io.Writer, _ int64, doc *tmtypes.GenesisDoc) (sdk.Context, []InvariantRoute, error) {
+		require.NotNil(t, doc)
+		require.Equal(t, "test-chain", doc.ChainID)
+
+		ctx := sdk.NewContext(store.NewCommitMultiStore(db), abci.Header{Height: 1}, false, logger)
+		routes := []InvariantRoute{
+			NewInvariantRoute("bank/pass", func(sdk.Context) error { return nil }),
+			NewInvariantRoute("bank/toggle", func(sdk.Context) error {
+				if broken {
+					return errors.New("broken balance")
+				}
+				return nil
+			}),
+		}
+		return ctx, routes, nil
+	}
+
+	// the command is registered along with the other server commands
+	rootCmd := &cobra.Command{}
+	AddCommands(NewDefaultContext(), codec.New(), rootCmd, nil, nil, appLoader)
+	cmd, _, err := rootCmd.Find([]string{"check-invariants"})
+	require.NoError(t, err)
+	require.Equal(t, "check-invariants", cmd.Name())
+
+	require.NoError(t, cmd.RunE(cmd, nil))
+
+	// a broken invariant makes the command fail
+	broken = true
+	require.EqualError(t, cmd.RunE(cmd, nil), "1 of 2 invariants broken at height 1")
+}
@@ -120,7 +120,7 @@ func interceptLoadConfig() (conf *cfg.Config, err error) {
 func AddCommands(
 	ctx *Context, cdc *codec.Codec,
 	rootCmd *cobra.Command,
-	appCreator AppCreator, appExport AppExporter) {
+	appCreator AppCreator, appExport AppExporter, appInvariants AppInvariantsLoader) {
 
 	rootCmd.PersistentFlags().String("log_level", ctx.Config.LogLevel, "Log level")
 
@@ -142,6 +142,7 @@ func AddCommands(
 		flags.LineBreak,
 		tendermintCmd,
 		ExportCmd(ctx, cdc, appExport),
+		CheckInvariantsCmd(ctx, appInvariants),
 		flags.LineBreak,
 		version.Cmd,
 	)
@@ -19,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
 	distrclient "github.com/cosmos/cosmos-sdk/x/distribution/client"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
 	"github.com/cosmos/cosmos-sdk/x/genaccounts"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/gov"
@@ -28,6 +29,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
 )
 
 const appName = "SimApp"
@@ -50,11 +52,14 @@ var (
 		staking.AppModuleBasic{},
 		mint.AppModuleBasic{},
 		distr.AppModuleBasic{},
-		gov.NewAppModuleBasic(paramsclient.ProposalHandler, distrclient.ProposalHandler),
+		gov.NewAppModuleBasic(paramsclient.ProposalHandler, distrclient.ProposalHandler,
+			distrclient.StreamProposalHandler, distrclient.CancelStreamProposalHandler),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
 		slashing.AppModuleBasic{},
 		supply.AppModuleBasic{},
+		tokenfactory.AppModuleBasic{},
+		evidence.AppModuleBasic{},
 	)
 )
 
@@ -88,6 +93,9 @@ type SimApp struct {
 	keyParams   *sdk.KVStoreKey
 	tkeyParams  *sdk.TransientStoreKey
 
+	keyTokenFactory *sdk.KVStoreKey
+	keyEvidence     *sdk.KVStoreKey
+
 	// keepers
 	accountKeeper  auth.AccountKeeper
 	bankKeeper     bank.Keeper
@@ -100,6 +108,9 @@ type SimApp struct {
 	crisisKeeper   crisis.Keeper
 	paramsKeeper   params.Keeper
 
+	tokenFactoryKeeper tokenfactory.Keeper
+	evidenceKeeper     evidence.Keeper
+
 	// the module manager
 	mm *module.Manager
 }
@@ -108,6 +119,16 @@ type SimApp struct {
 func NewSimApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool,
 	invCheckPeriod uint, baseAppOptions ...func(*bam.BaseApp)) *SimApp {
 
+	return NewSimAppWithInflationFns(logger, db, traceStore, loadLatest, invCheckPeriod, nil, nil, baseAppOptions...)
+}
+
+// NewSimAppWithInflationFns returns a reference to an initialized SimApp whose
+// mint keeper computes the inflation with the given functions, nil functions
+// default to the bonded ratio based inflation.
+func NewSimAppWithInflationFns(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool,
+	invCheckPeriod uint, inflationCalculationFn mint.InflationCalculationFn, annualProvisionsFn mint.AnnualProvisionsFn,
+	baseAppOptions ...func(*bam.BaseApp)) *SimApp {
+
 	cdc := MakeCodec()
 
 	bApp := bam.NewBaseApp(appName, logger, db, auth.DefaultTxDecoder(cdc), baseAppOptions...)
@@ -130,6 +151,9 @@ func NewSimApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bo
 		keyGov:         sdk.NewKVStoreKey(gov.StoreKey),
 		keyParams:      sdk.NewKVStoreKey(params.StoreKey),
 		tkeyParams:     sdk.NewTransientStoreKey(params.TStoreKey),
+
+		keyTokenFactory: sdk.NewKVStoreKey(tokenfactory.StoreKey),
+		keyEvidence:     sdk.NewKVStoreKey(evidence.StoreKey),
 	}
 
 	// init params keeper and subspaces
@@ -142,11 +166,30 @@ func NewSimApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bo
 	slashingSubspace := app.paramsKeeper.Subspace(slashing.DefaultParamspace)
 	govSubspace := app.paramsKeeper.Subspace(gov.DefaultParamspace)
 	crisisSubspace := app.paramsKeeper.Subspace(crisis.DefaultParamspace)
+	tokenFactorySubspace := app.paramsKeeper.Subspace(tokenfactory.DefaultParamspace)
+	evidenceSubspace := app.paramsKeeper.Subspace(evidence.DefaultParamspace)
+
+	// set the BaseApp's parameter store, holding the consensus params
+	bApp.SetParamStore(app.paramsKeeper.Subspace(bam.Paramspace).WithKeyTable(params.ConsensusParamsKeyTable()))
 
 	// account permissions
 	basicModuleAccs := []string{auth.FeeCollectorName, distr.ModuleName}
-	minterModuleAccs := []string{mint.ModuleName}
-	burnerModuleAccs := []string{staking.BondedPoolName, staking.NotBondedPoolName, gov.ModuleName}
+	minterModuleAccs := []string{mint.ModuleName, staking.TokenizedSharesPoolName, tokenfactory.ModuleName}
+	burnerModuleAccs := []string{staking.BondedPoolName, staking.NotBondedPoolName,
+		staking.TokenizedSharesBurnerName, gov.ModuleName, tokenfactory.BurnerAccountName}
+
+	// module accounts whose balances are tracked by their modules, or that
+	// only pass coins through, can't receive minted provisions
+	mintBlockedDestinations := map[string]bool{
+		staking.BondedPoolName:            true,
+		staking.NotBondedPoolName:         true,
+		staking.TokenizedSharesPoolName:   true,
+		staking.TokenizedSharesBurnerName: true,
+		distr.ModuleName:                  true,
+		gov.ModuleName:                    true,
+		tokenfactory.ModuleName:           true,
+		tokenfactory.BurnerAccountName:    true,
+	}
 
 	// add keepers
 	app.accountKeeper = auth.NewAccountKeeper(app.cdc, app.keyAccount, authSubspace, auth.ProtoBaseAccount)
@@ -154,13 +197,17 @@ func NewSimApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bo
 	app.supplyKeeper = supply.NewKeeper(app.cdc, app.keySupply, app.accountKeeper,
 		app.bankKeeper, supply.DefaultCodespace, basicModuleAccs, minterModuleAccs, burnerModuleAccs)
 	stakingKeeper := staking.NewKeeper(app.cdc, app.keyStaking, app.tkeyStaking,
-		app.supplyKeeper, stakingSubspace, staking.DefaultCodespace)
-	app.mintKeeper = mint.NewKeeper(app.cdc, app.keyMint, mintSubspace, &stakingKeeper, app.supplyKeeper, auth.FeeCollectorName)
+		app.accountKeeper, app.supplyKeeper, stakingSubspace, staking.DefaultCodespace)
+	app.mintKeeper = mint.NewKeeper(app.cdc, app.keyMint, mintSubspace, &stakingKeeper, app.supplyKeeper,
+		auth.FeeCollectorName, mintBlockedDestinations, inflationCalculationFn, annualProvisionsFn)
 	app.distrKeeper = distr.NewKeeper(app.cdc, app.keyDistr, distrSubspace, &stakingKeeper,
-		app.supplyKeeper, distr.DefaultCodespace, auth.FeeCollectorName)
+		app.supplyKeeper, app.mintKeeper, distr.DefaultCodespace, auth.FeeCollectorName)
+	app.mintKeeper.SetCommunityPoolKeeper(app.distrKeeper)
 	app.slashingKeeper = slashing.NewKeeper(app.cdc, app.keySlashing, &stakingKeeper,
 		slashingSubspace, slashing.DefaultCodespace)
 	app.crisisKeeper = crisis.NewKeeper(crisisSubspace, invCheckPeriod, app.supplyKeeper, auth.FeeCollectorName)
+	app.tokenFactoryKeeper = tokenfactory.NewKeeper(app.cdc, app.keyTokenFactory, tokenFactorySubspace,
+		app.supplyKeeper, tokenfactory.DefaultCodespace, auth.FeeCollectorName)
 
 	// register the proposal types
 	govRouter := gov.NewRouter()
@@ -168,12 +215,19 @@ func NewSimApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bo
 		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
 		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper))
 	app.govKeeper = gov.NewKeeper(app.cdc, app.keyGov, app.paramsKeeper, govSubspace,
-		app.supplyKeeper, &stakingKeeper, gov.DefaultCodespace, govRouter)
+		app.supplyKeeper, &stakingKeeper, gov.DefaultCodespace, govRouter, app.Router(), gov.NewStakeWeightedTally())
+
+	// register the evidence types that can be submitted through messages
+	// NOTE: equivocation evidence is handled directly in BeginBlock and isn't routed,
+	// while double sign evidence is routed by the evidence keeper itself
+	evidenceRouter := evidence.NewRouter()
+	app.evidenceKeeper = evidence.NewKeeper(app.cdc, app.keyEvidence, evidenceSubspace,
+		evidence.DefaultCodespace, &stakingKeeper, app.slashingKeeper, evidenceRouter)
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	app.stakingKeeper = *stakingKeeper.SetHooks(
-		staking.NewMultiStakingHooks(app.distrKeeper.Hooks(), app.slashingKeeper.Hooks()))
+		staking.NewMultiStakingHooks(app.distrKeeper.Hooks(), app.slashingKeeper.Hooks(), app.govKeeper.Hooks()))
 
 	app.mm = module.NewManager(
 		genaccounts.NewAppModule(app.accountKeeper),
@@ -187,20 +241,27 @@ func NewSimApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bo
 		mint.NewAppModule(app.mintKeeper),
 		slashing.NewAppModule(app.slashingKeeper, app.stakingKeeper),
 		staking.NewAppModule(app.stakingKeeper, app.distrKeeper, app.accountKeeper, app.supplyKeeper),
+		tokenfactory.NewAppModule(app.tokenFactoryKeeper, app.supplyKeeper),
+		evidence.NewAppModule(app.evidenceKeeper),
+		params.NewAppModule(app.paramsKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
 	// there is nothing left over in the validator fee pool, so as to keep the
 	// CanWithdrawInvariant invariant.
-	app.mm.SetOrderBeginBlockers(mint.ModuleName, distr.ModuleName, slashing.ModuleName)
+	app.mm.SetOrderBeginBlockers(mint.ModuleName, distr.ModuleName, slashing.ModuleName,
+		evidence.ModuleName, staking.ModuleName)
 
-	app.mm.SetOrderEndBlockers(gov.ModuleName, staking.ModuleName)
+	// distribution must run before staking so that auto-compounded delegations
+	// are reflected in the validator updates of the same block
+	app.mm.SetOrderEndBlockers(gov.ModuleName, distr.ModuleName, staking.ModuleName)
 
 	// genutils must occur after staking so that pools are properly
 	// initialized with tokens from genesis accounts.
 	app.mm.SetOrderInitGenesis(genaccounts.ModuleName, supply.ModuleName, distr.ModuleName,
-		staking.ModuleName, auth.ModuleName, bank.ModuleName, slashing.ModuleName,
-		gov.ModuleName, mint.ModuleName, crisis.ModuleName, genutil.ModuleName)
+		staking.ModuleName, auth.ModuleName, bank.ModuleName, slashing.ModuleName, evidence.ModuleName,
+		gov.ModuleName, mint.ModuleName, tokenfactory.ModuleName, crisis.ModuleName, params.ModuleName,
+		genutil.ModuleName)
 
 	app.mm.RegisterInvariants(&app.crisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
@@ -208,7 +269,7 @@ func NewSimApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bo
 	// initialize stores
 	app.MountStores(app.keyMain, app.keyAccount, app.keySupply, app.keyStaking,
 		app.keyMint, app.keyDistr, app.keySlashing, app.keyGov, app.keyParams,
-		app.tkeyParams, app.tkeyStaking, app.tkeyDistr)
+		app.tkeyParams, app.tkeyStaking, app.tkeyDistr, app.keyTokenFactory, app.keyEvidence)
 
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
@@ -0,0 +1,65 @@
+package simapp
+
+import (
+	"fmt"
+	"io"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InvariantRoutes returns the invariants registered in the crisis keeper.
+func (app *SimApp) InvariantRoutes() []server.InvariantRoute {
+	var routes []server.InvariantRoute
+	for _, ir := range app.crisisKeeper.Routes() {
+		routes = append(routes, server.NewInvariantRoute(ir.FullRoute(), ir.Invar))
+	}
+	return routes
+}
+
+// LoadInvariants loads the app state at the given height, or from the given
+// genesis document when it is not nil, and returns a context over that state
+// along with the registered invariants. It implements server.AppInvariantsLoader.
+func LoadInvariants(
+	logger log.Logger, db dbm.DB, traceStore io.Writer, height int64, doc *tmtypes.GenesisDoc,
+) (ctx sdk.Context, routes []server.InvariantRoute, err error) {
+
+	if doc != nil {
+		app := NewSimApp(logger, db, traceStore, true, 0)
+
+		// a corrupted genesis state can make the modules panic on init
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("error initializing the genesis state: %v", r)
+			}
+		}()
+
+		app.InitChain(abci.RequestInitChain{
+			Time:            doc.GenesisTime,
+			ChainId:         doc.ChainID,
+			ConsensusParams: tmtypes.TM2PB.ConsensusParams(doc.ConsensusParams),
+			AppStateBytes:   doc.AppState,
+		})
+
+		header := abci.Header{ChainID: doc.ChainID, Time: doc.GenesisTime}
+		return app.NewContext(false, header), app.InvariantRoutes(), nil
+	}
+
+	var app *SimApp
+	if height != -1 {
+		app = NewSimApp(logger, db, traceStore, false, 0)
+		if err := app.LoadHeight(height); err != nil {
+			return sdk.Context{}, nil, err
+		}
+	} else {
+		app = NewSimApp(logger, db, traceStore, true, 0)
+	}
+
+	header := abci.Header{Height: app.LastBlockHeight()}
+	return app.NewContext(true, header), app.InvariantRoutes(), nil
+}
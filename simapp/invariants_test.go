@@ -0,0 +1,56 @@
+package simapp
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+func TestLoadInvariants(t *testing.T) {
+	memDB := db.NewMemDB()
+	app := NewSimApp(log.NewNopLogger(), memDB, nil, true, 0)
+
+	stateBytes, err := codec.MarshalJSONIndent(app.cdc, NewDefaultGenesisState())
+	require.NoError(t, err)
+
+	// check the invariants of a genesis file
+	doc := &tmtypes.GenesisDoc{ChainID: "test-chain", AppState: stateBytes}
+	require.NoError(t, doc.ValidateAndComplete())
+
+	ctx, routes, err := LoadInvariants(log.NewNopLogger(), db.NewMemDB(), nil, -1, doc)
+	require.NoError(t, err)
+	require.NotEmpty(t, routes)
+	require.Equal(t, "test-chain", ctx.ChainID())
+
+	for _, res := range server.CheckInvariants(ctx, routes, 4, ioutil.Discard) {
+		require.False(t, res.Broken(), res.String())
+	}
+
+	// check the invariants of a committed state
+	app.InitChain(abci.RequestInitChain{AppStateBytes: stateBytes})
+	app.Commit()
+
+	ctx, routes, err = LoadInvariants(log.NewNopLogger(), memDB, nil, 1, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), ctx.BlockHeight())
+
+	for _, res := range server.CheckInvariants(ctx, routes, 4, ioutil.Discard) {
+		require.False(t, res.Broken(), res.String())
+	}
+
+	_, _, err = LoadInvariants(log.NewNopLogger(), memDB, nil, 2, nil)
+	require.Error(t, err)
+
+	// a corrupted genesis state is reported as an error
+	doc.AppState = []byte(`{"bank": 1}`)
+	_, _, err = LoadInvariants(log.NewNopLogger(), db.NewMemDB(), nil, -1, doc)
+	require.Error(t, err)
+}
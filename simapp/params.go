@@ -10,14 +10,30 @@ const (
 	OpWeightMsgSetWithdrawAddress                      = "op_weight_msg_set_withdraw_address"
 	OpWeightMsgWithdrawDelegationReward                = "op_weight_msg_withdraw_delegation_reward"
 	OpWeightMsgWithdrawValidatorCommission             = "op_weight_msg_withdraw_validator_commission"
+	OpWeightMsgSetAutoCompound                         = "op_weight_msg_set_auto_compound"
+	OpWeightMsgFundCommunityPool                       = "op_weight_msg_fund_community_pool"
 	OpWeightSubmitVotingSlashingTextProposal           = "op_weight_submit_voting_slashing_text_proposal"
 	OpWeightSubmitVotingSlashingCommunitySpendProposal = "op_weight_submit_voting_slashing_community_spend_proposal"
 	OpWeightSubmitVotingSlashingParamChangeProposal    = "op_weight_submit_voting_slashing_param_change_proposal"
+	OpWeightSubmitVotingSlashingStreamProposal         = "op_weight_submit_voting_slashing_stream_proposal"
+	OpWeightSubmitVotingSlashingCancelStreamProposal   = "op_weight_submit_voting_slashing_cancel_stream_proposal"
+	OpWeightSubmitVotingSlashingMsgsProposal           = "op_weight_submit_voting_slashing_msgs_proposal"
 	OpWeightMsgDeposit                                 = "op_weight_msg_deposit"
+	OpWeightMsgVoteWeighted                            = "op_weight_msg_vote_weighted"
+	OpWeightMsgCancelProposal                          = "op_weight_msg_cancel_proposal"
+	OpWeightMsgDelegateVote                            = "op_weight_msg_delegate_vote"
+	OpWeightMsgUndelegateVote                          = "op_weight_msg_undelegate_vote"
 	OpWeightMsgCreateValidator                         = "op_weight_msg_create_validator"
 	OpWeightMsgEditValidator                           = "op_weight_msg_edit_validator"
 	OpWeightMsgDelegate                                = "op_weight_msg_delegate"
 	OpWeightMsgUndelegate                              = "op_weight_msg_undelegate"
 	OpWeightMsgBeginRedelegate                         = "op_weight_msg_begin_redelegate"
+	OpWeightMsgCancelUnbondingDelegation               = "op_weight_msg_cancel_unbonding_delegation"
+	OpWeightMsgTokenizeShares                          = "op_weight_msg_tokenize_shares"
+	OpWeightMsgRedeemTokensForShares                   = "op_weight_msg_redeem_tokens_for_shares"
 	OpWeightMsgUnjail                                  = "op_weight_msg_unjail"
+	OpWeightMsgCreateDenom                             = "op_weight_msg_create_denom"
+	OpWeightMsgTokenFactoryMint                        = "op_weight_msg_token_factory_mint"
+	OpWeightMsgTokenFactoryBurn                        = "op_weight_msg_token_factory_burn"
+	OpWeightMsgChangeAdmin                             = "op_weight_msg_change_admin"
 )
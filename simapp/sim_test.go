@@ -27,6 +27,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
 	distrsim "github.com/cosmos/cosmos-sdk/x/distribution/simulation"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
 	"github.com/cosmos/cosmos-sdk/x/genaccounts"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	govsim "github.com/cosmos/cosmos-sdk/x/gov/simulation"
@@ -38,6 +39,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingsim "github.com/cosmos/cosmos-sdk/x/staking/simulation"
 	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+	tokenfactorysim "github.com/cosmos/cosmos-sdk/x/tokenfactory/simulation"
 )
 
 var (
@@ -52,6 +55,8 @@ var (
 	commit      bool
 	period      int
 	onOperation bool // TODO Remove in favor of binary search for invariant violation
+
+	halvingInflation bool
 )
 
 func init() {
@@ -66,6 +71,19 @@ func init() {
 	flag.BoolVar(&commit, "SimulationCommit", false, "have the simulation commit")
 	flag.IntVar(&period, "SimulationPeriod", 1, "run slow invariants only once every period assertions")
 	flag.BoolVar(&onOperation, "SimulateEveryOperation", false, "run slow invariants every operation")
+	flag.BoolVar(&halvingInflation, "SimulationHalvingInflation", false, "mint with the halving schedule inflation functions instead of for a random half of the seeds")
+}
+
+// newSimApp returns the SimApp simulated with the given seed. Its mint module
+// follows the halving schedule if the SimulationHalvingInflation flag is set,
+// otherwise for a random half of the seeds.
+func newSimApp(logger log.Logger, db dbm.DB, seed int64, baseAppOptions ...func(*baseapp.BaseApp)) *SimApp {
+	if halvingInflation || rand.New(rand.NewSource(seed)).Intn(2) == 0 {
+		return NewSimAppWithInflationFns(logger, db, nil, true, 0,
+			mint.HalvingInflationCalculationFn, mint.HalvingAnnualProvisionsFn, baseAppOptions...)
+	}
+
+	return NewSimApp(logger, db, nil, true, 0, baseAppOptions...)
 }
 
 // helper function for populating input for SimulateFromSeed
@@ -152,6 +170,8 @@ func appStateRandomizedFn(
 	genDistrGenesisState(cdc, r, appParams, genesisState)
 	stakingGen := genStakingGenesisState(cdc, r, accs, amount, numAccs, numInitiallyBonded, appParams, genesisState)
 	genSlashingGenesisState(cdc, r, stakingGen, appParams, genesisState)
+	genEvidenceGenesisState(cdc, stakingGen, genesisState)
+	genTokenFactoryGenesisState(cdc, r, appParams, genesisState)
 
 	appState, err := MakeCodec().MarshalJSON(genesisState)
 	if err != nil {
@@ -333,20 +353,53 @@ func genGovGenesisState(cdc *codec.Codec, r *rand.Rand, ap simulation.AppParams,
 			vp = simulation.ModuleParamSimulator[simulation.VotingParamsVotingPeriod](r).(time.Duration)
 		})
 
+	// the expedited voting period must be shorter than the voting period
+	var evp time.Duration
+	ap.GetOrGenerate(cdc, simulation.VotingParamsExpeditedVotingPeriod, &evp, r,
+		func(r *rand.Rand) {
+			evp = vp / time.Duration(simulation.RandIntBetween(r, 2, 10))
+		})
+
+	var minDeposit sdk.Coins
+	ap.GetOrGenerate(cdc, simulation.DepositParamsMinDeposit, &minDeposit, r,
+		func(r *rand.Rand) {
+			minDeposit = simulation.ModuleParamSimulator[simulation.DepositParamsMinDeposit](r).(sdk.Coins)
+		})
+
+	// the expedited min deposit must be greater or equal to the min deposit
+	var expeditedMinDeposit sdk.Coins
+	ap.GetOrGenerate(cdc, simulation.DepositParamsExpeditedMinDeposit, &expeditedMinDeposit, r,
+		func(r *rand.Rand) {
+			amount := minDeposit.AmountOf(sdk.DefaultBondDenom).MulRaw(int64(simulation.RandIntBetween(r, 1, 5)))
+			expeditedMinDeposit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount))
+		})
+
+	var threshold sdk.Dec
+	ap.GetOrGenerate(cdc, simulation.TallyParamsThreshold, &threshold, r,
+		func(r *rand.Rand) {
+			threshold = simulation.ModuleParamSimulator[simulation.TallyParamsThreshold](r).(sdk.Dec)
+		})
+
+	// the expedited threshold must be greater or equal to the threshold
+	var expeditedThreshold sdk.Dec
+	ap.GetOrGenerate(cdc, simulation.TallyParamsExpeditedThreshold, &expeditedThreshold, r,
+		func(r *rand.Rand) {
+			expeditedThreshold = threshold.Add(simulation.ModuleParamSimulator[simulation.TallyParamsExpeditedThreshold](r).(sdk.Dec))
+		})
+
 	govGenesis := gov.NewGenesisState(
 		uint64(r.Intn(100)),
-		gov.NewDepositParams(
-			func(r *rand.Rand) sdk.Coins {
-				var v sdk.Coins
-				ap.GetOrGenerate(cdc, simulation.DepositParamsMinDeposit, &v, r,
+		gov.NewDepositParams(minDeposit, vp, expeditedMinDeposit,
+			func(r *rand.Rand) sdk.Dec {
+				var v sdk.Dec
+				ap.GetOrGenerate(cdc, simulation.DepositParamsProposalCancelRatio, &v, r,
 					func(r *rand.Rand) {
-						v = simulation.ModuleParamSimulator[simulation.DepositParamsMinDeposit](r).(sdk.Coins)
+						v = simulation.ModuleParamSimulator[simulation.DepositParamsProposalCancelRatio](r).(sdk.Dec)
 					})
 				return v
 			}(r),
-			vp,
 		),
-		gov.NewVotingParams(vp),
+		gov.NewVotingParams(vp, evp),
 		gov.NewTallyParams(
 			func(r *rand.Rand) sdk.Dec {
 				var v sdk.Dec
@@ -356,14 +409,7 @@ func genGovGenesisState(cdc *codec.Codec, r *rand.Rand, ap simulation.AppParams,
 					})
 				return v
 			}(r),
-			func(r *rand.Rand) sdk.Dec {
-				var v sdk.Dec
-				ap.GetOrGenerate(cdc, simulation.TallyParamsThreshold, &v, r,
-					func(r *rand.Rand) {
-						v = simulation.ModuleParamSimulator[simulation.TallyParamsThreshold](r).(sdk.Dec)
-					})
-				return v
-			}(r),
+			threshold,
 			func(r *rand.Rand) sdk.Dec {
 				var v sdk.Dec
 				ap.GetOrGenerate(cdc, simulation.TallyParamsVeto, &v, r,
@@ -372,6 +418,7 @@ func genGovGenesisState(cdc *codec.Codec, r *rand.Rand, ap simulation.AppParams,
 					})
 				return v
 			}(r),
+			expeditedThreshold,
 		),
 	)
 
@@ -426,6 +473,30 @@ func genMintGenesisState(cdc *codec.Codec, r *rand.Rand, ap simulation.AppParams
 				return v
 			}(r),
 			uint64(60*60*8766/5),
+			func(r *rand.Rand) uint64 {
+				var v uint64
+				ap.GetOrGenerate(cdc, simulation.BlocksPerHalving, &v, r,
+					func(r *rand.Rand) {
+						v = simulation.ModuleParamSimulator[simulation.BlocksPerHalving](r).(uint64)
+					})
+				return v
+			}(r),
+			func(r *rand.Rand) mint.Destinations {
+				var v sdk.Dec
+				ap.GetOrGenerate(cdc, simulation.MintFeeCollectorWeight, &v, r,
+					func(r *rand.Rand) {
+						v = simulation.ModuleParamSimulator[simulation.MintFeeCollectorWeight](r).(sdk.Dec)
+					})
+
+				// the provisions not sent to the fee collector fund the community pool
+				if v.Equal(sdk.OneDec()) {
+					return mint.Destinations{}
+				}
+				return mint.Destinations{
+					mint.NewModuleDestination(auth.FeeCollectorName, v),
+					mint.NewCommunityPoolDestination(sdk.OneDec().Sub(v)),
+				}
+			}(r),
 		),
 	)
 
@@ -460,19 +531,54 @@ func genDistrGenesisState(cdc *codec.Codec, r *rand.Rand, ap simulation.AppParam
 				})
 			return v
 		}(r),
+		AutoCompoundPeriod: func(r *rand.Rand) int64 {
+			var v int64
+			ap.GetOrGenerate(cdc, simulation.AutoCompoundPeriod, &v, r,
+				func(r *rand.Rand) {
+					v = simulation.ModuleParamSimulator[simulation.AutoCompoundPeriod](r).(int64)
+				})
+			return v
+		}(r),
+		AutoCompoundMaxPerBlock: func(r *rand.Rand) int64 {
+			var v int64
+			ap.GetOrGenerate(cdc, simulation.AutoCompoundMaxPerBlock, &v, r,
+				func(r *rand.Rand) {
+					v = simulation.ModuleParamSimulator[simulation.AutoCompoundMaxPerBlock](r).(int64)
+				})
+			return v
+		}(r),
+		NextCommunityPoolStreamID: 1,
 	}
 
 	fmt.Printf("Selected randomly generated distribution parameters:\n%s\n", codec.MustMarshalJSONIndent(cdc, distrGenesis))
 	genesisState[distr.ModuleName] = cdc.MustMarshalJSON(distrGenesis)
 }
 
+func genTokenFactoryGenesisState(cdc *codec.Codec, r *rand.Rand, ap simulation.AppParams, genesisState map[string]json.RawMessage) {
+	tokenFactoryGenesis := tokenfactory.NewGenesisState(
+		tokenfactory.NewParams(
+			func(r *rand.Rand) sdk.Coins {
+				var v sdk.Coins
+				ap.GetOrGenerate(cdc, simulation.DenomCreationFee, &v, r,
+					func(r *rand.Rand) {
+						v = simulation.ModuleParamSimulator[simulation.DenomCreationFee](r).(sdk.Coins)
+					})
+				return v
+			}(r),
+		),
+		[]tokenfactory.GenesisDenom{},
+	)
+
+	fmt.Printf("Selected randomly generated token factory parameters:\n%s\n", codec.MustMarshalJSONIndent(cdc, tokenFactoryGenesis.Params))
+	genesisState[tokenfactory.ModuleName] = cdc.MustMarshalJSON(tokenFactoryGenesis)
+}
+
 func genSlashingGenesisState(
 	cdc *codec.Codec, r *rand.Rand, stakingGen staking.GenesisState,
 	ap simulation.AppParams, genesisState map[string]json.RawMessage,
 ) {
 	slashingGenesis := slashing.NewGenesisState(
 		slashing.NewParams(
-			stakingGen.Params.UnbondingTime,
 			func(r *rand.Rand) int64 {
 				var v int64
 				ap.GetOrGenerate(cdc, simulation.SignedBlocksWindow, &v, r,
@@ -513,15 +619,52 @@ func genSlashingGenesisState(
 					})
 				return v
 			}(r),
+			func(r *rand.Rand) time.Duration {
+				var v time.Duration
+				ap.GetOrGenerate(cdc, simulation.DowntimeJailDecayPeriod, &v, r,
+					func(r *rand.Rand) {
+						v = simulation.ModuleParamSimulator[simulation.DowntimeJailDecayPeriod](r).(time.Duration)
+					})
+				return v
+			}(r),
+			func(r *rand.Rand) []sdk.Dec {
+				var v []sdk.Dec
+				ap.GetOrGenerate(cdc, simulation.SlashFractionDowntimeSchedule, &v, r,
+					func(r *rand.Rand) {
+						v = simulation.ModuleParamSimulator[simulation.SlashFractionDowntimeSchedule](r).([]sdk.Dec)
+					})
+				return v
+			}(r),
+			func(r *rand.Rand) []time.Duration {
+				var v []time.Duration
+				ap.GetOrGenerate(cdc, simulation.DowntimeJailDurationSchedule, &v, r,
+					func(r *rand.Rand) {
+						v = simulation.ModuleParamSimulator[simulation.DowntimeJailDurationSchedule](r).([]time.Duration)
+					})
+				return v
+			}(r),
 		),
 		nil,
 		nil,
+		nil,
 	)
 
 	fmt.Printf("Selected randomly generated slashing parameters:\n%s\n", codec.MustMarshalJSONIndent(cdc, slashingGenesis.Params))
 	genesisState[slashing.ModuleName] = cdc.MustMarshalJSON(slashingGenesis)
 }
 
+func genEvidenceGenesisState(
+	cdc *codec.Codec, stakingGen staking.GenesisState, genesisState map[string]json.RawMessage,
+) {
+	evidenceGenesis := evidence.NewGenesisState(
+		evidence.NewParams(stakingGen.Params.UnbondingTime),
+		[]evidence.Evidence{},
+	)
+
+	fmt.Printf("Selected randomly generated evidence parameters:\n%s\n", codec.MustMarshalJSONIndent(cdc, evidenceGenesis.Params))
+	genesisState[evidence.ModuleName] = cdc.MustMarshalJSON(evidenceGenesis)
+}
+
 func genStakingGenesisState(
 	cdc *codec.Codec, r *rand.Rand, accs []simulation.Account, amount, numAccs, numInitiallyBonded int64,
 	ap simulation.AppParams, genesisState map[string]json.RawMessage,
@@ -546,7 +689,31 @@ func genStakingGenesisState(
 				return v
 			}(r),
 			7,
+			func(r *rand.Rand) uint16 {
+				var v uint16
+				ap.GetOrGenerate(cdc, simulation.HistoricalEntries, &v, r,
+					func(r *rand.Rand) {
+						v = simulation.ModuleParamSimulator[simulation.HistoricalEntries](r).(uint16)
+					})
+				return v
+			}(r),
 			sdk.DefaultBondDenom,
+			func(r *rand.Rand) sdk.Dec {
+				var v sdk.Dec
+				ap.GetOrGenerate(cdc, simulation.MaxVotingPowerRatio, &v, r,
+					func(r *rand.Rand) {
+						v = simulation.ModuleParamSimulator[simulation.MaxVotingPowerRatio](r).(sdk.Dec)
+					})
+				return v
+			}(r),
+			func(r *rand.Rand) string {
+				var v string
+				ap.GetOrGenerate(cdc, simulation.VotingPowerCapMode, &v, r,
+					func(r *rand.Rand) {
+						v = simulation.ModuleParamSimulator[simulation.VotingPowerCapMode](r).(string)
+					})
+				return v
+			}(r),
 		),
 		nil,
 		nil,
@@ -659,6 +826,28 @@ func testAndRunTxs(app *SimApp) []simulation.WeightedOperation {
 			}(nil),
 			distrsim.SimulateMsgWithdrawValidatorCommission(app.accountKeeper, app.distrKeeper),
 		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgSetAutoCompound, &v, nil,
+					func(_ *rand.Rand) {
+						v = 50
+					})
+				return v
+			}(nil),
+			distrsim.SimulateMsgSetAutoCompound(app.accountKeeper, app.stakingKeeper, app.distrKeeper),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgFundCommunityPool, &v, nil,
+					func(_ *rand.Rand) {
+						v = 20
+					})
+				return v
+			}(nil),
+			distrsim.SimulateMsgFundCommunityPool(app.accountKeeper, app.distrKeeper),
+		},
 		{
 			func(_ *rand.Rand) int {
 				var v int
@@ -670,6 +859,17 @@ func testAndRunTxs(app *SimApp) []simulation.WeightedOperation {
 			}(nil),
 			govsim.SimulateSubmittingVotingAndSlashingForProposal(app.govKeeper, govsim.SimulateTextProposalContent),
 		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightSubmitVotingSlashingMsgsProposal, &v, nil,
+					func(_ *rand.Rand) {
+						v = 5
+					})
+				return v
+			}(nil),
+			govsim.SimulateSubmittingVotingAndSlashingForProposal(app.govKeeper, govsim.SimulateMsgsProposalContent(app.govKeeper)),
+		},
 		{
 			func(_ *rand.Rand) int {
 				var v int
@@ -681,6 +881,28 @@ func testAndRunTxs(app *SimApp) []simulation.WeightedOperation {
 			}(nil),
 			govsim.SimulateSubmittingVotingAndSlashingForProposal(app.govKeeper, distrsim.SimulateCommunityPoolSpendProposalContent(app.distrKeeper)),
 		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightSubmitVotingSlashingStreamProposal, &v, nil,
+					func(_ *rand.Rand) {
+						v = 5
+					})
+				return v
+			}(nil),
+			govsim.SimulateSubmittingVotingAndSlashingForProposal(app.govKeeper, distrsim.SimulateCommunityPoolStreamProposalContent(app.distrKeeper)),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightSubmitVotingSlashingCancelStreamProposal, &v, nil,
+					func(_ *rand.Rand) {
+						v = 2
+					})
+				return v
+			}(nil),
+			govsim.SimulateSubmittingVotingAndSlashingForProposal(app.govKeeper, distrsim.SimulateCancelCommunityPoolStreamProposalContent(app.distrKeeper)),
+		},
 		{
 			func(_ *rand.Rand) int {
 				var v int
@@ -703,6 +925,50 @@ func testAndRunTxs(app *SimApp) []simulation.WeightedOperation {
 			}(nil),
 			govsim.SimulateMsgDeposit(app.govKeeper),
 		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgVoteWeighted, &v, nil,
+					func(_ *rand.Rand) {
+						v = 50
+					})
+				return v
+			}(nil),
+			govsim.SimulateMsgVoteWeighted(app.govKeeper),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgCancelProposal, &v, nil,
+					func(_ *rand.Rand) {
+						v = 5
+					})
+				return v
+			}(nil),
+			govsim.SimulateMsgCancelProposal(app.govKeeper),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgDelegateVote, &v, nil,
+					func(_ *rand.Rand) {
+						v = 20
+					})
+				return v
+			}(nil),
+			govsim.SimulateMsgDelegateVote(app.govKeeper),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgUndelegateVote, &v, nil,
+					func(_ *rand.Rand) {
+						v = 5
+					})
+				return v
+			}(nil),
+			govsim.SimulateMsgUndelegateVote(app.govKeeper),
+		},
 		{
 			func(_ *rand.Rand) int {
 				var v int
@@ -758,6 +1024,39 @@ func testAndRunTxs(app *SimApp) []simulation.WeightedOperation {
 			}(nil),
 			stakingsim.SimulateMsgBeginRedelegate(app.accountKeeper, app.stakingKeeper),
 		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgCancelUnbondingDelegation, &v, nil,
+					func(_ *rand.Rand) {
+						v = 20
+					})
+				return v
+			}(nil),
+			stakingsim.SimulateMsgCancelUnbondingDelegation(app.accountKeeper, app.stakingKeeper),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgTokenizeShares, &v, nil,
+					func(_ *rand.Rand) {
+						v = 20
+					})
+				return v
+			}(nil),
+			stakingsim.SimulateMsgTokenizeShares(app.stakingKeeper),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgRedeemTokensForShares, &v, nil,
+					func(_ *rand.Rand) {
+						v = 20
+					})
+				return v
+			}(nil),
+			stakingsim.SimulateMsgRedeemTokensForShares(app.accountKeeper, app.stakingKeeper),
+		},
 		{
 			func(_ *rand.Rand) int {
 				var v int
@@ -769,6 +1068,50 @@ func testAndRunTxs(app *SimApp) []simulation.WeightedOperation {
 			}(nil),
 			slashingsim.SimulateMsgUnjail(app.slashingKeeper),
 		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgCreateDenom, &v, nil,
+					func(_ *rand.Rand) {
+						v = 20
+					})
+				return v
+			}(nil),
+			tokenfactorysim.SimulateMsgCreateDenom(app.tokenFactoryKeeper),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgTokenFactoryMint, &v, nil,
+					func(_ *rand.Rand) {
+						v = 50
+					})
+				return v
+			}(nil),
+			tokenfactorysim.SimulateMsgMint(app.tokenFactoryKeeper),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgTokenFactoryBurn, &v, nil,
+					func(_ *rand.Rand) {
+						v = 50
+					})
+				return v
+			}(nil),
+			tokenfactorysim.SimulateMsgBurn(app.accountKeeper, app.tokenFactoryKeeper),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgChangeAdmin, &v, nil,
+					func(_ *rand.Rand) {
+						v = 10
+					})
+				return v
+			}(nil),
+			tokenfactorysim.SimulateMsgChangeAdmin(app.tokenFactoryKeeper),
+		},
 	}
 }
 
@@ -798,7 +1141,7 @@ func BenchmarkFullAppSimulation(b *testing.B) {
 		db.Close()
 		os.RemoveAll(dir)
 	}()
-	app := NewSimApp(logger, db, nil, true, 0)
+	app := newSimApp(logger, db, seed)
 
 	// Run randomized simulation
 	// TODO parameterize numbers, save for a later PR
@@ -836,7 +1179,7 @@ func TestFullAppSimulation(t *testing.T) {
 		os.RemoveAll(dir)
 	}()
 
-	app := NewSimApp(logger, db, nil, true, 0, fauxMerkleModeOpt)
+	app := newSimApp(logger, db, seed, fauxMerkleModeOpt)
 	require.Equal(t, "SimApp", app.Name())
 
 	// Run randomized simulation
@@ -873,7 +1216,7 @@ func TestAppImportExport(t *testing.T) {
 		os.RemoveAll(dir)
 	}()
 
-	app := NewSimApp(logger, db, nil, true, 0, fauxMerkleModeOpt)
+	app := newSimApp(logger, db, seed, fauxMerkleModeOpt)
 	require.Equal(t, "SimApp", app.Name())
 
 	// Run randomized simulation
@@ -890,7 +1233,7 @@ func TestAppImportExport(t *testing.T) {
 	require.Nil(t, err)
 	fmt.Printf("Exporting genesis...\n")
 
-	appState, _, err := app.ExportAppStateAndValidators(false, []string{})
+	appState, _, _, err := app.ExportAppStateAndValidators(false, []string{})
 	require.NoError(t, err)
 	fmt.Printf("Importing genesis...\n")
 
@@ -902,7 +1245,7 @@ func TestAppImportExport(t *testing.T) {
 		os.RemoveAll(newDir)
 	}()
 
-	newApp := NewSimApp(log.NewNopLogger(), newDB, nil, true, 0, fauxMerkleModeOpt)
+	newApp := newSimApp(log.NewNopLogger(), newDB, seed, fauxMerkleModeOpt)
 	require.Equal(t, "SimApp", newApp.Name())
 
 	var genesisState GenesisState
@@ -935,6 +1278,8 @@ func TestAppImportExport(t *testing.T) {
 		{app.keySupply, newApp.keySupply, [][]byte{}},
 		{app.keyParams, newApp.keyParams, [][]byte{}},
 		{app.keyGov, newApp.keyGov, [][]byte{}},
+		{app.keyTokenFactory, newApp.keyTokenFactory, [][]byte{}},
+		{app.keyEvidence, newApp.keyEvidence, [][]byte{}},
 	}
 
 	for _, storeKeysPrefix := range storeKeysPrefixes {
@@ -970,7 +1315,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 		os.RemoveAll(dir)
 	}()
 
-	app := NewSimApp(logger, db, nil, true, 0, fauxMerkleModeOpt)
+	app := newSimApp(logger, db, seed, fauxMerkleModeOpt)
 	require.Equal(t, "SimApp", app.Name())
 
 	// Run randomized simulation
@@ -994,7 +1339,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 
 	fmt.Printf("Exporting genesis...\n")
 
-	appState, _, err := app.ExportAppStateAndValidators(true, []string{})
+	appState, _, _, err := app.ExportAppStateAndValidators(true, []string{})
 	if err != nil {
 		panic(err)
 	}
@@ -1009,7 +1354,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 		os.RemoveAll(newDir)
 	}()
 
-	newApp := NewSimApp(log.NewNopLogger(), newDB, nil, true, 0, fauxMerkleModeOpt)
+	newApp := newSimApp(log.NewNopLogger(), newDB, seed, fauxMerkleModeOpt)
 	require.Equal(t, "SimApp", newApp.Name())
 	newApp.InitChain(abci.RequestInitChain{
 		AppStateBytes: appState,
@@ -1036,7 +1381,7 @@ func TestAppStateDeterminism(t *testing.T) {
 		for j := 0; j < numTimesToRunPerSeed; j++ {
 			logger := log.NewNopLogger()
 			db := dbm.NewMemDB()
-			app := NewSimApp(logger, db, nil, true, 0)
+			app := newSimApp(logger, db, seed)
 
 			// Run randomized simulation
 			simulation.SimulateFromSeed(
@@ -1068,7 +1413,7 @@ func BenchmarkInvariants(b *testing.B) {
 		os.RemoveAll(dir)
 	}()
 
-	app := NewSimApp(logger, db, nil, true, 0)
+	app := newSimApp(logger, db, seed)
 
 	// 2. Run parameterized simulation (w/o invariants)
 	_, err := simulation.SimulateFromSeed(
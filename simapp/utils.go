@@ -18,11 +18,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
 )
 
 // NewSimAppUNSAFE is used for debugging purposes only.
@@ -60,6 +62,10 @@ func getSimulationLog(storeName string, cdcA, cdcB *codec.Codec, kvA, kvB cmn.KV
 		return decodeDistributionStore(cdcA, cdcB, kvA, kvB)
 	case supply.StoreKey:
 		return decodeSupplyStore(cdcA, cdcB, kvA, kvB)
+	case tokenfactory.StoreKey:
+		return decodeTokenFactoryStore(cdcA, cdcB, kvA, kvB)
+	case evidence.StoreKey:
+		return decodeEvidenceStore(cdcA, cdcB, kvA, kvB)
 	default:
 		return
 	}
@@ -144,6 +150,26 @@ func decodeDistributionStore(cdcA, cdcB *codec.Codec, kvA, kvB cmn.KVPair) strin
 		cdcB.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &eventB)
 		return fmt.Sprintf("%v\n%v", eventA, eventB)
 
+	case bytes.Equal(kvA.Key[:1], distribution.DelegatorAutoCompoundPrefix):
+		delA, valA := distribution.GetDelegatorAutoCompoundAddresses(kvA.Key)
+		delB, valB := distribution.GetDelegatorAutoCompoundAddresses(kvB.Key)
+		return fmt.Sprintf("%v/%v\n%v/%v", delA, valA, delB, valB)
+
+	case bytes.Equal(kvA.Key[:1], distribution.AutoCompoundCursorKey):
+		return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
+	case bytes.Equal(kvA.Key[:1], distribution.CommunityPoolStreamPrefix):
+		var streamA, streamB distribution.CommunityPoolStream
+		cdcA.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &streamA)
+		cdcB.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &streamB)
+		return fmt.Sprintf("%v\n%v", streamA, streamB)
+
+	case bytes.Equal(kvA.Key[:1], distribution.NextCommunityPoolStreamIDKey):
+		var idA, idB uint64
+		cdcA.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &idA)
+		cdcB.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &idB)
+		return fmt.Sprintf("%d\n%d", idA, idB)
+
 	default:
 		panic(fmt.Sprintf("invalid distribution key prefix %X", kvA.Key[:1]))
 	}
@@ -194,6 +220,18 @@ func decodeStakingStore(cdcA, cdcB *codec.Codec, kvA, kvB cmn.KVPair) string {
 		cdcB.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &redB)
 		return fmt.Sprintf("%v\n%v", redA, redB)
 
+	case bytes.Equal(kvA.Key[:1], staking.TokenizedSharesKey):
+		var recordA, recordB staking.TokenizedShares
+		cdcA.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &recordA)
+		cdcB.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &recordB)
+		return fmt.Sprintf("%v\n%v", recordA, recordB)
+
+	case bytes.Equal(kvA.Key[:1], staking.HistoricalInfoKey):
+		var histInfoA, histInfoB staking.HistoricalInfo
+		cdcA.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &histInfoA)
+		cdcB.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &histInfoB)
+		return fmt.Sprintf("%v\n%v", histInfoA, histInfoB)
+
 	default:
 		panic(fmt.Sprintf("invalid staking key prefix %X", kvA.Key[:1]))
 	}
@@ -221,6 +259,12 @@ func decodeSlashingStore(cdcA, cdcB *codec.Codec, kvA, kvB cmn.KVPair) string {
 		bechPKB := sdk.MustBech32ifyAccPub(pubKeyB)
 		return fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", bechPKA, bechPKB)
 
+	case bytes.Equal(kvA.Key[:1], slashing.ValidatorDowntimeOffenceKey):
+		var offenceA, offenceB slashing.DowntimeOffence
+		cdcA.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &offenceA)
+		cdcB.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &offenceB)
+		return fmt.Sprintf("%v\n%v", offenceA, offenceB)
+
 	default:
 		panic(fmt.Sprintf("invalid slashing key prefix %X", kvA.Key[:1]))
 	}
@@ -253,6 +297,28 @@ func decodeGovStore(cdcA, cdcB *codec.Codec, kvA, kvB cmn.KVPair) string {
 		cdcB.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &voteB)
 		return fmt.Sprintf("%v\n%v", voteA, voteB)
 
+	case bytes.Equal(kvA.Key[:1], gov.VotePruningQueuePrefix):
+		proposalIDA := gov.SplitVotePruningQueueKey(kvA.Key)
+		proposalIDB := gov.SplitVotePruningQueueKey(kvB.Key)
+		return fmt.Sprintf("proposalIDA: %d\nProposalIDB: %d", proposalIDA, proposalIDB)
+
+	case bytes.Equal(kvA.Key[:1], gov.VoteDelegationsKeyPrefix):
+		var voteDelegationA, voteDelegationB gov.VoteDelegation
+		cdcA.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &voteDelegationA)
+		cdcB.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &voteDelegationB)
+		return fmt.Sprintf("%v\n%v", voteDelegationA, voteDelegationB)
+
+	case bytes.Equal(kvA.Key[:1], gov.VoteDelegatorsKeyPrefix):
+		delegatorA, proposalTypeA := gov.SplitKeyVoteDelegator(kvA.Key)
+		delegatorB, proposalTypeB := gov.SplitKeyVoteDelegator(kvB.Key)
+		return fmt.Sprintf("%s %q\n%s %q", delegatorA, proposalTypeA, delegatorB, proposalTypeB)
+
+	case bytes.Equal(kvA.Key[:1], gov.ValidatorVoteSharesKeyPrefix):
+		var voteSharesA, voteSharesB gov.ValidatorVoteShares
+		cdcA.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &voteSharesA)
+		cdcB.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &voteSharesB)
+		return fmt.Sprintf("%v\n%v", voteSharesA, voteSharesB)
+
 	default:
 		panic(fmt.Sprintf("invalid governance key prefix %X", kvA.Key[:1]))
 	}
@@ -269,3 +335,32 @@ func decodeSupplyStore(cdcA, cdcB *codec.Codec, kvA, kvB cmn.KVPair) string {
 		panic(fmt.Sprintf("invalid supply key %X", kvA.Key))
 	}
 }
+
+func decodeTokenFactoryStore(cdcA, cdcB *codec.Codec, kvA, kvB cmn.KVPair) string {
+	switch {
+	case bytes.Equal(kvA.Key[:1], tokenfactory.DenomAuthorityMetadataKeyPrefix):
+		var metadataA, metadataB tokenfactory.DenomAuthorityMetadata
+		cdcA.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &metadataA)
+		cdcB.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &metadataB)
+		return fmt.Sprintf("%v\n%v", metadataA, metadataB)
+
+	case bytes.Equal(kvA.Key[:1], tokenfactory.CreatorDenomsKeyPrefix):
+		return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+	default:
+		panic(fmt.Sprintf("invalid token factory key prefix %X", kvA.Key[:1]))
+	}
+}
+
+func decodeEvidenceStore(cdcA, cdcB *codec.Codec, kvA, kvB cmn.KVPair) string {
+	switch {
+	case bytes.Equal(kvA.Key[:1], evidence.KeyPrefixEvidence):
+		var evidenceA, evidenceB evidence.Evidence
+		cdcA.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &evidenceA)
+		cdcB.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &evidenceB)
+		return fmt.Sprintf("%v\n%v", evidenceA, evidenceB)
+
+	default:
+		panic(fmt.Sprintf("invalid evidence key prefix %X", kvA.Key[:1]))
+	}
+}
@@ -136,9 +136,10 @@ func TestDecodeDistributionStore(t *testing.T) {
 	info := distr.NewDelegatorStartingInfo(2, sdk.OneDec(), 200)
 	outstanding := distr.ValidatorOutstandingRewards{decCoins[0]}
 	commission := distr.ValidatorAccumulatedCommission{decCoins[0]}
-	historicalRewards := distr.NewValidatorHistoricalRewards(decCoins, 100)
+	historicalRewards := distr.NewValidatorHistoricalRewards(decCoins, 100, 10)
 	currentRewards := distr.NewValidatorCurrentRewards(decCoins, 5)
 	slashEvent := distr.NewValidatorSlashEvent(10, sdk.OneDec())
+	stream := distr.NewCommunityPoolStream(1, delAddr1, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))), 10, 20)
 
 	kvPairs := cmn.KVPairs{
 		cmn.KVPair{Key: distr.FeePoolKey, Value: cdc.MustMarshalBinaryLengthPrefixed(feePool)},
@@ -149,7 +150,11 @@ func TestDecodeDistributionStore(t *testing.T) {
 		cmn.KVPair{Key: distr.GetValidatorHistoricalRewardsKey(valAddr1, 100), Value: cdc.MustMarshalBinaryLengthPrefixed(historicalRewards)},
 		cmn.KVPair{Key: distr.GetValidatorCurrentRewardsKey(valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(currentRewards)},
 		cmn.KVPair{Key: distr.GetValidatorAccumulatedCommissionKey(valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(commission)},
-		cmn.KVPair{Key: distr.GetValidatorSlashEventKey(valAddr1, 13), Value: cdc.MustMarshalBinaryLengthPrefixed(slashEvent)},
+		cmn.KVPair{Key: distr.GetValidatorSlashEventKey(valAddr1, 13, 0), Value: cdc.MustMarshalBinaryLengthPrefixed(slashEvent)},
+		cmn.KVPair{Key: distr.GetDelegatorAutoCompoundKey(delAddr1, valAddr1), Value: []byte{0x01}},
+		cmn.KVPair{Key: distr.AutoCompoundCursorKey, Value: distr.GetDelegatorAutoCompoundKey(delAddr1, valAddr1)},
+		cmn.KVPair{Key: distr.GetCommunityPoolStreamKey(1), Value: cdc.MustMarshalBinaryLengthPrefixed(stream)},
+		cmn.KVPair{Key: distr.NextCommunityPoolStreamIDKey, Value: cdc.MustMarshalBinaryLengthPrefixed(uint64(2))},
 		cmn.KVPair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -166,6 +171,10 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"ValidatorCurrentRewards", fmt.Sprintf("%v\n%v", currentRewards, currentRewards)},
 		{"ValidatorAccumulatedCommission", fmt.Sprintf("%v\n%v", commission, commission)},
 		{"ValidatorSlashEvent", fmt.Sprintf("%v\n%v", slashEvent, slashEvent)},
+		{"DelegatorAutoCompound", fmt.Sprintf("%v/%v\n%v/%v", delAddr1, valAddr1, delAddr1, valAddr1)},
+		{"AutoCompoundCursor", fmt.Sprintf("%X\n%X", distr.GetDelegatorAutoCompoundKey(delAddr1, valAddr1), distr.GetDelegatorAutoCompoundKey(delAddr1, valAddr1))},
+		{"CommunityPoolStream", fmt.Sprintf("%v\n%v", stream, stream)},
+		{"NextCommunityPoolStreamID", "2\n2"},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -230,11 +239,13 @@ func TestDecodeSlashingStore(t *testing.T) {
 	info := slashing.NewValidatorSigningInfo(consAddr1, 0, 1, time.Now().UTC(), false, 0)
 	bechPK := sdk.MustBech32ifyAccPub(delPk1)
 	missed := true
+	offence := slashing.NewDowntimeOffence(10, time.Now().UTC(), 1, sdk.NewDecWithPrec(1, 2), time.Hour)
 
 	kvPairs := cmn.KVPairs{
 		cmn.KVPair{Key: slashing.GetValidatorSigningInfoKey(consAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(info)},
 		cmn.KVPair{Key: slashing.GetValidatorMissedBlockBitArrayKey(consAddr1, 6), Value: cdc.MustMarshalBinaryLengthPrefixed(missed)},
 		cmn.KVPair{Key: slashing.GetAddrPubkeyRelationKey(delAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(delPk1)},
+		cmn.KVPair{Key: slashing.GetValidatorDowntimeOffenceKey(consAddr1, 10), Value: cdc.MustMarshalBinaryLengthPrefixed(offence)},
 		cmn.KVPair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -245,6 +256,7 @@ func TestDecodeSlashingStore(t *testing.T) {
 		{"ValidatorSigningInfo", fmt.Sprintf("%v\n%v", info, info)},
 		{"ValidatorMissedBlockBitArray", fmt.Sprintf("missedA: %v\nmissedB: %v", missed, missed)},
 		{"AddrPubkeyRelation", fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", bechPK, bechPK)},
+		{"DowntimeOffence", fmt.Sprintf("%v\n%v", offence, offence)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -270,12 +282,18 @@ func TestDecodeGovStore(t *testing.T) {
 	binary.LittleEndian.PutUint64(proposalIDBz, 1)
 	deposit := gov.NewDeposit(1, delAddr1, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())))
 	vote := gov.NewVote(1, delAddr1, gov.OptionYes)
+	voteDelegation := gov.NewVoteDelegation(delAddr1, sdk.AccAddress(valAddr1), "")
+	voteShares := gov.NewValidatorVoteShares(1, valAddr1).AddVote(sdk.OneDec(), gov.NewNonSplitVoteOption(gov.OptionYes))
 
 	kvPairs := cmn.KVPairs{
 		cmn.KVPair{Key: gov.ProposalKey(1), Value: cdc.MustMarshalBinaryLengthPrefixed(proposal)},
 		cmn.KVPair{Key: gov.InactiveProposalQueueKey(1, endTime), Value: proposalIDBz},
 		cmn.KVPair{Key: gov.DepositKey(1, delAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(deposit)},
 		cmn.KVPair{Key: gov.VoteKey(1, delAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(vote)},
+		cmn.KVPair{Key: gov.VotePruningQueueKey(1), Value: []byte{}},
+		cmn.KVPair{Key: gov.VoteDelegationKey(delAddr1, ""), Value: cdc.MustMarshalBinaryLengthPrefixed(voteDelegation)},
+		cmn.KVPair{Key: gov.VoteDelegatorKey(sdk.AccAddress(valAddr1), delAddr1, ""), Value: []byte{}},
+		cmn.KVPair{Key: gov.ValidatorVoteShareKey(1, valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(voteShares)},
 		cmn.KVPair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -287,6 +305,10 @@ func TestDecodeGovStore(t *testing.T) {
 		{"proposal IDs", "proposalIDA: 1\nProposalIDB: 1"},
 		{"deposits", fmt.Sprintf("%v\n%v", deposit, deposit)},
 		{"votes", fmt.Sprintf("%v\n%v", vote, vote)},
+		{"vote pruning queue", "proposalIDA: 1\nProposalIDB: 1"},
+		{"vote delegations", fmt.Sprintf("%v\n%v", voteDelegation, voteDelegation)},
+		{"vote delegators", fmt.Sprintf("%s %q\n%s %q", delAddr1, "", delAddr1, "")},
+		{"validator vote shares", fmt.Sprintf("%v\n%v", voteShares, voteShares)},
 		{"other", ""},
 	}
 
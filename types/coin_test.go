@@ -61,6 +61,9 @@ func TestCoinIsValid(t *testing.T) {
 		{Coin{"a very long coin denom", NewInt(1)}, false},
 		{Coin{"atOm", NewInt(1)}, false},
 		{Coin{"     ", NewInt(1)}, false},
+		{Coin{"factory/cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du/atom", NewInt(1)}, true},
+		{Coin{"factory/Atom", NewInt(1)}, false},
+		{Coin{"/factory", NewInt(1)}, false},
 	}
 
 	for i, tc := range cases {
@@ -403,6 +406,7 @@ func TestParse(t *testing.T) {
 		{"11me coin, 12you coin", false, nil}, // no spaces in coin names
 		{"1.2btc", false, nil},                // amount must be integer
 		{"5foo-bar", false, nil},              // once more, only letters in coin name
+		{"10factory/abc/def", true, Coins{{"factory/abc/def", NewInt(10)}}},
 	}
 
 	for tcIndex, tc := range cases {
@@ -1,9 +1,5 @@
 package types
 
-import (
-	"fmt"
-)
-
 // GenesisState - all auth state that must be provided at genesis
 type GenesisState struct {
 	Params Params `json:"params"`
@@ -22,20 +18,5 @@ func DefaultGenesisState() GenesisState {
 // ValidateGenesis performs basic validation of auth genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
-	if data.Params.TxSigLimit == 0 {
-		return fmt.Errorf("invalid tx signature limit: %d", data.Params.TxSigLimit)
-	}
-	if data.Params.SigVerifyCostED25519 == 0 {
-		return fmt.Errorf("invalid ED25519 signature verification cost: %d", data.Params.SigVerifyCostED25519)
-	}
-	if data.Params.SigVerifyCostSecp256k1 == 0 {
-		return fmt.Errorf("invalid SECK256k1 signature verification cost: %d", data.Params.SigVerifyCostSecp256k1)
-	}
-	if data.Params.MaxMemoCharacters == 0 {
-		return fmt.Errorf("invalid max memo characters: %d", data.Params.MaxMemoCharacters)
-	}
-	if data.Params.TxSizeCostPerByte == 0 {
-		return fmt.Errorf("invalid tx size cost per byte: %d", data.Params.TxSizeCostPerByte)
-	}
-	return nil
+	return data.Params.Validate()
 }
@@ -236,7 +236,9 @@ func (keeper BaseSendKeeper) GetSendEnabled(ctx sdk.Context) bool {
 
 // SetSendEnabled sets the send enabled
 func (keeper BaseSendKeeper) SetSendEnabled(ctx sdk.Context, enabled bool) {
-	keeper.paramSpace.Set(ctx, types.ParamStoreKeySendEnabled, &enabled)
+	if err := keeper.paramSpace.Set(ctx, types.ParamStoreKeySendEnabled, &enabled); err != nil {
+		panic(err)
+	}
 }
 
 var _ ViewKeeper = (*BaseViewKeeper)(nil)
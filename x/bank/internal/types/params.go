@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
@@ -17,6 +19,13 @@ var ParamStoreKeySendEnabled = []byte("sendenabled")
 // ParamKeyTable type declaration for parameters
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable(
-		ParamStoreKeySendEnabled, false,
+		params.NewParamSetPair(ParamStoreKeySendEnabled, false, validateSendEnabled),
 	)
 }
+
+func validateSendEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
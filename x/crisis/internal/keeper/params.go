@@ -13,5 +13,7 @@ func (k Keeper) GetConstantFee(ctx sdk.Context) (constantFee sdk.Coin) {
 
 // GetConstantFee set's the constant fee in the paramSpace
 func (k Keeper) SetConstantFee(ctx sdk.Context, constantFee sdk.Coin) {
-	k.paramSpace.Set(ctx, types.ParamStoreKeyConstantFee, constantFee)
+	if err := k.paramSpace.Set(ctx, types.ParamStoreKeyConstantFee, constantFee); err != nil {
+		panic(err)
+	}
 }
@@ -7,8 +7,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 )
 
-// set the proposer for determining distribution during endblock
-// and distribute rewards for the previous block
+// set the proposer for determining distribution during endblock,
+// distribute rewards for the previous block and pay out the community pool streams
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper) {
 	// determine the total power signing the block
 	var previousTotalPower, sumPreviousPrecommitPower int64
@@ -29,4 +29,12 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 	// record the proposer for when we payout on the next block
 	consAddr := sdk.ConsAddress(req.Header.ProposerAddress)
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
+
+	// pay out the community pool streams
+	k.ProcessCommunityPoolStreams(ctx)
+}
+
+// re-delegate the rewards of the delegations opted into auto-compounding
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.ProcessAutoCompounding(ctx)
 }
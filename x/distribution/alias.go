@@ -12,31 +12,43 @@ import (
 )
 
 const (
-	DefaultParamspace                = keeper.DefaultParamspace
-	DefaultCodespace                 = types.DefaultCodespace
-	CodeInvalidInput                 = types.CodeInvalidInput
-	CodeNoDistributionInfo           = types.CodeNoDistributionInfo
-	CodeNoValidatorCommission        = types.CodeNoValidatorCommission
-	CodeSetWithdrawAddrDisabled      = types.CodeSetWithdrawAddrDisabled
-	ModuleName                       = types.ModuleName
-	StoreKey                         = types.StoreKey
-	TStoreKey                        = types.TStoreKey
-	RouterKey                        = types.RouterKey
-	QuerierRoute                     = types.QuerierRoute
-	ProposalTypeCommunityPoolSpend   = types.ProposalTypeCommunityPoolSpend
-	QueryParams                      = types.QueryParams
-	QueryValidatorOutstandingRewards = types.QueryValidatorOutstandingRewards
-	QueryValidatorCommission         = types.QueryValidatorCommission
-	QueryValidatorSlashes            = types.QueryValidatorSlashes
-	QueryDelegationRewards           = types.QueryDelegationRewards
-	QueryDelegatorTotalRewards       = types.QueryDelegatorTotalRewards
-	QueryDelegatorValidators         = types.QueryDelegatorValidators
-	QueryWithdrawAddr                = types.QueryWithdrawAddr
-	QueryCommunityPool               = types.QueryCommunityPool
-	ParamCommunityTax                = types.ParamCommunityTax
-	ParamBaseProposerReward          = types.ParamBaseProposerReward
-	ParamBonusProposerReward         = types.ParamBonusProposerReward
-	ParamWithdrawAddrEnabled         = types.ParamWithdrawAddrEnabled
+	DefaultParamspace                     = keeper.DefaultParamspace
+	DefaultCodespace                      = types.DefaultCodespace
+	CodeInvalidInput                      = types.CodeInvalidInput
+	CodeNoDistributionInfo                = types.CodeNoDistributionInfo
+	CodeNoValidatorCommission             = types.CodeNoValidatorCommission
+	CodeSetWithdrawAddrDisabled           = types.CodeSetWithdrawAddrDisabled
+	CodeNoDelegation                      = types.CodeNoDelegation
+	CodeUnknownStream                     = types.CodeUnknownStream
+	ModuleName                            = types.ModuleName
+	StoreKey                              = types.StoreKey
+	TStoreKey                             = types.TStoreKey
+	RouterKey                             = types.RouterKey
+	QuerierRoute                          = types.QuerierRoute
+	ProposalTypeCommunityPoolSpend        = types.ProposalTypeCommunityPoolSpend
+	ProposalTypeCommunityPoolStream       = types.ProposalTypeCommunityPoolStream
+	ProposalTypeCancelCommunityPoolStream = types.ProposalTypeCancelCommunityPoolStream
+	QueryParams                           = types.QueryParams
+	QueryValidatorOutstandingRewards      = types.QueryValidatorOutstandingRewards
+	QueryValidatorCommission              = types.QueryValidatorCommission
+	QueryValidatorSlashes                 = types.QueryValidatorSlashes
+	QueryDelegationRewards                = types.QueryDelegationRewards
+	QueryDelegatorTotalRewards            = types.QueryDelegatorTotalRewards
+	QueryDelegatorValidators              = types.QueryDelegatorValidators
+	QueryWithdrawAddr                     = types.QueryWithdrawAddr
+	QueryCommunityPool                    = types.QueryCommunityPool
+	QueryDelegatorAutoCompound            = types.QueryDelegatorAutoCompound
+	QueryCommunityPoolStreams             = types.QueryCommunityPoolStreams
+	QueryCommunityPoolStream              = types.QueryCommunityPoolStream
+	QueryValidatorRewardRate              = types.QueryValidatorRewardRate
+	QueryValidatorAPR                     = types.QueryValidatorAPR
+	QueryDelegatorAccruedRewards          = types.QueryDelegatorAccruedRewards
+	ParamCommunityTax                     = types.ParamCommunityTax
+	ParamBaseProposerReward               = types.ParamBaseProposerReward
+	ParamBonusProposerReward              = types.ParamBonusProposerReward
+	ParamWithdrawAddrEnabled              = types.ParamWithdrawAddrEnabled
+	ParamAutoCompoundPeriod               = types.ParamAutoCompoundPeriod
+	ParamAutoCompoundMaxPerBlock          = types.ParamAutoCompoundMaxPerBlock
 )
 
 var (
@@ -55,6 +67,8 @@ var (
 	GetValidatorCurrentRewardsAddress          = keeper.GetValidatorCurrentRewardsAddress
 	GetValidatorAccumulatedCommissionAddress   = keeper.GetValidatorAccumulatedCommissionAddress
 	GetValidatorSlashEventAddressHeight        = keeper.GetValidatorSlashEventAddressHeight
+	GetDelegatorAutoCompoundAddresses          = keeper.GetDelegatorAutoCompoundAddresses
+	GetCommunityPoolStreamID                   = keeper.GetCommunityPoolStreamID
 	GetValidatorOutstandingRewardsKey          = keeper.GetValidatorOutstandingRewardsKey
 	GetDelegatorWithdrawAddrKey                = keeper.GetDelegatorWithdrawAddrKey
 	GetDelegatorStartingInfoKey                = keeper.GetDelegatorStartingInfoKey
@@ -64,8 +78,13 @@ var (
 	GetValidatorAccumulatedCommissionKey       = keeper.GetValidatorAccumulatedCommissionKey
 	GetValidatorSlashEventPrefix               = keeper.GetValidatorSlashEventPrefix
 	GetValidatorSlashEventKey                  = keeper.GetValidatorSlashEventKey
+	GetDelegatorAutoCompoundPrefix             = keeper.GetDelegatorAutoCompoundPrefix
+	GetDelegatorAutoCompoundKey                = keeper.GetDelegatorAutoCompoundKey
+	GetCommunityPoolStreamKey                  = keeper.GetCommunityPoolStreamKey
 	ParamKeyTable                              = keeper.ParamKeyTable
 	HandleCommunityPoolSpendProposal           = keeper.HandleCommunityPoolSpendProposal
+	HandleCommunityPoolStreamProposal          = keeper.HandleCommunityPoolStreamProposal
+	HandleCancelCommunityPoolStreamProposal    = keeper.HandleCancelCommunityPoolStreamProposal
 	NewQuerier                                 = keeper.NewQuerier
 	MakeTestCodec                              = keeper.MakeTestCodec
 	CreateTestInputDefault                     = keeper.CreateTestInputDefault
@@ -82,6 +101,10 @@ var (
 	ErrBadDistribution                         = types.ErrBadDistribution
 	ErrInvalidProposalAmount                   = types.ErrInvalidProposalAmount
 	ErrEmptyProposalRecipient                  = types.ErrEmptyProposalRecipient
+	ErrNoDelegation                            = types.ErrNoDelegation
+	ErrInvalidStreamHeights                    = types.ErrInvalidStreamHeights
+	ErrStreamEnded                             = types.ErrStreamEnded
+	ErrUnknownStream                           = types.ErrUnknownStream
 	InitialFeePool                             = types.InitialFeePool
 	NewGenesisState                            = types.NewGenesisState
 	DefaultGenesisState                        = types.DefaultGenesisState
@@ -89,19 +112,30 @@ var (
 	NewMsgSetWithdrawAddress                   = types.NewMsgSetWithdrawAddress
 	NewMsgWithdrawDelegatorReward              = types.NewMsgWithdrawDelegatorReward
 	NewMsgWithdrawValidatorCommission          = types.NewMsgWithdrawValidatorCommission
+	NewMsgSetAutoCompound                      = types.NewMsgSetAutoCompound
+	NewMsgFundCommunityPool                    = types.NewMsgFundCommunityPool
 	NewCommunityPoolSpendProposal              = types.NewCommunityPoolSpendProposal
+	NewCommunityPoolStreamProposal             = types.NewCommunityPoolStreamProposal
+	NewCancelCommunityPoolStreamProposal       = types.NewCancelCommunityPoolStreamProposal
 	NewQueryValidatorOutstandingRewardsParams  = types.NewQueryValidatorOutstandingRewardsParams
 	NewQueryValidatorCommissionParams          = types.NewQueryValidatorCommissionParams
 	NewQueryValidatorSlashesParams             = types.NewQueryValidatorSlashesParams
 	NewQueryDelegationRewardsParams            = types.NewQueryDelegationRewardsParams
 	NewQueryDelegatorParams                    = types.NewQueryDelegatorParams
 	NewQueryDelegatorWithdrawAddrParams        = types.NewQueryDelegatorWithdrawAddrParams
+	NewQueryCommunityPoolStreamParams          = types.NewQueryCommunityPoolStreamParams
 	NewQueryDelegatorTotalRewardsResponse      = types.NewQueryDelegatorTotalRewardsResponse
 	NewDelegationDelegatorReward               = types.NewDelegationDelegatorReward
+	NewQueryValidatorParams                    = types.NewQueryValidatorParams
+	NewQueryValidatorRewardRateParams          = types.NewQueryValidatorRewardRateParams
+	NewQueryDelegatorAccruedRewardsResponse    = types.NewQueryDelegatorAccruedRewardsResponse
+	NewDelegationAccruedReward                 = types.NewDelegationAccruedReward
+	NewValidatorRewardRate                     = types.NewValidatorRewardRate
 	NewValidatorHistoricalRewards              = types.NewValidatorHistoricalRewards
 	NewValidatorCurrentRewards                 = types.NewValidatorCurrentRewards
 	InitialValidatorAccumulatedCommission      = types.InitialValidatorAccumulatedCommission
 	NewValidatorSlashEvent                     = types.NewValidatorSlashEvent
+	NewCommunityPoolStream                     = types.NewCommunityPoolStream
 
 	// variable aliases
 	FeePoolKey                           = keeper.FeePoolKey
@@ -113,15 +147,35 @@ var (
 	ValidatorCurrentRewardsPrefix        = keeper.ValidatorCurrentRewardsPrefix
 	ValidatorAccumulatedCommissionPrefix = keeper.ValidatorAccumulatedCommissionPrefix
 	ValidatorSlashEventPrefix            = keeper.ValidatorSlashEventPrefix
+	DelegatorAutoCompoundPrefix          = keeper.DelegatorAutoCompoundPrefix
+	AutoCompoundCursorKey                = keeper.AutoCompoundCursorKey
+	CommunityPoolStreamPrefix            = keeper.CommunityPoolStreamPrefix
+	NextCommunityPoolStreamIDKey         = keeper.NextCommunityPoolStreamIDKey
 	ParamStoreKeyCommunityTax            = keeper.ParamStoreKeyCommunityTax
 	ParamStoreKeyBaseProposerReward      = keeper.ParamStoreKeyBaseProposerReward
 	ParamStoreKeyBonusProposerReward     = keeper.ParamStoreKeyBonusProposerReward
 	ParamStoreKeyWithdrawAddrEnabled     = keeper.ParamStoreKeyWithdrawAddrEnabled
+	ParamStoreKeyAutoCompoundPeriod      = keeper.ParamStoreKeyAutoCompoundPeriod
+	ParamStoreKeyAutoCompoundMaxPerBlock = keeper.ParamStoreKeyAutoCompoundMaxPerBlock
 	TestAddrs                            = keeper.TestAddrs
 	EventTypeRewards                     = types.EventTypeRewards
 	EventTypeCommission                  = types.EventTypeCommission
+	EventTypeSetAutoCompound             = types.EventTypeSetAutoCompound
+	EventTypeAutoCompound                = types.EventTypeAutoCompound
+	EventTypeFundCommunityPool           = types.EventTypeFundCommunityPool
+	EventTypeCreateCommunityPoolStream   = types.EventTypeCreateCommunityPoolStream
+	EventTypeCancelCommunityPoolStream   = types.EventTypeCancelCommunityPoolStream
+	EventTypeCommunityPoolStreamPayout   = types.EventTypeCommunityPoolStreamPayout
+	EventTypeCompleteCommunityPoolStream = types.EventTypeCompleteCommunityPoolStream
+	EventTypeStopCommunityPoolStream     = types.EventTypeStopCommunityPoolStream
 	AttributeValueCategory               = types.AttributeValueCategory
 	AttributeKeyValidator                = types.AttributeKeyValidator
+	AttributeKeyDelegator                = types.AttributeKeyDelegator
+	AttributeKeyEnabled                  = types.AttributeKeyEnabled
+	AttributeKeyDepositor                = types.AttributeKeyDepositor
+	AttributeKeyStreamID                 = types.AttributeKeyStreamID
+	AttributeKeyRecipient                = types.AttributeKeyRecipient
+	AttributeKeyPaid                     = types.AttributeKeyPaid
 	ModuleCdc                            = types.ModuleCdc
 )
 
@@ -138,23 +192,38 @@ type (
 	ValidatorCurrentRewardsRecord          = types.ValidatorCurrentRewardsRecord
 	DelegatorStartingInfoRecord            = types.DelegatorStartingInfoRecord
 	ValidatorSlashEventRecord              = types.ValidatorSlashEventRecord
+	AutoCompoundRecord                     = types.AutoCompoundRecord
 	GenesisState                           = types.GenesisState
 	MsgSetWithdrawAddress                  = types.MsgSetWithdrawAddress
 	MsgWithdrawDelegatorReward             = types.MsgWithdrawDelegatorReward
 	MsgWithdrawValidatorCommission         = types.MsgWithdrawValidatorCommission
+	MsgSetAutoCompound                     = types.MsgSetAutoCompound
+	MsgFundCommunityPool                   = types.MsgFundCommunityPool
 	CommunityPoolSpendProposal             = types.CommunityPoolSpendProposal
+	CommunityPoolStreamProposal            = types.CommunityPoolStreamProposal
+	CancelCommunityPoolStreamProposal      = types.CancelCommunityPoolStreamProposal
 	QueryValidatorOutstandingRewardsParams = types.QueryValidatorOutstandingRewardsParams
 	QueryValidatorCommissionParams         = types.QueryValidatorCommissionParams
 	QueryValidatorSlashesParams            = types.QueryValidatorSlashesParams
 	QueryDelegationRewardsParams           = types.QueryDelegationRewardsParams
 	QueryDelegatorParams                   = types.QueryDelegatorParams
 	QueryDelegatorWithdrawAddrParams       = types.QueryDelegatorWithdrawAddrParams
+	QueryCommunityPoolStreamParams         = types.QueryCommunityPoolStreamParams
 	QueryDelegatorTotalRewardsResponse     = types.QueryDelegatorTotalRewardsResponse
 	DelegationDelegatorReward              = types.DelegationDelegatorReward
+	AutoCompoundValidators                 = types.AutoCompoundValidators
+	QueryValidatorParams                   = types.QueryValidatorParams
+	QueryValidatorRewardRateParams         = types.QueryValidatorRewardRateParams
+	QueryDelegatorAccruedRewardsResponse   = types.QueryDelegatorAccruedRewardsResponse
+	DelegationAccruedReward                = types.DelegationAccruedReward
+	ValidatorRewardRate                    = types.ValidatorRewardRate
+	ValidatorAPR                           = types.ValidatorAPR
 	ValidatorHistoricalRewards             = types.ValidatorHistoricalRewards
 	ValidatorCurrentRewards                = types.ValidatorCurrentRewards
 	ValidatorAccumulatedCommission         = types.ValidatorAccumulatedCommission
 	ValidatorSlashEvent                    = types.ValidatorSlashEvent
 	ValidatorSlashEvents                   = types.ValidatorSlashEvents
 	ValidatorOutstandingRewards            = types.ValidatorOutstandingRewards
+	CommunityPoolStream                    = types.CommunityPoolStream
+	CommunityPoolStreams                   = types.CommunityPoolStreams
 )
@@ -33,6 +33,12 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryValidatorSlashes(queryRoute, cdc),
 		GetCmdQueryDelegatorRewards(queryRoute, cdc),
 		GetCmdQueryCommunityPool(queryRoute, cdc),
+		GetCmdQueryAutoCompound(queryRoute, cdc),
+		GetCmdQueryCommunityPoolStreams(queryRoute, cdc),
+		GetCmdQueryCommunityPoolStream(queryRoute, cdc),
+		GetCmdQueryAccruedRewards(queryRoute, cdc),
+		GetCmdQueryValidatorRewardRate(queryRoute, cdc),
+		GetCmdQueryValidatorAPR(queryRoute, cdc),
 	)...)
 
 	return distQueryCmd
@@ -262,3 +268,224 @@ $ %s query distr community-pool
 		},
 	}
 }
+
+// GetCmdQueryAutoCompound implements the query delegator auto-compounding command.
+func GetCmdQueryAutoCompound(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "auto-compound [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the delegations of a delegator that are opted into auto-compounding",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validators of all delegations of a delegator whose rewards are periodically re-delegated.
+
+Example:
+$ %s query distr auto-compound cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := common.QueryDelegatorAutoCompound(cliCtx, queryRoute, delegatorAddr)
+			if err != nil {
+				return err
+			}
+
+			var result types.AutoCompoundValidators
+			cdc.MustUnmarshalJSON(res, &result)
+			return cliCtx.PrintOutput(result)
+		},
+	}
+}
+
+// GetCmdQueryCommunityPoolStreams implements the query community pool streams command.
+func GetCmdQueryCommunityPoolStreams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "community-pool-streams",
+		Args:  cobra.NoArgs,
+		Short: "Query all active community pool streams",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all community pool streams that have not completed or been stopped yet,
+including the ones that have not started yet.
+
+Example:
+$ %s query distr community-pool-streams
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryCommunityPoolStreams), nil)
+			if err != nil {
+				return err
+			}
+
+			var result types.CommunityPoolStreams
+			cdc.MustUnmarshalJSON(res, &result)
+			return cliCtx.PrintOutput(result)
+		},
+	}
+}
+
+// GetCmdQueryCommunityPoolStream implements the query community pool stream command.
+func GetCmdQueryCommunityPoolStream(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "community-pool-stream [stream-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query an active community pool stream",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the details of an active community pool stream, including the amount paid out so far.
+
+Example:
+$ %s query distr community-pool-stream 1
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			streamID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("stream-id %s not a valid uint, please input a valid stream-id", args[0])
+			}
+
+			res, err := common.QueryCommunityPoolStream(cliCtx, queryRoute, streamID)
+			if err != nil {
+				return err
+			}
+
+			var result types.CommunityPoolStream
+			cdc.MustUnmarshalJSON(res, &result)
+			return cliCtx.PrintOutput(result)
+		},
+	}
+}
+
+// GetCmdQueryAccruedRewards implements the query delegator accrued rewards command.
+func GetCmdQueryAccruedRewards(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "accrued-rewards [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the rewards a delegator has accrued per validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the rewards a delegator has accrued on each of its delegations since they were
+last withdrawn, along with the commission rate of each validator.
+
+Example:
+$ %s query distr accrued-rewards cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := common.QueryDelegatorAccruedRewards(cliCtx, queryRoute, delegatorAddr)
+			if err != nil {
+				return err
+			}
+
+			var result types.QueryDelegatorAccruedRewardsResponse
+			cdc.MustUnmarshalJSON(res, &result)
+			return cliCtx.PrintOutput(result)
+		},
+	}
+}
+
+// GetCmdQueryValidatorRewardRate implements the query validator reward rate command.
+func GetCmdQueryValidatorRewardRate(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reward-rate [validator] [from-height] [to-height]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Query the rewards per token a validator's delegations earned between two heights",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the rewards per token a validator's delegations earned between two heights, net of
+the validator's commission. The rate is computed from the validator's stored reward periods, so it may cover a
+wider range than requested when no period ended at the given heights: the range covered is returned with the rate.
+
+Example:
+$ %s query distr reward-rate cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100000 200000
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			validatorAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			fromHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("from-height %s not a valid int, please input a valid from-height", args[1])
+			}
+
+			toHeight, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("to-height %s not a valid int, please input a valid to-height", args[2])
+			}
+
+			res, err := common.QueryValidatorRewardRate(cliCtx, queryRoute, validatorAddr, fromHeight, toHeight)
+			if err != nil {
+				return err
+			}
+
+			var rate types.ValidatorRewardRate
+			cdc.MustUnmarshalJSON(res, &rate)
+			return cliCtx.PrintOutput(rate)
+		},
+	}
+}
+
+// GetCmdQueryValidatorAPR implements the query validator APR command.
+func GetCmdQueryValidatorAPR(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "apr [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the projected annual rewards per bonded token of a validator's delegations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the projected annual rewards per bonded token of a validator's delegations. The
+projection is derived from the annual provisions of the mint module and the bonded ratio, net of the community
+tax and the validator's commission. Fees and proposer rewards are not taken into account.
+
+Example:
+$ %s query distr apr cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			validatorAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := common.QueryValidatorAPR(cliCtx, queryRoute, validatorAddr)
+			if err != nil {
+				return err
+			}
+
+			var apr types.ValidatorAPR
+			cdc.MustUnmarshalJSON(res, &apr)
+			return cliCtx.PrintOutput(apr)
+		},
+	}
+}
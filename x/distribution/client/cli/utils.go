@@ -16,6 +16,25 @@ type (
 		Amount      sdk.Coins      `json:"amount"`
 		Deposit     sdk.Coins      `json:"deposit"`
 	}
+
+	// CommunityPoolStreamProposalJSON defines a CommunityPoolStreamProposal with a deposit
+	CommunityPoolStreamProposalJSON struct {
+		Title       string         `json:"title"`
+		Description string         `json:"description"`
+		Recipient   sdk.AccAddress `json:"recipient"`
+		Amount      sdk.Coins      `json:"amount"`
+		StartHeight int64          `json:"start_height"`
+		EndHeight   int64          `json:"end_height"`
+		Deposit     sdk.Coins      `json:"deposit"`
+	}
+
+	// CancelCommunityPoolStreamProposalJSON defines a CancelCommunityPoolStreamProposal with a deposit
+	CancelCommunityPoolStreamProposalJSON struct {
+		Title       string    `json:"title"`
+		Description string    `json:"description"`
+		StreamID    uint64    `json:"stream_id"`
+		Deposit     sdk.Coins `json:"deposit"`
+	}
 )
 
 // ParseCommunityPoolSpendProposalJSON reads and parses a CommunityPoolSpendProposalJSON from a file.
@@ -33,3 +52,35 @@ func ParseCommunityPoolSpendProposalJSON(cdc *codec.Codec, proposalFile string)
 
 	return proposal, nil
 }
+
+// ParseCommunityPoolStreamProposalJSON reads and parses a CommunityPoolStreamProposalJSON from a file.
+func ParseCommunityPoolStreamProposalJSON(cdc *codec.Codec, proposalFile string) (CommunityPoolStreamProposalJSON, error) {
+	proposal := CommunityPoolStreamProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseCancelCommunityPoolStreamProposalJSON reads and parses a CancelCommunityPoolStreamProposalJSON from a file.
+func ParseCancelCommunityPoolStreamProposalJSON(cdc *codec.Codec, proposalFile string) (CancelCommunityPoolStreamProposalJSON, error) {
+	proposal := CancelCommunityPoolStreamProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -35,8 +35,21 @@ func QueryParams(cliCtx context.CLIContext, queryRoute string) (PrettyParams, er
 		return PrettyParams{}, err
 	}
 
+	route = fmt.Sprintf("custom/%s/params/%s", queryRoute, types.ParamAutoCompoundPeriod)
+	retAutoCompoundPeriod, _, err := cliCtx.QueryWithData(route, []byte{})
+	if err != nil {
+		return PrettyParams{}, err
+	}
+
+	route = fmt.Sprintf("custom/%s/params/%s", queryRoute, types.ParamAutoCompoundMaxPerBlock)
+	retAutoCompoundMaxPerBlock, _, err := cliCtx.QueryWithData(route, []byte{})
+	if err != nil {
+		return PrettyParams{}, err
+	}
+
 	return NewPrettyParams(
 		retCommunityTax, retBaseProposerReward, retBonusProposerReward, retWithdrawAddrEnabled,
+		retAutoCompoundPeriod, retAutoCompoundMaxPerBlock,
 	), nil
 }
 
@@ -92,6 +105,56 @@ func QueryValidatorCommission(cliCtx context.CLIContext, queryRoute string, vali
 	return res, err
 }
 
+// QueryDelegatorAutoCompound returns the validators of the delegator's
+// delegations that are opted into auto-compounding.
+func QueryDelegatorAutoCompound(cliCtx context.CLIContext, queryRoute string, delegatorAddr sdk.AccAddress) ([]byte, error) {
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDelegatorAutoCompound),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryDelegatorParams(delegatorAddr)),
+	)
+	return res, err
+}
+
+// QueryCommunityPoolStream queries an active community pool stream.
+func QueryCommunityPoolStream(cliCtx context.CLIContext, queryRoute string, streamID uint64) ([]byte, error) {
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryCommunityPoolStream),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryCommunityPoolStreamParams(streamID)),
+	)
+	return res, err
+}
+
+// QueryDelegatorAccruedRewards queries the rewards a delegator has accrued on
+// each of its delegations, along with the validators' commission rates.
+func QueryDelegatorAccruedRewards(cliCtx context.CLIContext, queryRoute string, delegatorAddr sdk.AccAddress) ([]byte, error) {
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDelegatorAccruedRewards),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryDelegatorParams(delegatorAddr)),
+	)
+	return res, err
+}
+
+// QueryValidatorAPR queries the projected annual rewards per bonded token of a
+// validator's delegations.
+func QueryValidatorAPR(cliCtx context.CLIContext, queryRoute string, validatorAddr sdk.ValAddress) ([]byte, error) {
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryValidatorAPR),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryValidatorParams(validatorAddr)),
+	)
+	return res, err
+}
+
+// QueryValidatorRewardRate queries the rewards per token a validator's
+// delegations earned between two heights.
+func QueryValidatorRewardRate(cliCtx context.CLIContext, queryRoute string, validatorAddr sdk.ValAddress,
+	fromHeight, toHeight int64) ([]byte, error) {
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryValidatorRewardRate),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryValidatorRewardRateParams(validatorAddr, fromHeight, toHeight)),
+	)
+	return res, err
+}
+
 // WithdrawAllDelegatorRewards builds a multi-message slice to be used
 // to withdraw all delegations rewards for the given delegator.
 func WithdrawAllDelegatorRewards(cliCtx context.CLIContext, queryRoute string, delegatorAddr sdk.AccAddress) ([]sdk.Msg, error) {
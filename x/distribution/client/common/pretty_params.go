@@ -7,28 +7,36 @@ import (
 
 // Convenience struct for CLI output
 type PrettyParams struct {
-	CommunityTax        json.RawMessage `json:"community_tax"`
-	BaseProposerReward  json.RawMessage `json:"base_proposer_reward"`
-	BonusProposerReward json.RawMessage `json:"bonus_proposer_reward"`
-	WithdrawAddrEnabled json.RawMessage `json:"withdraw_addr_enabled"`
+	CommunityTax            json.RawMessage `json:"community_tax"`
+	BaseProposerReward      json.RawMessage `json:"base_proposer_reward"`
+	BonusProposerReward     json.RawMessage `json:"bonus_proposer_reward"`
+	WithdrawAddrEnabled     json.RawMessage `json:"withdraw_addr_enabled"`
+	AutoCompoundPeriod      json.RawMessage `json:"auto_compound_period"`
+	AutoCompoundMaxPerBlock json.RawMessage `json:"auto_compound_max_per_block"`
 }
 
 // Construct a new PrettyParams
-func NewPrettyParams(communityTax json.RawMessage, baseProposerReward json.RawMessage, bonusProposerReward json.RawMessage, withdrawAddrEnabled json.RawMessage) PrettyParams {
+func NewPrettyParams(communityTax json.RawMessage, baseProposerReward json.RawMessage, bonusProposerReward json.RawMessage,
+	withdrawAddrEnabled json.RawMessage, autoCompoundPeriod json.RawMessage, autoCompoundMaxPerBlock json.RawMessage) PrettyParams {
 	return PrettyParams{
-		CommunityTax:        communityTax,
-		BaseProposerReward:  baseProposerReward,
-		BonusProposerReward: bonusProposerReward,
-		WithdrawAddrEnabled: withdrawAddrEnabled,
+		CommunityTax:            communityTax,
+		BaseProposerReward:      baseProposerReward,
+		BonusProposerReward:     bonusProposerReward,
+		WithdrawAddrEnabled:     withdrawAddrEnabled,
+		AutoCompoundPeriod:      autoCompoundPeriod,
+		AutoCompoundMaxPerBlock: autoCompoundMaxPerBlock,
 	}
 }
 
 func (pp PrettyParams) String() string {
 	return fmt.Sprintf(`Distribution Params:
-  Community Tax:          %s
-  Base Proposer Reward:   %s
-  Bonus Proposer Reward:  %s
-  Withdraw Addr Enabled:  %s`, pp.CommunityTax,
-		pp.BaseProposerReward, pp.BonusProposerReward, pp.WithdrawAddrEnabled)
+  Community Tax:                %s
+  Base Proposer Reward:         %s
+  Bonus Proposer Reward:        %s
+  Withdraw Addr Enabled:        %s
+  Auto-Compound Period:         %s
+  Auto-Compound Max Per Block:  %s`, pp.CommunityTax,
+		pp.BaseProposerReward, pp.BonusProposerReward, pp.WithdrawAddrEnabled,
+		pp.AutoCompoundPeriod, pp.AutoCompoundMaxPerBlock)
 
 }
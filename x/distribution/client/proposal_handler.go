@@ -8,3 +8,9 @@ import (
 
 // param change proposal handler
 var ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+
+// community pool stream proposal handlers
+var (
+	StreamProposalHandler       = govclient.NewProposalHandler(cli.GetCmdSubmitStreamProposal, rest.StreamProposalRESTHandler)
+	CancelStreamProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitCancelStreamProposal, rest.CancelStreamProposalRESTHandler)
+)
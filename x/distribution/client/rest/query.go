@@ -63,6 +63,42 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute st
 		communityPoolHandler(cliCtx, queryRoute),
 	).Methods("GET")
 
+	// Get the delegations of a delegator that are opted into auto-compounding
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/auto_compound",
+		delegatorAutoCompoundHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Get all active community pool streams
+	r.HandleFunc(
+		"/distribution/community_pool/streams",
+		communityPoolStreamsHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Get a single active community pool stream
+	r.HandleFunc(
+		"/distribution/community_pool/streams/{streamID}",
+		communityPoolStreamHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Get the rewards a delegator has accrued per validator
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/accrued_rewards",
+		delegatorAccruedRewardsHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Get the rewards per token a validator's delegations earned between two heights
+	r.HandleFunc(
+		"/distribution/validators/{validatorAddr}/reward_rate",
+		validatorRewardRateHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Get the projected annual rewards per bonded token of a validator's delegations
+	r.HandleFunc(
+		"/distribution/validators/{validatorAddr}/apr",
+		validatorAPRHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
 }
 
 // HTTP request handler to query the total rewards balance from all delegations
@@ -126,6 +162,154 @@ func delegatorWithdrawalAddrHandlerFn(cliCtx context.CLIContext, queryRoute stri
 	}
 }
 
+// HTTP request handler to query the delegations of a delegator that are opted into auto-compounding
+func delegatorAutoCompoundHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		delegatorAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz := cliCtx.Codec.MustMarshalJSON(types.NewQueryDelegatorParams(delegatorAddr))
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDelegatorAutoCompound), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query the active community pool streams
+func communityPoolStreamsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryCommunityPoolStreams), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query a single active community pool stream
+func communityPoolStreamHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		streamID, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)["streamID"])
+		if !ok {
+			return
+		}
+
+		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, err := common.QueryCommunityPoolStream(cliCtx, queryRoute, streamID)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query the rewards a delegator has accrued per validator
+func delegatorAccruedRewardsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		delegatorAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, err := common.QueryDelegatorAccruedRewards(cliCtx, queryRoute, delegatorAddr)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query the rewards per token a validator's
+// delegations earned between two heights
+func validatorRewardRateHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		validatorAddr, ok := checkValidatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		fromHeight, ok := rest.ParseInt64OrReturnBadRequest(w, r.FormValue("from_height"))
+		if !ok {
+			return
+		}
+
+		toHeight, ok := rest.ParseInt64OrReturnBadRequest(w, r.FormValue("to_height"))
+		if !ok {
+			return
+		}
+
+		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, err := common.QueryValidatorRewardRate(cliCtx, queryRoute, validatorAddr, fromHeight, toHeight)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query the projected annual rewards per bonded token
+// of a validator's delegations
+func validatorAPRHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		validatorAddr, ok := checkValidatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, err := common.QueryValidatorAPR(cliCtx, queryRoute, validatorAddr)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // ValidatorDistInfo defines the properties of
 // validator distribution information response.
 type ValidatorDistInfo struct {
@@ -17,4 +17,29 @@ type (
 		Proposer    sdk.AccAddress `json:"proposer"`
 		Deposit     sdk.Coins      `json:"deposit"`
 	}
+
+	// CommunityPoolStreamProposalReq defines a community pool stream proposal request body.
+	CommunityPoolStreamProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req"`
+
+		Title       string         `json:"title"`
+		Description string         `json:"description"`
+		Recipient   sdk.AccAddress `json:"recipient"`
+		Amount      sdk.Coins      `json:"amount"`
+		StartHeight int64          `json:"start_height"`
+		EndHeight   int64          `json:"end_height"`
+		Proposer    sdk.AccAddress `json:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit"`
+	}
+
+	// CancelCommunityPoolStreamProposalReq defines a community pool stream cancellation proposal request body.
+	CancelCommunityPoolStreamProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req"`
+
+		Title       string         `json:"title"`
+		Description string         `json:"description"`
+		StreamID    uint64         `json:"stream_id"`
+		Proposer    sdk.AccAddress `json:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit"`
+	}
 )
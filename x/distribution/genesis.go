@@ -16,6 +16,8 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 	keeper.SetBaseProposerReward(ctx, data.BaseProposerReward)
 	keeper.SetBonusProposerReward(ctx, data.BonusProposerReward)
 	keeper.SetWithdrawAddrEnabled(ctx, data.WithdrawAddrEnabled)
+	keeper.SetAutoCompoundPeriod(ctx, data.AutoCompoundPeriod)
+	keeper.SetAutoCompoundMaxPerBlock(ctx, data.AutoCompoundMaxPerBlock)
 
 	for _, dwi := range data.DelegatorWithdrawInfos {
 		keeper.SetDelegatorWithdrawAddr(ctx, dwi.DelegatorAddress, dwi.WithdrawAddress)
@@ -40,6 +42,17 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 	for _, evt := range data.ValidatorSlashEvents {
 		keeper.SetValidatorSlashEvent(ctx, evt.ValidatorAddress, evt.Height, evt.Period, evt.Event)
 	}
+	for _, ac := range data.AutoCompoundDelegations {
+		keeper.SetDelegatorAutoCompound(ctx, ac.DelegatorAddress, ac.ValidatorAddress)
+	}
+	if data.AutoCompoundCursor != nil {
+		cursor := data.AutoCompoundCursor
+		keeper.SetAutoCompoundCursor(ctx, GetDelegatorAutoCompoundKey(cursor.DelegatorAddress, cursor.ValidatorAddress))
+	}
+	for _, stream := range data.CommunityPoolStreams {
+		keeper.SetCommunityPoolStream(ctx, stream)
+	}
+	keeper.SetNextCommunityPoolStreamID(ctx, data.NextCommunityPoolStreamID)
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -138,6 +151,29 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 			return false
 		},
 	)
+	autoCompoundPeriod := keeper.GetAutoCompoundPeriod(ctx)
+	autoCompoundMaxPerBlock := keeper.GetAutoCompoundMaxPerBlock(ctx)
+	autoCompounds := make([]types.AutoCompoundRecord, 0)
+	keeper.IterateDelegatorAutoCompounds(ctx,
+		func(del sdk.AccAddress, val sdk.ValAddress) (stop bool) {
+			autoCompounds = append(autoCompounds, types.AutoCompoundRecord{
+				DelegatorAddress: del,
+				ValidatorAddress: val,
+			})
+			return false
+		},
+	)
+	var autoCompoundCursor *types.AutoCompoundRecord
+	if cursor, found := keeper.GetAutoCompoundCursor(ctx); found {
+		del, val := GetDelegatorAutoCompoundAddresses(cursor)
+		autoCompoundCursor = &types.AutoCompoundRecord{
+			DelegatorAddress: del,
+			ValidatorAddress: val,
+		}
+	}
+	streams := keeper.GetCommunityPoolStreams(ctx)
+	nextStreamID := keeper.GetNextCommunityPoolStreamID(ctx)
 	return types.NewGenesisState(feePool, communityTax, baseProposerRewards, bonusProposerRewards, withdrawAddrEnabled,
-		dwi, pp, outstanding, acc, his, cur, dels, slashes)
+		dwi, pp, outstanding, acc, his, cur, dels, slashes, autoCompoundPeriod, autoCompoundMaxPerBlock, autoCompounds,
+		autoCompoundCursor, streams, nextStreamID)
 }
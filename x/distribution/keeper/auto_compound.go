@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// opt a delegation in or out of auto-compounding
+func (k Keeper) SetAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) sdk.Error {
+	if enabled {
+		if k.stakingKeeper.Delegation(ctx, delAddr, valAddr) == nil {
+			return types.ErrNoDelegation(k.codespace)
+		}
+		k.SetDelegatorAutoCompound(ctx, delAddr, valAddr)
+	} else {
+		k.DeleteDelegatorAutoCompound(ctx, delAddr, valAddr)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+		),
+	)
+
+	return nil
+}
+
+// ProcessAutoCompounding re-delegates the rewards of the delegations opted into
+// auto-compounding. A pass over all opted-in delegations starts every
+// auto-compound period blocks and processes at most auto-compound max per block
+// delegations in each block, resuming from a cursor until the pass completes.
+func (k Keeper) ProcessAutoCompounding(ctx sdk.Context) {
+	period := k.GetAutoCompoundPeriod(ctx)
+	maxPerBlock := k.GetAutoCompoundMaxPerBlock(ctx)
+	if period <= 0 || maxPerBlock <= 0 {
+		k.DeleteAutoCompoundCursor(ctx)
+		return
+	}
+
+	// start a new pass if none is running
+	start := DelegatorAutoCompoundPrefix
+	cursor, found := k.GetAutoCompoundCursor(ctx)
+	if found {
+		start = append(cursor, 0x00)
+	} else if ctx.BlockHeight()%period != 0 {
+		return
+	}
+
+	// collect the next batch before modifying the store
+	var keys [][]byte
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(start, sdk.PrefixEndBytes(DelegatorAutoCompoundPrefix))
+	for ; iter.Valid() && int64(len(keys)) < maxPerBlock; iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		delAddr, valAddr := GetDelegatorAutoCompoundAddresses(key)
+		k.compoundDelegationRewards(ctx, delAddr, valAddr)
+	}
+
+	// the pass is complete once a batch comes up short
+	if int64(len(keys)) < maxPerBlock {
+		k.DeleteAutoCompoundCursor(ctx)
+		return
+	}
+	k.SetAutoCompoundCursor(ctx, keys[len(keys)-1])
+}
+
+// withdraw the rewards of a delegation and delegate the bond denomination back
+// to the same validator, the other denominations are sent to the withdraw
+// address of the delegator. The state is left untouched on failure.
+func (k Keeper) compoundDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	del := k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
+	if !found || del == nil {
+		k.DeleteDelegatorAutoCompound(ctx, delAddr, valAddr)
+		return
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+	rewards, err := k.claimDelegationRewards(cacheCtx, validator, del)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("failed to auto-compound rewards of delegation %s/%s: %s", delAddr, valAddr, err))
+		return
+	}
+	k.initializeDelegation(cacheCtx, valAddr, delAddr)
+
+	amount := rewards.AmountOf(k.stakingKeeper.BondDenom(ctx))
+	bondCoins := sdk.NewCoins(sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), amount))
+	if otherCoins := rewards.Sub(bondCoins); !otherCoins.IsZero() {
+		withdrawAddr := k.GetDelegatorWithdrawAddr(cacheCtx, delAddr)
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, withdrawAddr, otherCoins); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("failed to auto-compound rewards of delegation %s/%s: %s", delAddr, valAddr, err))
+			return
+		}
+	}
+
+	if amount.IsPositive() {
+		if err := k.stakingKeeper.CheckVotingPowerCap(cacheCtx, validator, amount); err != nil {
+			k.Logger(ctx).Info(fmt.Sprintf("skipped auto-compounding of delegation %s/%s: validator exceeds the voting power cap", delAddr, valAddr))
+			return
+		}
+
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, delAddr, bondCoins); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("failed to auto-compound rewards of delegation %s/%s: %s", delAddr, valAddr, err))
+			return
+		}
+
+		if _, err := k.stakingKeeper.Delegate(cacheCtx, delAddr, amount, sdk.Unbonded, validator, true); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("failed to auto-compound rewards of delegation %s/%s: %s", delAddr, valAddr, err))
+			return
+		}
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+}
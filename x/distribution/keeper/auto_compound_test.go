@@ -0,0 +1,233 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+// create a bonded validator with 50% commission, fund the distribution module
+// account and enable auto-compounding every five blocks
+func setupAutoCompound(t *testing.T) (sdk.Context, auth.AccountKeeper, Keeper, staking.Keeper) {
+	balanceTokens := sdk.TokensFromConsensusPower(1000)
+	ctx, ak, k, sk, _ := CreateTestInputDefault(t, false, 1000)
+	sh := staking.NewHandler(sk)
+
+	distrAcc := k.GetDistributionAccount(ctx)
+	distrAcc.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens)))
+	k.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(
+		valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(100)),
+		staking.Description{}, commission, sdk.OneInt(),
+	)
+	require.True(t, sh(ctx, msg).IsOK())
+
+	staking.EndBlocker(ctx, sk)
+	ctx = ctx.WithBlockHeight(1)
+
+	k.SetAutoCompoundPeriod(ctx, 5)
+	k.SetAutoCompoundMaxPerBlock(ctx, 10)
+
+	return ctx, ak, k, sk
+}
+
+func countAutoCompoundEvents(ctx sdk.Context) (count int) {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeAutoCompound {
+			count++
+		}
+	}
+	return count
+}
+
+// calculate the rewards of a delegation without modifying the state
+func delegationRewards(ctx sdk.Context, k Keeper, sk staking.Keeper, del sdk.AccAddress, valAddr sdk.ValAddress) sdk.DecCoins {
+	ctx, _ = ctx.CacheContext()
+	val := sk.Validator(ctx, valAddr)
+	endingPeriod := k.incrementValidatorPeriod(ctx, val)
+	return k.calculateDelegationRewards(ctx, val, sk.Delegation(ctx, del, valAddr), endingPeriod)
+}
+
+func TestSetAutoCompound(t *testing.T) {
+	ctx, _, k, _ := setupAutoCompound(t)
+	valAccAddr := sdk.AccAddress(valOpAddr1)
+
+	// a delegation must exist to opt into auto-compounding
+	err := k.SetAutoCompound(ctx, delAddr1, valOpAddr1, true)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeNoDelegation, err.Code())
+	require.False(t, k.HasDelegatorAutoCompound(ctx, delAddr1, valOpAddr1))
+
+	require.Nil(t, k.SetAutoCompound(ctx, valAccAddr, valOpAddr1, true))
+	require.True(t, k.HasDelegatorAutoCompound(ctx, valAccAddr, valOpAddr1))
+	require.Equal(t, []sdk.ValAddress{valOpAddr1}, k.GetDelegatorAutoCompoundValidators(ctx, valAccAddr))
+
+	require.Nil(t, k.SetAutoCompound(ctx, valAccAddr, valOpAddr1, false))
+	require.False(t, k.HasDelegatorAutoCompound(ctx, valAccAddr, valOpAddr1))
+	require.Empty(t, k.GetDelegatorAutoCompoundValidators(ctx, valAccAddr))
+}
+
+func TestProcessAutoCompounding(t *testing.T) {
+	ctx, ak, k, sk := setupAutoCompound(t)
+	valAccAddr := sdk.AccAddress(valOpAddr1)
+
+	// rewards must be re-delegated rather than paid to the withdraw address
+	k.SetDelegatorWithdrawAddr(ctx, valAccAddr, delAddr2)
+	require.Nil(t, k.SetAutoCompound(ctx, valAccAddr, valOpAddr1, true))
+
+	initial := sdk.TokensFromConsensusPower(10)
+	val := sk.Validator(ctx, valOpAddr1)
+	k.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+
+	balance := ak.GetAccount(ctx, valAccAddr).GetCoins()
+	withdrawBalance := ak.GetAccount(ctx, delAddr2).GetCoins()
+	tokens := sk.Validator(ctx, valOpAddr1).GetTokens()
+
+	// nothing happens outside of the auto-compounding period
+	ctx = ctx.WithBlockHeight(4).WithEventManager(sdk.NewEventManager())
+	k.ProcessAutoCompounding(ctx)
+	require.Equal(t, 0, countAutoCompoundEvents(ctx))
+	require.Equal(t, tokens, sk.Validator(ctx, valOpAddr1).GetTokens())
+
+	// half of the rewards go to the delegation, the other half is commission
+	ctx = ctx.WithBlockHeight(5).WithEventManager(sdk.NewEventManager())
+	k.ProcessAutoCompounding(ctx)
+	require.Equal(t, 1, countAutoCompoundEvents(ctx))
+	require.Equal(t, tokens.Add(initial.QuoRaw(2)), sk.Validator(ctx, valOpAddr1).GetTokens())
+	require.Equal(t, balance, ak.GetAccount(ctx, valAccAddr).GetCoins())
+	require.Equal(t, withdrawBalance, ak.GetAccount(ctx, delAddr2).GetCoins())
+	require.True(t, delegationRewards(ctx, k, sk, valAccAddr, valOpAddr1).IsZero())
+
+	// the pass is complete
+	_, found := k.GetAutoCompoundCursor(ctx)
+	require.False(t, found)
+}
+
+func TestProcessAutoCompoundingOtherDenoms(t *testing.T) {
+	ctx, ak, k, sk := setupAutoCompound(t)
+	valAccAddr := sdk.AccAddress(valOpAddr1)
+
+	k.SetDelegatorWithdrawAddr(ctx, valAccAddr, delAddr2)
+	require.Nil(t, k.SetAutoCompound(ctx, valAccAddr, valOpAddr1, true))
+
+	// fund the distribution module account with the other denomination too
+	initial := sdk.TokensFromConsensusPower(10)
+	distrAcc := k.GetDistributionAccount(ctx)
+	distrAcc.SetCoins(distrAcc.GetCoins().Add(sdk.NewCoins(sdk.NewCoin("photon", initial))))
+	k.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+
+	val := sk.Validator(ctx, valOpAddr1)
+	k.AllocateTokensToValidator(ctx, val, sdk.DecCoins{
+		sdk.NewDecCoin("photon", initial),
+		sdk.NewDecCoin(sdk.DefaultBondDenom, initial),
+	})
+
+	balance := ak.GetAccount(ctx, valAccAddr).GetCoins()
+	withdrawBalance := ak.GetAccount(ctx, delAddr2).GetCoins()
+	tokens := sk.Validator(ctx, valOpAddr1).GetTokens()
+
+	// the bond denomination is compounded and the rest paid to the withdraw address
+	ctx = ctx.WithBlockHeight(5).WithEventManager(sdk.NewEventManager())
+	k.ProcessAutoCompounding(ctx)
+	require.Equal(t, 1, countAutoCompoundEvents(ctx))
+	require.Equal(t, tokens.Add(initial.QuoRaw(2)), sk.Validator(ctx, valOpAddr1).GetTokens())
+	require.Equal(t, balance, ak.GetAccount(ctx, valAccAddr).GetCoins())
+	require.Equal(t, withdrawBalance.Add(sdk.NewCoins(sdk.NewCoin("photon", initial.QuoRaw(2)))),
+		ak.GetAccount(ctx, delAddr2).GetCoins())
+	require.True(t, delegationRewards(ctx, k, sk, valAccAddr, valOpAddr1).IsZero())
+}
+
+func TestProcessAutoCompoundingCursor(t *testing.T) {
+	ctx, _, k, sk := setupAutoCompound(t)
+	sh := staking.NewHandler(sk)
+	k.SetAutoCompoundMaxPerBlock(ctx, 2)
+
+	delegators := []sdk.AccAddress{delAddr1, delAddr2, delAddr3}
+	for _, del := range delegators {
+		msg := staking.NewMsgDelegate(del, valOpAddr1, sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10)))
+		require.True(t, sh(ctx, msg).IsOK())
+		require.Nil(t, k.SetAutoCompound(ctx, del, valOpAddr1, true))
+	}
+
+	// the first batch leaves a cursor behind
+	ctx = ctx.WithBlockHeight(5).WithEventManager(sdk.NewEventManager())
+	k.ProcessAutoCompounding(ctx)
+	require.Equal(t, 2, countAutoCompoundEvents(ctx))
+	_, found := k.GetAutoCompoundCursor(ctx)
+	require.True(t, found)
+
+	// the pass resumes in the next block and completes
+	ctx = ctx.WithBlockHeight(6).WithEventManager(sdk.NewEventManager())
+	k.ProcessAutoCompounding(ctx)
+	require.Equal(t, 1, countAutoCompoundEvents(ctx))
+	_, found = k.GetAutoCompoundCursor(ctx)
+	require.False(t, found)
+
+	// no new pass starts before the next period
+	ctx = ctx.WithBlockHeight(7).WithEventManager(sdk.NewEventManager())
+	k.ProcessAutoCompounding(ctx)
+	require.Equal(t, 0, countAutoCompoundEvents(ctx))
+}
+
+func TestProcessAutoCompoundingVestingAccount(t *testing.T) {
+	ctx, ak, k, sk := setupAutoCompound(t)
+	sh := staking.NewHandler(sk)
+
+	// turn the delegator into a vesting account whose coins are all still vesting
+	now := time.Unix(1000, 0)
+	ctx = ctx.WithBlockTime(now)
+	baseAcc := ak.GetAccount(ctx, delAddr1).(*auth.BaseAccount)
+	vacc := auth.NewContinuousVestingAccount(baseAcc, now.Unix(), now.Add(365*24*time.Hour).Unix())
+	ak.SetAccount(ctx, vacc)
+
+	delTokens := sdk.TokensFromConsensusPower(100)
+	msg := staking.NewMsgDelegate(delAddr1, valOpAddr1, sdk.NewCoin(sdk.DefaultBondDenom, delTokens))
+	require.True(t, sh(ctx, msg).IsOK())
+	require.Nil(t, k.SetAutoCompound(ctx, delAddr1, valOpAddr1, true))
+
+	// the delegation earns rewards from the next block on
+	ctx = ctx.WithBlockHeight(2)
+	val := sk.Validator(ctx, valOpAddr1)
+	k.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(20))})
+	rewards := delegationRewards(ctx, k, sk, delAddr1, valOpAddr1)
+	compounded := rewards.AmountOf(sdk.DefaultBondDenom).TruncateInt()
+	require.True(t, compounded.IsPositive())
+
+	balance := ak.GetAccount(ctx, delAddr1).GetCoins()
+	tokens := sk.Validator(ctx, valOpAddr1).GetTokens()
+
+	ctx = ctx.WithBlockHeight(5).WithEventManager(sdk.NewEventManager())
+	k.ProcessAutoCompounding(ctx)
+	require.Equal(t, 1, countAutoCompoundEvents(ctx))
+	require.Equal(t, tokens.Add(compounded), sk.Validator(ctx, valOpAddr1).GetTokens())
+
+	// the re-delegated rewards are tracked by the vesting account
+	acc := ak.GetAccount(ctx, delAddr1).(*auth.ContinuousVestingAccount)
+	require.Equal(t, balance, acc.GetCoins())
+	delegated := acc.GetDelegatedVesting().Add(acc.GetDelegatedFree())
+	require.Equal(t, delTokens.Add(compounded), delegated.AmountOf(sdk.DefaultBondDenom))
+}
+
+func TestAutoCompoundDelegationRemoved(t *testing.T) {
+	ctx, _, k, sk := setupAutoCompound(t)
+	sh := staking.NewHandler(sk)
+
+	delTokens := sdk.TokensFromConsensusPower(10)
+	msg := staking.NewMsgDelegate(delAddr1, valOpAddr1, sdk.NewCoin(sdk.DefaultBondDenom, delTokens))
+	require.True(t, sh(ctx, msg).IsOK())
+	require.Nil(t, k.SetAutoCompound(ctx, delAddr1, valOpAddr1, true))
+
+	// fully undelegating removes the opt-in
+	undelegate := staking.NewMsgUndelegate(delAddr1, valOpAddr1, sdk.NewCoin(sdk.DefaultBondDenom, delTokens))
+	require.True(t, sh(ctx, undelegate).IsOK())
+	require.False(t, k.HasDelegatorAutoCompound(ctx, delAddr1, valOpAddr1))
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/exported"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // initialize starting info for a new delegation
@@ -137,6 +138,26 @@ func (k Keeper) calculateDelegationRewards(ctx sdk.Context, val exported.Validat
 }
 
 func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val exported.ValidatorI, del exported.DelegationI) (sdk.Coins, sdk.Error) {
+	coins, err := k.claimDelegationRewards(ctx, val, del)
+	if err != nil {
+		return nil, err
+	}
+
+	// add coins to user account
+	if !coins.IsZero() {
+		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, del.GetDelegatorAddr())
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return coins, nil
+}
+
+// claim the rewards of a delegation, the returned coins are held by the
+// distribution module account until the caller sends them
+func (k Keeper) claimDelegationRewards(ctx sdk.Context, val exported.ValidatorI, del exported.DelegationI) (sdk.Coins, sdk.Error) {
 	// check existence of delegator starting info
 	if !k.HasDelegatorStartingInfo(ctx, del.GetValidatorAddr(), del.GetDelegatorAddr()) {
 		return nil, types.ErrNoDelegationDistInfo(k.codespace)
@@ -165,20 +186,20 @@ func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val exported.Validato
 	// truncate coins, return remainder to community pool
 	coins, remainder := rewards.TruncateDecimal()
 
+	// the staking module compounds the rewards of the tokenized shares pool
+	// delegation in the bond denom, the holders of share tokens can't claim
+	// the other denoms so they go to the community pool
+	if del.GetDelegatorAddr().Equals(k.supplyKeeper.GetModuleAddress(stakingtypes.TokenizedSharesPoolName)) {
+		bondCoins := sdk.NewCoins(sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), coins.AmountOf(k.stakingKeeper.BondDenom(ctx))))
+		remainder = remainder.Add(sdk.NewDecCoins(coins.Sub(bondCoins)))
+		coins = bondCoins
+	}
+
 	k.SetValidatorOutstandingRewards(ctx, del.GetValidatorAddr(), outstanding.Sub(rewards))
 	feePool := k.GetFeePool(ctx)
 	feePool.CommunityPool = feePool.CommunityPool.Add(remainder)
 	k.SetFeePool(ctx, feePool)
 
-	// add coins to user account
-	if !coins.IsZero() {
-		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, del.GetDelegatorAddr())
-		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins)
-		if err != nil {
-			return nil, err
-		}
-	}
-
 	// remove delegator starting info
 	k.DeleteDelegatorStartingInfo(ctx, del.GetValidatorAddr(), del.GetDelegatorAddr())
 
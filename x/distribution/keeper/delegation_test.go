@@ -618,3 +618,80 @@ func TestCalculateRewardsMultiDelegatorMultWithdraw(t *testing.T) {
 	// commission should be zero
 	require.True(t, k.GetValidatorAccumulatedCommission(ctx, valOpAddr1).IsZero())
 }
+
+func TestTokenizedSharesPoolRewardsCompounded(t *testing.T) {
+	ctx, ak, k, sk, supplyKeeper := CreateTestInputDefault(t, false, 1000)
+	sh := staking.NewHandler(sk)
+
+	// fund the distribution module account for the rewards
+	distrAcc := k.GetDistributionAccount(ctx)
+	distrAcc.SetCoins(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), sdk.NewInt64Coin("photon", 1000)))
+	k.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with 50% commission
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), staking.Description{}, commission, sdk.OneInt())
+	require.True(t, sh(ctx, msg).IsOK())
+
+	// second delegation, tokenized entirely
+	delAddr := sdk.AccAddress(valOpAddr2)
+	msg2 := staking.NewMsgDelegate(delAddr, valOpAddr1, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	require.True(t, sh(ctx, msg2).IsOK())
+	shareTokens, err := sk.TokenizeShares(ctx, delAddr, valOpAddr1, sdk.NewDec(100))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(100), shareTokens.Amount)
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, sk)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards, half of the delegator rewards accrue to the pool
+	val := sk.Validator(ctx, valOpAddr1)
+	tokens := sdk.DecCoins{{"photon", sdk.NewDec(10)}, {sdk.DefaultBondDenom, sdk.NewDec(100)}}
+	k.AllocateTokensToValidator(ctx, val, tokens)
+
+	// redeeming half of the share tokens compounds the pool rewards first, so
+	// the redeemed delegation includes half of them
+	halfTokens := sdk.NewCoin(shareTokens.Denom, sdk.NewInt(50))
+	_, err = sk.RedeemTokensForShares(ctx, delAddr, halfTokens)
+	require.NoError(t, err)
+
+	delegation, found := sk.GetDelegation(ctx, delAddr, valOpAddr1)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecWithPrec(625, 1), delegation.Shares)
+
+	poolAddr := supplyKeeper.GetModuleAddress(staking.TokenizedSharesPoolName)
+	poolDelegation, found := sk.GetDelegation(ctx, poolAddr, valOpAddr1)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecWithPrec(625, 1), poolDelegation.Shares)
+
+	// no coins are left in the pool account, the rewards the holders can't
+	// claim went to the community pool
+	poolAcc := supplyKeeper.GetModuleAccount(ctx, staking.TokenizedSharesPoolName)
+	require.True(t, poolAcc.GetCoins().IsZero())
+	require.Equal(t, sdk.DecCoins{{"photon", sdk.NewDecWithPrec(25, 1)}}, k.GetFeePoolCommunityCoins(ctx))
+
+	// new share tokens are minted at the raised redemption rate
+	newTokens, err := sk.TokenizeShares(ctx, delAddr, valOpAddr1, sdk.NewDec(25))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(20), newTokens.Amount)
+
+	delegation, found = sk.GetDelegation(ctx, delAddr, valOpAddr1)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecWithPrec(375, 1), delegation.Shares)
+
+	// the remaining share tokens are redeemed for the rest of the pool delegation
+	_, err = sk.RedeemTokensForShares(ctx, delAddr, halfTokens.Add(newTokens))
+	require.NoError(t, err)
+
+	delegation, found = sk.GetDelegation(ctx, delAddr, valOpAddr1)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(125), delegation.Shares)
+	require.True(t, ak.GetAccount(ctx, delAddr).GetCoins().AmountOf(shareTokens.Denom).IsZero())
+	_, found = sk.GetDelegation(ctx, poolAddr, valOpAddr1)
+	require.False(t, found)
+	require.False(t, k.HasDelegatorStartingInfo(ctx, valOpAddr1, poolAddr))
+}
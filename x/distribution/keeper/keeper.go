@@ -18,6 +18,7 @@ type Keeper struct {
 	paramSpace    params.Subspace
 	stakingKeeper types.StakingKeeper
 	supplyKeeper  types.SupplyKeeper
+	mintKeeper    types.MintKeeper
 
 	// codespace
 	codespace sdk.CodespaceType
@@ -27,8 +28,8 @@ type Keeper struct {
 
 // NewKeeper creates a new distribution Keeper instance
 func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace,
-	sk types.StakingKeeper, supplyKeeper types.SupplyKeeper, codespace sdk.CodespaceType,
-	feeCollectorName string) Keeper {
+	sk types.StakingKeeper, supplyKeeper types.SupplyKeeper, mintKeeper types.MintKeeper,
+	codespace sdk.CodespaceType, feeCollectorName string) Keeper {
 
 	// ensure distribution module account is set
 	if addr := supplyKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -41,6 +42,7 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace,
 		paramSpace:       paramSpace.WithKeyTable(ParamKeyTable()),
 		stakingKeeper:    sk,
 		supplyKeeper:     supplyKeeper,
+		mintKeeper:       mintKeeper,
 		codespace:        codespace,
 		feeCollectorName: feeCollectorName,
 	}
@@ -86,3 +86,20 @@ func TestGetTotalRewards(t *testing.T) {
 
 	require.Equal(t, expectedRewards, totalRewards)
 }
+
+func TestFundCommunityPool(t *testing.T) {
+	ctx, ak, keeper, _, _ := CreateTestInputDefault(t, false, 1000)
+
+	balance := ak.GetAccount(ctx, delAddr1).GetCoins()
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	require.Nil(t, keeper.FundCommunityPool(ctx, amount, delAddr1))
+
+	require.Equal(t, balance.Sub(amount), ak.GetAccount(ctx, delAddr1).GetCoins())
+	require.Equal(t, amount, keeper.GetDistributionAccount(ctx).GetCoins())
+	require.Equal(t, sdk.NewDecCoins(amount), keeper.GetFeePoolCommunityCoins(ctx))
+
+	// the depositor can't fund more than its balance
+	err := keeper.FundCommunityPool(ctx, balance, delAddr1)
+	require.NotNil(t, err)
+	require.Equal(t, sdk.NewDecCoins(amount), keeper.GetFeePoolCommunityCoins(ctx))
+}
@@ -32,6 +32,14 @@ const (
 // - 0x07<valAddr_Bytes>: ValidatorCurrentRewards
 //
 // - 0x08<valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09<accAddr_Bytes><valAddr_Bytes>: auto-compounding marker
+//
+// - 0x0A: auto-compounding cursor
+//
+// - 0x0B<streamID_Bytes>: CommunityPoolStream
+//
+// - 0x0C: next community pool stream ID
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -43,11 +51,18 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+	DelegatorAutoCompoundPrefix          = []byte{0x09} // key for delegations opted into auto-compounding
+	AutoCompoundCursorKey                = []byte{0x0A} // key for the last delegation processed by the running auto-compounding pass
+	CommunityPoolStreamPrefix            = []byte{0x0B} // key for community pool streams
+	NextCommunityPoolStreamIDKey         = []byte{0x0C} // key for the ID of the next community pool stream
 
 	ParamStoreKeyCommunityTax        = []byte("communitytax")
 	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
 	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
 	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+
+	ParamStoreKeyAutoCompoundPeriod      = []byte("autocompoundperiod")
+	ParamStoreKeyAutoCompoundMaxPerBlock = []byte("autocompoundmaxperblock")
 )
 
 // gets an address from a validator's outstanding rewards key
@@ -129,6 +144,30 @@ func GetValidatorSlashEventAddressHeight(key []byte) (valAddr sdk.ValAddress, he
 	return
 }
 
+// gets the addresses from a delegator's auto-compounding key
+func GetDelegatorAutoCompoundAddresses(key []byte) (delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	addr := key[1 : 1+sdk.AddrLen]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	delAddr = sdk.AccAddress(addr)
+	addr = key[1+sdk.AddrLen:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	valAddr = sdk.ValAddress(addr)
+	return
+}
+
+// gets the ID from a community pool stream key
+func GetCommunityPoolStreamID(key []byte) uint64 {
+	b := key[1:]
+	if len(b) != 8 {
+		panic("unexpected key length")
+	}
+	return binary.BigEndian.Uint64(b)
+}
+
 // gets the outstanding rewards key for a validator
 func GetValidatorOutstandingRewardsKey(valAddr sdk.ValAddress) []byte {
 	return append(ValidatorOutstandingRewardsPrefix, valAddr.Bytes()...)
@@ -191,3 +230,20 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 	prefix := GetValidatorSlashEventKeyPrefix(v, height)
 	return append(prefix, periodBz...)
 }
+
+// gets the prefix key for a delegator's auto-compounding delegations
+func GetDelegatorAutoCompoundPrefix(d sdk.AccAddress) []byte {
+	return append(DelegatorAutoCompoundPrefix, d.Bytes()...)
+}
+
+// gets the key for a delegation opted into auto-compounding
+func GetDelegatorAutoCompoundKey(d sdk.AccAddress, v sdk.ValAddress) []byte {
+	return append(GetDelegatorAutoCompoundPrefix(d), v.Bytes()...)
+}
+
+// gets the key for a community pool stream
+func GetCommunityPoolStreamKey(id uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, id)
+	return append(CommunityPoolStreamPrefix, b...)
+}
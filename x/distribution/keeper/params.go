@@ -1,18 +1,73 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 // type declaration for parameters
 func ParamKeyTable() params.KeyTable {
-	return params.NewKeyTable(
-		ParamStoreKeyCommunityTax, sdk.Dec{},
-		ParamStoreKeyBaseProposerReward, sdk.Dec{},
-		ParamStoreKeyBonusProposerReward, sdk.Dec{},
-		ParamStoreKeyWithdrawAddrEnabled, false,
-	)
+	return params.NewKeyTable().RegisterParamSet(&paramSet{})
+}
+
+// paramSet holds the distribution parameters for them to be validated
+// together after a parameter change proposal: the community tax and the
+// proposer rewards are all taken from the collected fees.
+type paramSet struct {
+	CommunityTax            sdk.Dec
+	BaseProposerReward      sdk.Dec
+	BonusProposerReward     sdk.Dec
+	WithdrawAddrEnabled     bool
+	AutoCompoundPeriod      int64
+	AutoCompoundMaxPerBlock int64
+}
+
+var _ params.ParamSetValidator = (*paramSet)(nil)
+
+// ParamSetPairs implements params.ParamSet
+func (p *paramSet) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(ParamStoreKeyCommunityTax, &p.CommunityTax, validateCommunityTax),
+		params.NewParamSetPair(ParamStoreKeyBaseProposerReward, &p.BaseProposerReward, validateBaseProposerReward),
+		params.NewParamSetPair(ParamStoreKeyBonusProposerReward, &p.BonusProposerReward, validateBonusProposerReward),
+		params.NewParamSetPair(ParamStoreKeyWithdrawAddrEnabled, &p.WithdrawAddrEnabled, validateWithdrawAddrEnabled),
+		params.NewParamSetPair(ParamStoreKeyAutoCompoundPeriod, &p.AutoCompoundPeriod, validateAutoCompoundPeriod),
+		params.NewParamSetPair(ParamStoreKeyAutoCompoundMaxPerBlock, &p.AutoCompoundMaxPerBlock, validateAutoCompoundMaxPerBlock),
+	}
+}
+
+// Validate implements params.ParamSetValidator
+func (p paramSet) Validate() error {
+	if err := validateCommunityTax(p.CommunityTax); err != nil {
+		return err
+	}
+	if err := validateBaseProposerReward(p.BaseProposerReward); err != nil {
+		return err
+	}
+	if err := validateBonusProposerReward(p.BonusProposerReward); err != nil {
+		return err
+	}
+	if err := validateAutoCompoundPeriod(p.AutoCompoundPeriod); err != nil {
+		return err
+	}
+	if err := validateAutoCompoundMaxPerBlock(p.AutoCompoundMaxPerBlock); err != nil {
+		return err
+	}
+
+	if p.CommunityTax.Add(p.BaseProposerReward).Add(p.BonusProposerReward).GT(sdk.OneDec()) {
+		return fmt.Errorf("distribution parameters CommunityTax, BaseProposerReward and "+
+			"BonusProposerReward cannot add to be greater than one, adds to %s",
+			p.CommunityTax.Add(p.BaseProposerReward).Add(p.BonusProposerReward))
+	}
+
+	if p.AutoCompoundPeriod > 0 && p.AutoCompoundMaxPerBlock <= 0 {
+		return fmt.Errorf("distribution parameter AutoCompoundMaxPerBlock should be positive "+
+			"when auto-compounding is enabled, is %d", p.AutoCompoundMaxPerBlock)
+	}
+
+	return nil
 }
 
 // returns the current CommunityTax rate from the global param store
@@ -23,9 +78,10 @@ func (k Keeper) GetCommunityTax(ctx sdk.Context) sdk.Dec {
 	return percent
 }
 
-// nolint: errcheck
 func (k Keeper) SetCommunityTax(ctx sdk.Context, percent sdk.Dec) {
-	k.paramSpace.Set(ctx, ParamStoreKeyCommunityTax, &percent)
+	if err := k.paramSpace.Set(ctx, ParamStoreKeyCommunityTax, &percent); err != nil {
+		panic(err)
+	}
 }
 
 // returns the current BaseProposerReward rate from the global param store
@@ -36,9 +92,10 @@ func (k Keeper) GetBaseProposerReward(ctx sdk.Context) sdk.Dec {
 	return percent
 }
 
-// nolint: errcheck
 func (k Keeper) SetBaseProposerReward(ctx sdk.Context, percent sdk.Dec) {
-	k.paramSpace.Set(ctx, ParamStoreKeyBaseProposerReward, &percent)
+	if err := k.paramSpace.Set(ctx, ParamStoreKeyBaseProposerReward, &percent); err != nil {
+		panic(err)
+	}
 }
 
 // returns the current BaseProposerReward rate from the global param store
@@ -49,9 +106,10 @@ func (k Keeper) GetBonusProposerReward(ctx sdk.Context) sdk.Dec {
 	return percent
 }
 
-// nolint: errcheck
 func (k Keeper) SetBonusProposerReward(ctx sdk.Context, percent sdk.Dec) {
-	k.paramSpace.Set(ctx, ParamStoreKeyBonusProposerReward, &percent)
+	if err := k.paramSpace.Set(ctx, ParamStoreKeyBonusProposerReward, &percent); err != nil {
+		panic(err)
+	}
 }
 
 // returns the current WithdrawAddrEnabled
@@ -62,7 +120,109 @@ func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) bool {
 	return enabled
 }
 
-// nolint: errcheck
 func (k Keeper) SetWithdrawAddrEnabled(ctx sdk.Context, enabled bool) {
-	k.paramSpace.Set(ctx, ParamStoreKeyWithdrawAddrEnabled, &enabled)
+	if err := k.paramSpace.Set(ctx, ParamStoreKeyWithdrawAddrEnabled, &enabled); err != nil {
+		panic(err)
+	}
+}
+
+// returns the number of blocks between the start of two auto-compounding passes
+// nolint: errcheck
+func (k Keeper) GetAutoCompoundPeriod(ctx sdk.Context) int64 {
+	var period int64
+	k.paramSpace.Get(ctx, ParamStoreKeyAutoCompoundPeriod, &period)
+	return period
+}
+
+func (k Keeper) SetAutoCompoundPeriod(ctx sdk.Context, period int64) {
+	if err := k.paramSpace.Set(ctx, ParamStoreKeyAutoCompoundPeriod, &period); err != nil {
+		panic(err)
+	}
+}
+
+// returns the maximum number of delegations auto-compounded in a single block
+// nolint: errcheck
+func (k Keeper) GetAutoCompoundMaxPerBlock(ctx sdk.Context) int64 {
+	var maxPerBlock int64
+	k.paramSpace.Get(ctx, ParamStoreKeyAutoCompoundMaxPerBlock, &maxPerBlock)
+	return maxPerBlock
+}
+
+func (k Keeper) SetAutoCompoundMaxPerBlock(ctx sdk.Context, maxPerBlock int64) {
+	if err := k.paramSpace.Set(ctx, ParamStoreKeyAutoCompoundMaxPerBlock, &maxPerBlock); err != nil {
+		panic(err)
+	}
+}
+
+func validateCommunityTax(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("distribution parameter CommunityTax should be non-negative and less than one, is %s", v)
+	}
+
+	return nil
+}
+
+func validateBaseProposerReward(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("distribution parameter BaseProposerReward should be non-negative and less than one, is %s", v)
+	}
+
+	return nil
+}
+
+func validateBonusProposerReward(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("distribution parameter BonusProposerReward should be non-negative and less than one, is %s", v)
+	}
+
+	return nil
+}
+
+func validateWithdrawAddrEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateAutoCompoundPeriod(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("distribution parameter AutoCompoundPeriod should be non-negative, is %d", v)
+	}
+
+	return nil
+}
+
+func validateAutoCompoundMaxPerBlock(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("distribution parameter AutoCompoundMaxPerBlock should be non-negative, is %d", v)
+	}
+
+	return nil
 }
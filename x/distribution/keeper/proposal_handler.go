@@ -18,3 +18,28 @@ func HandleCommunityPoolSpendProposal(ctx sdk.Context, k Keeper, p types.Communi
 	logger.Info(fmt.Sprintf("transferred %s from the community pool to recipient %s", p.Amount, p.Recipient))
 	return nil
 }
+
+// HandleCommunityPoolStreamProposal is a handler for executing a passed community pool stream proposal
+func HandleCommunityPoolStreamProposal(ctx sdk.Context, k Keeper, p types.CommunityPoolStreamProposal) sdk.Error {
+	stream, err := k.CreateCommunityPoolStream(ctx, p.Recipient, p.Amount, p.StartHeight, p.EndHeight)
+	if err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("created community pool stream %d of %s to recipient %s from height %d to %d",
+		stream.ID, p.Amount, p.Recipient, p.StartHeight, p.EndHeight))
+	return nil
+}
+
+// HandleCancelCommunityPoolStreamProposal is a handler for executing a passed community pool stream cancellation proposal
+func HandleCancelCommunityPoolStreamProposal(ctx sdk.Context, k Keeper, p types.CancelCommunityPoolStreamProposal) sdk.Error {
+	err := k.CancelCommunityPoolStream(ctx, p.StreamID)
+	if err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("cancelled community pool stream %d", p.StreamID))
+	return nil
+}
@@ -136,6 +136,84 @@ func getQueriedCommunityPool(t *testing.T, ctx sdk.Context, cdc *codec.Codec, qu
 	return
 }
 
+func getQueriedDelegatorAutoCompound(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier, delegatorAddr sdk.AccAddress) (validators []sdk.ValAddress) {
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryDelegatorAutoCompound}, "/"),
+		Data: cdc.MustMarshalJSON(types.NewQueryDelegatorParams(delegatorAddr)),
+	}
+
+	bz, err := querier(ctx, []string{types.QueryDelegatorAutoCompound}, query)
+	require.Nil(t, err)
+	require.Nil(t, cdc.UnmarshalJSON(bz, &validators))
+
+	return
+}
+
+func getQueriedCommunityPoolStreams(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier) (streams types.CommunityPoolStreams) {
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryCommunityPoolStreams}, "/"),
+		Data: []byte{},
+	}
+
+	bz, err := querier(ctx, []string{types.QueryCommunityPoolStreams}, query)
+	require.Nil(t, err)
+	require.Nil(t, cdc.UnmarshalJSON(bz, &streams))
+
+	return
+}
+
+func getQueriedCommunityPoolStream(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier, streamID uint64) (stream types.CommunityPoolStream) {
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryCommunityPoolStream}, "/"),
+		Data: cdc.MustMarshalJSON(types.NewQueryCommunityPoolStreamParams(streamID)),
+	}
+
+	bz, err := querier(ctx, []string{types.QueryCommunityPoolStream}, query)
+	require.Nil(t, err)
+	require.Nil(t, cdc.UnmarshalJSON(bz, &stream))
+
+	return
+}
+
+func getQueriedValidatorRewardRate(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier, validatorAddr sdk.ValAddress, fromHeight, toHeight int64) (rate types.ValidatorRewardRate) {
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryValidatorRewardRate}, "/"),
+		Data: cdc.MustMarshalJSON(types.NewQueryValidatorRewardRateParams(validatorAddr, fromHeight, toHeight)),
+	}
+
+	bz, err := querier(ctx, []string{types.QueryValidatorRewardRate}, query)
+	require.Nil(t, err)
+	require.Nil(t, cdc.UnmarshalJSON(bz, &rate))
+
+	return
+}
+
+func getQueriedValidatorAPR(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier, validatorAddr sdk.ValAddress) (apr types.ValidatorAPR) {
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryValidatorAPR}, "/"),
+		Data: cdc.MustMarshalJSON(types.NewQueryValidatorParams(validatorAddr)),
+	}
+
+	bz, err := querier(ctx, []string{types.QueryValidatorAPR}, query)
+	require.Nil(t, err)
+	require.Nil(t, cdc.UnmarshalJSON(bz, &apr))
+
+	return
+}
+
+func getQueriedDelegatorAccruedRewards(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier, delegatorAddr sdk.AccAddress) (response types.QueryDelegatorAccruedRewardsResponse) {
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryDelegatorAccruedRewards}, "/"),
+		Data: cdc.MustMarshalJSON(types.NewQueryDelegatorParams(delegatorAddr)),
+	}
+
+	bz, err := querier(ctx, []string{types.QueryDelegatorAccruedRewards}, query)
+	require.Nil(t, err)
+	require.Nil(t, cdc.UnmarshalJSON(bz, &response))
+
+	return
+}
+
 func TestQueries(t *testing.T) {
 	cdc := codec.New()
 	types.RegisterCodec(cdc)
@@ -211,7 +289,42 @@ func TestQueries(t *testing.T) {
 		[]types.DelegationDelegatorReward{expectedDelReward}, expectedDelReward.Reward)
 	require.Equal(t, wantDelRewards, delRewards)
 
+	// test delegator's accrued rewards query
+	accruedRewards := getQueriedDelegatorAccruedRewards(t, ctx, cdc, querier, sdk.AccAddress(valOpAddr1))
+	expectedAccruedReward := types.NewDelegationAccruedReward(valOpAddr1,
+		sdk.DecCoins{sdk.NewInt64DecCoin("stake", 5)}, sdk.NewDecWithPrec(5, 1))
+	wantAccruedRewards := types.NewQueryDelegatorAccruedRewardsResponse(
+		[]types.DelegationAccruedReward{expectedAccruedReward}, expectedAccruedReward.Reward)
+	require.Equal(t, wantAccruedRewards, accruedRewards)
+
+	// test validator reward rate and APR queries
+	rate := getQueriedValidatorRewardRate(t, ctx, cdc, querier, valOpAddr1, 1, 2)
+	require.Equal(t, types.NewValidatorRewardRate(valOpAddr1, 0, 1,
+		sdk.DecCoins{sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(5, 2))}), rate)
+	apr := getQueriedValidatorAPR(t, ctx, cdc, querier, valOpAddr1)
+	require.Equal(t, valOpAddr1, apr.ValidatorAddress)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), apr.CommissionRate)
+
 	// currently community pool hold nothing so we should return null
 	communityPool := getQueriedCommunityPool(t, ctx, cdc, querier)
 	require.Nil(t, communityPool)
+
+	// test delegator auto-compounding query
+	autoCompound := getQueriedDelegatorAutoCompound(t, ctx, cdc, querier, sdk.AccAddress(valOpAddr1))
+	require.Empty(t, autoCompound)
+	require.Nil(t, keeper.SetAutoCompound(ctx, sdk.AccAddress(valOpAddr1), valOpAddr1, true))
+	autoCompound = getQueriedDelegatorAutoCompound(t, ctx, cdc, querier, sdk.AccAddress(valOpAddr1))
+	require.Equal(t, []sdk.ValAddress{valOpAddr1}, autoCompound)
+
+	// test community pool stream queries
+	require.Empty(t, getQueriedCommunityPoolStreams(t, ctx, cdc, querier))
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	stream, err := keeper.CreateCommunityPoolStream(ctx, delAddr1, amount, ctx.BlockHeight(), ctx.BlockHeight()+10)
+	require.Nil(t, err)
+	streams := getQueriedCommunityPoolStreams(t, ctx, cdc, querier)
+	require.Len(t, streams, 1)
+	require.Equal(t, stream.ID, streams[0].ID)
+	queriedStream := getQueriedCommunityPoolStream(t, ctx, cdc, querier, stream.ID)
+	require.Equal(t, stream.Recipient, queriedStream.Recipient)
+	require.Equal(t, stream.Amount, queriedStream.Amount)
 }
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/exported"
+)
+
+// GetValidatorCumulativeRewardRatio returns the rewards a validator's
+// delegations have earned per token since the validator was created, including
+// the rewards of the current period. The difference between the ratios at two
+// heights is the reward rate per token between those heights.
+func (k Keeper) GetValidatorCumulativeRewardRatio(ctx sdk.Context, val exported.ValidatorI) sdk.DecCoins {
+	rewards := k.GetValidatorCurrentRewards(ctx, val.GetOperator())
+	historical := k.GetValidatorHistoricalRewards(ctx, val.GetOperator(), rewards.Period-1).CumulativeRewardRatio
+
+	// the current period is added the same way it will be once it ends
+	if val.GetTokens().IsZero() {
+		return historical
+	}
+	return historical.Add(rewards.Rewards.QuoDecTruncate(val.GetTokens().ToDec()))
+}
+
+// GetValidatorRewardRate returns the rewards per token a validator's
+// delegations earned between two heights, computed from the cumulative reward
+// ratios of the validator's stored historical rewards periods. Each height is
+// matched with the last stored period that ended at or before it, and a height
+// not lower than the current one with the current period. Periods which are no
+// longer referenced are pruned, so the returned rate may cover a wider range
+// than requested; the heights of the returned rate are the ones it covers.
+func (k Keeper) GetValidatorRewardRate(ctx sdk.Context, val exported.ValidatorI,
+	fromHeight, toHeight int64) (types.ValidatorRewardRate, sdk.Error) {
+
+	if fromHeight <= 0 || toHeight <= fromHeight {
+		return types.ValidatorRewardRate{}, sdk.ErrUnknownRequest(fmt.Sprintf(
+			"invalid height range, from height %d must be positive and lower than to height %d", fromHeight, toHeight))
+	}
+
+	fromRatio, fromPeriodHeight, found := k.getValidatorRewardRatioAt(ctx, val.GetOperator(), fromHeight)
+	if !found {
+		return types.ValidatorRewardRate{}, sdk.ErrUnknownRequest(fmt.Sprintf(
+			"no historical rewards of validator %s are stored at or before height %d", val.GetOperator(), fromHeight))
+	}
+
+	toRatio, toPeriodHeight := k.GetValidatorCumulativeRewardRatio(ctx, val), ctx.BlockHeight()
+	if toHeight < ctx.BlockHeight() {
+		// the ratio of the period that ended at or before the from height is
+		// a lower bound, so a stored period is always found
+		toRatio, toPeriodHeight, _ = k.getValidatorRewardRatioAt(ctx, val.GetOperator(), toHeight)
+	}
+
+	rate, hasNeg := toRatio.SafeSub(fromRatio)
+	if hasNeg {
+		return types.ValidatorRewardRate{}, sdk.ErrInternal(fmt.Sprintf(
+			"reward ratio of validator %s decreased between heights %d and %d", val.GetOperator(), fromPeriodHeight, toPeriodHeight))
+	}
+
+	return types.NewValidatorRewardRate(val.GetOperator(), fromPeriodHeight, toPeriodHeight, rate), nil
+}
+
+// getValidatorRewardRatioAt returns the cumulative reward ratio and the end
+// height of the last stored historical rewards period of a validator that
+// ended at or before the given height. The periods are keyed in little endian,
+// which doesn't iterate them in order, so all of them are compared.
+//
+// Periods stored before their end height was recorded decode with a zero
+// height. No rewards are allocated before the first block, so a period that
+// really ended at height zero has an empty ratio; a zero height period with
+// rewards is one of those and is skipped, as its end height is unknown.
+func (k Keeper) getValidatorRewardRatioAt(ctx sdk.Context, val sdk.ValAddress, height int64) (
+	ratio sdk.DecCoins, periodHeight int64, found bool) {
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, GetValidatorHistoricalRewardsPrefix(val))
+	defer iter.Close()
+
+	var lastPeriod uint64
+	for ; iter.Valid(); iter.Next() {
+		var rewards types.ValidatorHistoricalRewards
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &rewards)
+		if rewards.Height > height || (rewards.Height == 0 && !rewards.CumulativeRewardRatio.IsZero()) {
+			continue
+		}
+
+		// the later of the periods that ended at the same height
+		_, period := GetValidatorHistoricalRewardsAddressPeriod(iter.Key())
+		if found && (rewards.Height < periodHeight || (rewards.Height == periodHeight && period < lastPeriod)) {
+			continue
+		}
+		ratio, periodHeight, lastPeriod, found = rewards.CumulativeRewardRatio, rewards.Height, period, true
+	}
+
+	return ratio, periodHeight, found
+}
+
+// GetValidatorAPR projects the annual rewards per bonded token of a
+// validator's delegations from the annual provisions sent to the fee collector
+// and the bonded ratio, net of the community tax and the validator's
+// commission. Fees and proposer rewards are not taken into account.
+func (k Keeper) GetValidatorAPR(ctx sdk.Context, val exported.ValidatorI) types.ValidatorAPR {
+	annualProvisions := k.mintKeeper.GetMinter(ctx).AnnualProvisions
+	stakingProvisions := annualProvisions.Mul(k.mintKeeper.FeeCollectorShare(ctx))
+	bondedRatio := k.stakingKeeper.BondedRatio(ctx)
+	communityTax := k.GetCommunityTax(ctx)
+	commissionRate := val.GetCommission()
+
+	apr := sdk.ZeroDec()
+	bondedTokens := bondedRatio.MulInt(k.stakingKeeper.StakingTokenSupply(ctx))
+	if bondedTokens.IsPositive() {
+		apr = stakingProvisions.Mul(sdk.OneDec().Sub(communityTax)).Quo(bondedTokens).
+			Mul(sdk.OneDec().Sub(commissionRate))
+	}
+
+	return types.ValidatorAPR{
+		ValidatorAddress: val.GetOperator(),
+		AnnualProvisions: annualProvisions,
+		BondedRatio:      bondedRatio,
+		CommunityTax:     communityTax,
+		CommissionRate:   commissionRate,
+		APR:              apr,
+	}
+}
@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func TestGetValidatorCumulativeRewardRatio(t *testing.T) {
+	ctx, _, k, sk, _ := CreateTestInputDefault(t, false, 1000)
+	sh := staking.NewHandler(sk)
+
+	// create validator with 50% commission
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), staking.Description{}, commission, sdk.OneInt())
+	require.True(t, sh(ctx, msg).IsOK())
+	staking.EndBlocker(ctx, sk)
+	ctx = ctx.WithBlockHeight(1)
+
+	val := sk.Validator(ctx, valOpAddr1)
+	require.True(t, k.GetValidatorCumulativeRewardRatio(ctx, val).IsZero())
+
+	// the rewards of the current period are included
+	k.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(10))})
+	expected := sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(5, 2))}
+	require.Equal(t, expected, k.GetValidatorCumulativeRewardRatio(ctx, val))
+
+	// a new delegation ends the period without changing the ratio
+	delegate := staking.NewMsgDelegate(delAddr1, valOpAddr1, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	require.True(t, sh(ctx, delegate).IsOK())
+	val = sk.Validator(ctx, valOpAddr1)
+	require.Equal(t, expected, k.GetValidatorCumulativeRewardRatio(ctx, val))
+
+	// rewards of the next period are earned on the new tokens
+	k.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(20))})
+	expected = sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 1))}
+	require.Equal(t, expected, k.GetValidatorCumulativeRewardRatio(ctx, val))
+}
+
+func TestGetValidatorRewardRate(t *testing.T) {
+	ctx, _, k, sk, _ := CreateTestInputDefault(t, false, 1000)
+	sh := staking.NewHandler(sk)
+
+	// create validator with 50% commission at height 1
+	ctx = ctx.WithBlockHeight(1)
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), staking.Description{}, commission, sdk.OneInt())
+	require.True(t, sh(ctx, msg).IsOK())
+	staking.EndBlocker(ctx, sk)
+
+	// earn 0.05 per token at height 2
+	ctx = ctx.WithBlockHeight(2)
+	val := sk.Validator(ctx, valOpAddr1)
+	k.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(10))})
+
+	// a new delegation ends the period at height 3
+	ctx = ctx.WithBlockHeight(3)
+	delegate := staking.NewMsgDelegate(delAddr1, valOpAddr1, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	require.True(t, sh(ctx, delegate).IsOK())
+
+	// earn another 0.05 per token at height 4
+	ctx = ctx.WithBlockHeight(4)
+	val = sk.Validator(ctx, valOpAddr1)
+	k.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(20))})
+
+	ctx = ctx.WithBlockHeight(5)
+	ratio := func(dec sdk.Dec) sdk.DecCoins { return sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, dec)} }
+
+	tests := []struct {
+		fromHeight, toHeight int64
+		expected             types.ValidatorRewardRate
+	}{
+		// up to the current period
+		{1, 5, types.NewValidatorRewardRate(valOpAddr1, 1, 5, ratio(sdk.NewDecWithPrec(1, 1)))},
+		{3, 10, types.NewValidatorRewardRate(valOpAddr1, 3, 5, ratio(sdk.NewDecWithPrec(5, 2)))},
+		// the heights are widened to the stored periods
+		{2, 3, types.NewValidatorRewardRate(valOpAddr1, 1, 3, ratio(sdk.NewDecWithPrec(5, 2)))},
+		{2, 4, types.NewValidatorRewardRate(valOpAddr1, 1, 3, ratio(sdk.NewDecWithPrec(5, 2)))},
+	}
+
+	for _, tc := range tests {
+		rate, err := k.GetValidatorRewardRate(ctx, val, tc.fromHeight, tc.toHeight)
+		require.NoError(t, err, "from %d to %d", tc.fromHeight, tc.toHeight)
+		require.Equal(t, tc.expected, rate, "from %d to %d", tc.fromHeight, tc.toHeight)
+	}
+
+	// invalid height ranges are rejected
+	_, err := k.GetValidatorRewardRate(ctx, val, 0, 5)
+	require.Error(t, err)
+	_, err = k.GetValidatorRewardRate(ctx, val, 3, 3)
+	require.Error(t, err)
+
+	// no period of a validator created later is stored at the from height
+	msg = staking.NewMsgCreateValidator(valOpAddr2, valConsPk2,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), staking.Description{}, commission, sdk.OneInt())
+	require.True(t, sh(ctx, msg).IsOK())
+	_, err = k.GetValidatorRewardRate(ctx, sk.Validator(ctx, valOpAddr2), 4, 5)
+	require.Error(t, err)
+}
+
+func TestGetValidatorRewardRatioAtManyPeriods(t *testing.T) {
+	ctx, _, k, _, _ := CreateTestInputDefault(t, false, 1000)
+	ratio := func(i int64) sdk.DecCoins { return sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(i))} }
+
+	// period p ends at height 10p, past the 255 periods a single little
+	// endian key byte can order
+	for p := int64(1); p <= 300; p++ {
+		k.SetValidatorHistoricalRewards(ctx, valOpAddr1, uint64(p), types.NewValidatorHistoricalRewards(ratio(p), 1, 10*p))
+	}
+	// a period stored before the end heights were recorded
+	k.SetValidatorHistoricalRewards(ctx, valOpAddr1, 301, types.NewValidatorHistoricalRewards(ratio(1000), 1, 0))
+
+	tests := []struct {
+		height    int64
+		expRatio  sdk.DecCoins
+		expHeight int64
+		expFound  bool
+	}{
+		{5, nil, 0, false},
+		{10, ratio(1), 10, true},
+		{2565, ratio(256), 2560, true},
+		{2570, ratio(257), 2570, true},
+		{10000, ratio(300), 3000, true},
+	}
+
+	for _, tc := range tests {
+		r, height, found := k.getValidatorRewardRatioAt(ctx, valOpAddr1, tc.height)
+		require.Equal(t, tc.expFound, found, "height %d", tc.height)
+		require.Equal(t, tc.expRatio, r, "height %d", tc.height)
+		require.Equal(t, tc.expHeight, height, "height %d", tc.height)
+	}
+}
+
+func TestGetValidatorAPR(t *testing.T) {
+	ctx, _, k, sk, _ := CreateTestInputDefault(t, false, 1000)
+	sh := staking.NewHandler(sk)
+
+	// bond a tenth of the staking token supply
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(600)), staking.Description{}, commission, sdk.OneInt())
+	require.True(t, sh(ctx, msg).IsOK())
+	staking.EndBlocker(ctx, sk)
+
+	val := sk.Validator(ctx, valOpAddr1)
+	apr := k.GetValidatorAPR(ctx, val)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), apr.BondedRatio)
+	require.True(t, apr.APR.IsZero())
+
+	minter := mint.DefaultInitialMinter()
+	minter.AnnualProvisions = sdk.TokensFromConsensusPower(120).ToDec()
+	k.mintKeeper.(mint.Keeper).SetMinter(ctx, minter)
+
+	// 120 / 600 provisions per bonded token, net of 2% community tax and 50% commission
+	apr = k.GetValidatorAPR(ctx, val)
+	require.Equal(t, minter.AnnualProvisions, apr.AnnualProvisions)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), apr.CommissionRate)
+	require.Equal(t, sdk.NewDecWithPrec(98, 3), apr.APR)
+
+	// only the provisions sent to the fee collector reward the delegators
+	mintKeeper := k.mintKeeper.(mint.Keeper)
+	params := mintKeeper.GetParams(ctx)
+	params.Destinations = mint.Destinations{
+		mint.NewModuleDestination(auth.FeeCollectorName, sdk.NewDecWithPrec(5, 1)),
+		mint.NewCommunityPoolDestination(sdk.NewDecWithPrec(5, 1)),
+	}
+	mintKeeper.SetParams(ctx, params)
+
+	apr = k.GetValidatorAPR(ctx, val)
+	require.Equal(t, minter.AnnualProvisions, apr.AnnualProvisions)
+	require.Equal(t, sdk.NewDecWithPrec(49, 3), apr.APR)
+}
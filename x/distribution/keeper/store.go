@@ -377,3 +377,124 @@ func (k Keeper) DeleteAllValidatorSlashEvents(ctx sdk.Context) {
 		store.Delete(iter.Key())
 	}
 }
+
+// check whether a delegation is opted into auto-compounding
+func (k Keeper) HasDelegatorAutoCompound(ctx sdk.Context, del sdk.AccAddress, val sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetDelegatorAutoCompoundKey(del, val))
+}
+
+// opt a delegation into auto-compounding
+func (k Keeper) SetDelegatorAutoCompound(ctx sdk.Context, del sdk.AccAddress, val sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetDelegatorAutoCompoundKey(del, val), []byte{0x01})
+}
+
+// opt a delegation out of auto-compounding
+func (k Keeper) DeleteDelegatorAutoCompound(ctx sdk.Context, del sdk.AccAddress, val sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetDelegatorAutoCompoundKey(del, val))
+}
+
+// iterate over the delegations opted into auto-compounding
+func (k Keeper) IterateDelegatorAutoCompounds(ctx sdk.Context, handler func(del sdk.AccAddress, val sdk.ValAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, DelegatorAutoCompoundPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		del, val := GetDelegatorAutoCompoundAddresses(iter.Key())
+		if handler(del, val) {
+			break
+		}
+	}
+}
+
+// get the validators of a delegator's delegations opted into auto-compounding
+func (k Keeper) GetDelegatorAutoCompoundValidators(ctx sdk.Context, del sdk.AccAddress) (vals []sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, GetDelegatorAutoCompoundPrefix(del))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, val := GetDelegatorAutoCompoundAddresses(iter.Key())
+		vals = append(vals, val)
+	}
+	return vals
+}
+
+// get the key of the last delegation processed by the running auto-compounding
+// pass, if a pass is running
+func (k Keeper) GetAutoCompoundCursor(ctx sdk.Context) (cursor []byte, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(AutoCompoundCursorKey) {
+		return nil, false
+	}
+	return store.Get(AutoCompoundCursorKey), true
+}
+
+// set the key of the last delegation processed by the running auto-compounding pass
+func (k Keeper) SetAutoCompoundCursor(ctx sdk.Context, cursor []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(AutoCompoundCursorKey, cursor)
+}
+
+// delete the auto-compounding cursor, ending the running pass
+func (k Keeper) DeleteAutoCompoundCursor(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(AutoCompoundCursorKey)
+}
+
+// get a community pool stream
+func (k Keeper) GetCommunityPoolStream(ctx sdk.Context, id uint64) (stream types.CommunityPoolStream, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(GetCommunityPoolStreamKey(id))
+	if b == nil {
+		return stream, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &stream)
+	return stream, true
+}
+
+// set a community pool stream
+func (k Keeper) SetCommunityPoolStream(ctx sdk.Context, stream types.CommunityPoolStream) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(stream)
+	store.Set(GetCommunityPoolStreamKey(stream.ID), b)
+}
+
+// delete a community pool stream
+func (k Keeper) DeleteCommunityPoolStream(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetCommunityPoolStreamKey(id))
+}
+
+// iterate over the community pool streams in ascending ID order
+func (k Keeper) IterateCommunityPoolStreams(ctx sdk.Context, handler func(stream types.CommunityPoolStream) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, CommunityPoolStreamPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var stream types.CommunityPoolStream
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &stream)
+		if handler(stream) {
+			break
+		}
+	}
+}
+
+// get the ID of the next community pool stream
+func (k Keeper) GetNextCommunityPoolStreamID(ctx sdk.Context) (id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(NextCommunityPoolStreamIDKey)
+	if b == nil {
+		panic("Next community pool stream ID not set")
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &id)
+	return
+}
+
+// set the ID of the next community pool stream
+func (k Keeper) SetNextCommunityPoolStreamID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(id)
+	store.Set(NextCommunityPoolStreamIDKey, b)
+}
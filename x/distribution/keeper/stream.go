@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// CreateCommunityPoolStream schedules a new stream paying out an amount from
+// the community pool to a recipient over the given heights
+func (k Keeper) CreateCommunityPoolStream(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins,
+	startHeight, endHeight int64) (types.CommunityPoolStream, sdk.Error) {
+
+	if endHeight < ctx.BlockHeight() {
+		return types.CommunityPoolStream{}, types.ErrStreamEnded(k.codespace)
+	}
+
+	id := k.GetNextCommunityPoolStreamID(ctx)
+	stream := types.NewCommunityPoolStream(id, recipient, amount, startHeight, endHeight)
+	k.SetCommunityPoolStream(ctx, stream)
+	k.SetNextCommunityPoolStreamID(ctx, id+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateCommunityPoolStream,
+			sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return stream, nil
+}
+
+// CancelCommunityPoolStream stops a stream, the amount paid out so far stays
+// with the recipient
+func (k Keeper) CancelCommunityPoolStream(ctx sdk.Context, id uint64) sdk.Error {
+	stream, found := k.GetCommunityPoolStream(ctx, id)
+	if !found {
+		return types.ErrUnknownStream(k.codespace)
+	}
+	k.DeleteCommunityPoolStream(ctx, id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelCommunityPoolStream,
+			sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyPaid, stream.Paid.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetCommunityPoolStreams returns all streams that have not completed or been
+// stopped yet, including the ones that have not started yet
+func (k Keeper) GetCommunityPoolStreams(ctx sdk.Context) types.CommunityPoolStreams {
+	streams := types.CommunityPoolStreams{}
+	k.IterateCommunityPoolStreams(ctx, func(stream types.CommunityPoolStream) (stop bool) {
+		streams = append(streams, stream)
+		return false
+	})
+	return streams
+}
+
+// ProcessCommunityPoolStreams pays out the amount due in the current block for
+// each running stream. Streams are removed once they complete, or stopped when
+// the community pool can no longer cover the amount due.
+func (k Keeper) ProcessCommunityPoolStreams(ctx sdk.Context) {
+	height := ctx.BlockHeight()
+	logger := k.Logger(ctx)
+
+	for _, stream := range k.GetCommunityPoolStreams(ctx) {
+		due := stream.AmountDue(height)
+		if !due.IsZero() {
+			if err := k.DistributeFromFeePool(ctx, due, stream.Recipient); err != nil {
+				k.DeleteCommunityPoolStream(ctx, stream.ID)
+				logger.Info(fmt.Sprintf("stopped community pool stream %d to recipient %s after paying out %s: %s",
+					stream.ID, stream.Recipient, stream.Paid, err))
+
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						types.EventTypeStopCommunityPoolStream,
+						sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", stream.ID)),
+						sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient.String()),
+						sdk.NewAttribute(types.AttributeKeyPaid, stream.Paid.String()),
+						sdk.NewAttribute(types.AttributeKeyAmount, due.String()),
+					),
+				)
+				continue
+			}
+
+			stream.Paid = stream.Paid.Add(due)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeCommunityPoolStreamPayout,
+					sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", stream.ID)),
+					sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient.String()),
+					sdk.NewAttribute(types.AttributeKeyAmount, due.String()),
+				),
+			)
+		}
+
+		if height < stream.EndHeight {
+			if !due.IsZero() {
+				k.SetCommunityPoolStream(ctx, stream)
+			}
+			continue
+		}
+
+		k.DeleteCommunityPoolStream(ctx, stream.ID)
+		logger.Info(fmt.Sprintf("completed community pool stream %d, transferred %s to recipient %s",
+			stream.ID, stream.Paid, stream.Recipient))
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCompleteCommunityPoolStream,
+				sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", stream.ID)),
+				sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient.String()),
+				sdk.NewAttribute(types.AttributeKeyPaid, stream.Paid.String()),
+			),
+		)
+	}
+}
@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// fund the community pool and the distribution module account holding it
+func fundCommunityPool(ctx sdk.Context, k Keeper, amount sdk.Coins) {
+	distrAcc := k.GetDistributionAccount(ctx)
+	distrAcc.SetCoins(distrAcc.GetCoins().Add(amount))
+	k.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoins(amount))
+	k.SetFeePool(ctx, feePool)
+}
+
+func countEvents(ctx sdk.Context, eventType string) (count int) {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			count++
+		}
+	}
+	return count
+}
+
+func TestCreateCommunityPoolStream(t *testing.T) {
+	ctx, _, k, _, _ := CreateTestInputDefault(t, false, 1000)
+	ctx = ctx.WithBlockHeight(10)
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+
+	// streams that already ended are rejected
+	_, err := k.CreateCommunityPoolStream(ctx, delAddr1, amount, 5, 9)
+	require.NotNil(t, err)
+	require.Empty(t, k.GetCommunityPoolStreams(ctx))
+
+	stream, err := k.CreateCommunityPoolStream(ctx, delAddr1, amount, 5, 20)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), stream.ID)
+	require.Equal(t, uint64(2), k.GetNextCommunityPoolStreamID(ctx))
+
+	stored, found := k.GetCommunityPoolStream(ctx, stream.ID)
+	require.True(t, found)
+	require.Equal(t, delAddr1, stored.Recipient)
+	require.Equal(t, amount, stored.Amount)
+	require.True(t, stored.Paid.IsZero())
+	require.Len(t, k.GetCommunityPoolStreams(ctx), 1)
+
+	// cancelling removes the stream
+	require.Nil(t, k.CancelCommunityPoolStream(ctx, stream.ID))
+	_, found = k.GetCommunityPoolStream(ctx, stream.ID)
+	require.False(t, found)
+
+	err = k.CancelCommunityPoolStream(ctx, stream.ID)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeUnknownStream, err.Code())
+}
+
+func TestProcessCommunityPoolStreams(t *testing.T) {
+	ctx, ak, k, _, _ := CreateTestInputDefault(t, false, 1000)
+	fundCommunityPool(ctx, k, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))))
+
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	stream, err := k.CreateCommunityPoolStream(ctx, delAddr1, amount, 10, 12)
+	require.Nil(t, err)
+	balance := ak.GetAccount(ctx, delAddr1).GetCoins()
+
+	// nothing is paid out before the stream starts
+	ctx = ctx.WithBlockHeight(9).WithEventManager(sdk.NewEventManager())
+	k.ProcessCommunityPoolStreams(ctx)
+	require.Equal(t, 0, countEvents(ctx, types.EventTypeCommunityPoolStreamPayout))
+	require.Equal(t, balance, ak.GetAccount(ctx, delAddr1).GetCoins())
+
+	// the amount is paid out pro-rata, rounding down
+	expected := []int64{33, 66, 100}
+	for i, height := range []int64{10, 11, 12} {
+		ctx = ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		k.ProcessCommunityPoolStreams(ctx)
+		require.Equal(t, 1, countEvents(ctx, types.EventTypeCommunityPoolStreamPayout))
+
+		paid := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(expected[i])))
+		require.Equal(t, balance.Add(paid), ak.GetAccount(ctx, delAddr1).GetCoins())
+	}
+
+	// the stream is removed once completed
+	require.Equal(t, 1, countEvents(ctx, types.EventTypeCompleteCommunityPoolStream))
+	_, found := k.GetCommunityPoolStream(ctx, stream.ID)
+	require.False(t, found)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(900)))),
+		k.GetFeePoolCommunityCoins(ctx))
+}
+
+func TestProcessCommunityPoolStreamsPoolRunsDry(t *testing.T) {
+	ctx, ak, k, _, _ := CreateTestInputDefault(t, false, 1000)
+	fundCommunityPool(ctx, k, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(60))))
+
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	stream, err := k.CreateCommunityPoolStream(ctx, delAddr1, amount, 1, 2)
+	require.Nil(t, err)
+	balance := ak.GetAccount(ctx, delAddr1).GetCoins()
+
+	ctx = ctx.WithBlockHeight(1).WithEventManager(sdk.NewEventManager())
+	k.ProcessCommunityPoolStreams(ctx)
+	paid := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50)))
+	require.Equal(t, balance.Add(paid), ak.GetAccount(ctx, delAddr1).GetCoins())
+
+	// the pool can't cover the second payout, so the stream stops
+	ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+	k.ProcessCommunityPoolStreams(ctx)
+	require.Equal(t, 1, countEvents(ctx, types.EventTypeStopCommunityPoolStream))
+	require.Equal(t, 0, countEvents(ctx, types.EventTypeCommunityPoolStreamPayout))
+	require.Equal(t, balance.Add(paid), ak.GetAccount(ctx, delAddr1).GetCoins())
+	require.Equal(t, sdk.NewDecCoins(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10)))),
+		k.GetFeePoolCommunityCoins(ctx))
+
+	_, found := k.GetCommunityPoolStream(ctx, stream.ID)
+	require.False(t, found)
+}
@@ -64,3 +64,29 @@ func TestProposalHandlerFailed(t *testing.T) {
 	require.Error(t, hdlr(ctx, tp))
 	require.True(t, accountKeeper.GetAccount(ctx, recipient).GetCoins().IsZero())
 }
+
+func TestStreamProposalHandler(t *testing.T) {
+	ctx, _, keeper, _, _ := CreateTestInputDefault(t, false, 10)
+	ctx = ctx.WithBlockHeight(10)
+	hdlr := NewCommunityPoolSpendProposalHandler(keeper)
+
+	// a stream can't end before the proposal passes
+	tp := types.NewCommunityPoolStreamProposal("Test", "description", delAddr1, amount, 1, 9)
+	require.Error(t, hdlr(ctx, tp))
+	require.Empty(t, keeper.GetCommunityPoolStreams(ctx))
+
+	tp = types.NewCommunityPoolStreamProposal("Test", "description", delAddr1, amount, 20, 30)
+	require.NoError(t, hdlr(ctx, tp))
+	streams := keeper.GetCommunityPoolStreams(ctx)
+	require.Len(t, streams, 1)
+	require.Equal(t, delAddr1, streams[0].Recipient)
+	require.Equal(t, int64(20), streams[0].StartHeight)
+	require.Equal(t, int64(30), streams[0].EndHeight)
+
+	cp := types.NewCancelCommunityPoolStreamProposal("Test", "description", streams[0].ID)
+	require.NoError(t, hdlr(ctx, cp))
+	require.Empty(t, keeper.GetCommunityPoolStreams(ctx))
+
+	// the stream is gone
+	require.Error(t, hdlr(ctx, cp))
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	govsim "github.com/cosmos/cosmos-sdk/x/gov/simulation"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
 // SimulateMsgSetWithdrawAddress
@@ -87,6 +88,76 @@ func SimulateMsgWithdrawValidatorCommission(m auth.AccountKeeper, k distribution
 	}
 }
 
+// SimulateMsgSetAutoCompound
+func SimulateMsgSetAutoCompound(m auth.AccountKeeper, sk staking.Keeper, k distribution.Keeper) simulation.Operation {
+	handler := distribution.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		delegatorAccount := simulation.RandomAcc(r, accs)
+
+		// prefer one of the delegator's existing delegations
+		valAddr := sdk.ValAddress(simulation.RandomAcc(r, accs).Address)
+		delegations := sk.GetDelegatorDelegations(ctx, delegatorAccount.Address, 10)
+		if len(delegations) > 0 {
+			valAddr = delegations[r.Intn(len(delegations))].ValidatorAddress
+		}
+
+		msg := distribution.NewMsgSetAutoCompound(delegatorAccount.Address, valAddr, r.Intn(5) > 0)
+
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := handler(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgFundCommunityPool
+func SimulateMsgFundCommunityPool(m auth.AccountKeeper, k distribution.Keeper) simulation.Operation {
+	handler := distribution.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		depositorAccount := simulation.RandomAcc(r, accs)
+		spendable := m.GetAccount(ctx, depositorAccount.Address).SpendableCoins(ctx.BlockHeader().Time)
+		if spendable.Empty() {
+			return simulation.NoOpMsg(), nil, nil
+		}
+
+		coin := spendable[r.Intn(len(spendable))]
+		amount := coin.Amount
+		if amount.GT(sdk.OneInt()) {
+			amount, err = simulation.RandPositiveInt(r, coin.Amount)
+			if err != nil {
+				return simulation.NoOpMsg(), nil, err
+			}
+		}
+
+		msg := distribution.NewMsgFundCommunityPool(sdk.NewCoins(sdk.NewCoin(coin.Denom, amount)), depositorAccount.Address)
+
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := handler(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
 // SimulateCommunityPoolSpendProposalContent generates random community-pool-spend proposal content
 func SimulateCommunityPoolSpendProposalContent(k distribution.Keeper) govsim.ContentSimulator {
 	return func(r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) gov.Content {
@@ -109,3 +180,51 @@ func SimulateCommunityPoolSpendProposalContent(k distribution.Keeper) govsim.Con
 		)
 	}
 }
+
+// SimulateCommunityPoolStreamProposalContent generates random community-pool-stream proposal content
+func SimulateCommunityPoolStreamProposalContent(k distribution.Keeper) govsim.ContentSimulator {
+	return func(r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) gov.Content {
+		recipientAcc := simulation.RandomAcc(r, accs)
+		coins := sdk.Coins{}
+		balance := k.GetFeePool(ctx).CommunityPool
+		if len(balance) > 0 {
+			denomIndex := r.Intn(len(balance))
+			amount, goErr := simulation.RandPositiveInt(r, balance[denomIndex].Amount.TruncateInt())
+			if goErr == nil {
+				denom := balance[denomIndex].Denom
+				coins = sdk.NewCoins(sdk.NewCoin(denom, amount.Mul(sdk.NewInt(2))))
+			}
+		}
+		// stream an amount the pool might not be able to cover if it's empty
+		if coins.Empty() {
+			coins = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(r.Intn(1000)+1)))
+		}
+		startHeight := ctx.BlockHeight() + int64(r.Intn(50))
+		endHeight := startHeight + int64(r.Intn(50))
+		return distribution.NewCommunityPoolStreamProposal(
+			simulation.RandStringOfLength(r, 10),
+			simulation.RandStringOfLength(r, 100),
+			recipientAcc.Address,
+			coins,
+			startHeight,
+			endHeight,
+		)
+	}
+}
+
+// SimulateCancelCommunityPoolStreamProposalContent generates random cancel-community-pool-stream proposal content
+func SimulateCancelCommunityPoolStreamProposalContent(k distribution.Keeper) govsim.ContentSimulator {
+	return func(r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, _ []simulation.Account) gov.Content {
+		// cancel an unknown stream if there is no active one
+		streamID := uint64(r.Intn(100))
+		streams := k.GetCommunityPoolStreams(ctx)
+		if len(streams) > 0 {
+			streamID = streams[r.Intn(len(streams))].ID
+		}
+		return distribution.NewCancelCommunityPoolStreamProposal(
+			simulation.RandStringOfLength(r, 10),
+			simulation.RandStringOfLength(r, 100),
+			streamID,
+		)
+	}
+}
@@ -13,6 +13,8 @@ const (
 	CodeNoDistributionInfo      CodeType          = 104
 	CodeNoValidatorCommission   CodeType          = 105
 	CodeSetWithdrawAddrDisabled CodeType          = 106
+	CodeNoDelegation            CodeType          = 107
+	CodeUnknownStream           CodeType          = 108
 )
 
 func ErrNilDelegatorAddr(codespace sdk.CodespaceType) sdk.Error {
@@ -45,3 +47,15 @@ func ErrInvalidProposalAmount(codespace sdk.CodespaceType) sdk.Error {
 func ErrEmptyProposalRecipient(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidInput, "invalid community pool spend proposal recipient")
 }
+func ErrNoDelegation(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoDelegation, "delegation does not exist")
+}
+func ErrInvalidStreamHeights(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "community pool stream must start at a positive height and end no earlier than it starts")
+}
+func ErrStreamEnded(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "community pool stream ends before the current height")
+}
+func ErrUnknownStream(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownStream, "unknown community pool stream")
+}
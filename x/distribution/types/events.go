@@ -8,10 +8,25 @@ var (
 	EventTypeWithdrawRewards    = "withdraw_rewards"
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
+	EventTypeSetAutoCompound    = "set_auto_compound"
+	EventTypeAutoCompound       = "auto_compound"
+	EventTypeFundCommunityPool  = "fund_community_pool"
+
+	EventTypeCreateCommunityPoolStream   = "create_community_pool_stream"
+	EventTypeCancelCommunityPoolStream   = "cancel_community_pool_stream"
+	EventTypeCommunityPoolStreamPayout   = "community_pool_stream_payout"
+	EventTypeCompleteCommunityPoolStream = "complete_community_pool_stream"
+	EventTypeStopCommunityPoolStream     = "stop_community_pool_stream"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyAmount          = "amount"
 	AttributeKeyValidator       = "validator"
+	AttributeKeyDelegator       = "delegator"
+	AttributeKeyEnabled         = "enabled"
+	AttributeKeyDepositor       = "depositor"
+	AttributeKeyStreamID        = "stream_id"
+	AttributeKeyRecipient       = "recipient"
+	AttributeKeyPaid            = "paid"
 
 	AttributeValueCategory = ModuleName
 )
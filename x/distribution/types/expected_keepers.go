@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
 	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
@@ -43,6 +44,17 @@ type StakingKeeper interface {
 	GetLastValidatorPower(ctx sdk.Context, valAddr sdk.ValAddress) int64
 
 	GetAllSDKDelegations(ctx sdk.Context) []staking.Delegation
+
+	// used to re-delegate auto-compounded rewards
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator staking.Validator, found bool)
+	Delegate(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.Int, tokenSrc sdk.BondStatus,
+		validator staking.Validator, subtractAccount bool) (newShares sdk.Dec, err sdk.Error)
+	BondDenom(ctx sdk.Context) string
+	CheckVotingPowerCap(ctx sdk.Context, validator staking.Validator, amount sdk.Int) sdk.Error
+
+	// used to project the annual rewards of bonded tokens
+	BondedRatio(ctx sdk.Context) sdk.Dec
+	StakingTokenSupply(ctx sdk.Context) sdk.Int
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
@@ -56,6 +68,12 @@ type StakingHooks interface {
 	BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec)
 }
 
+// MintKeeper defines the expected mint keeper (noalias)
+type MintKeeper interface {
+	GetMinter(ctx sdk.Context) mint.Minter
+	FeeCollectorShare(ctx sdk.Context) sdk.Dec
+}
+
 // SupplyKeeper defines the expected supply Keeper (noalias)
 type SupplyKeeper interface {
 	GetModuleAddress(name string) sdk.AccAddress
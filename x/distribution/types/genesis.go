@@ -53,6 +53,13 @@ type ValidatorSlashEventRecord struct {
 	Event            ValidatorSlashEvent `json:"validator_slash_event"`
 }
 
+// used for import / export via genesis json, also records the last delegation
+// processed by a running auto-compounding pass
+type AutoCompoundRecord struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address"`
+	ValidatorAddress sdk.ValAddress `json:"validator_address"`
+}
+
 // GenesisState - all distribution state that must be provided at genesis
 type GenesisState struct {
 	FeePool                         FeePool                                `json:"fee_pool"`
@@ -68,13 +75,21 @@ type GenesisState struct {
 	ValidatorCurrentRewards         []ValidatorCurrentRewardsRecord        `json:"validator_current_rewards"`
 	DelegatorStartingInfos          []DelegatorStartingInfoRecord          `json:"delegator_starting_infos"`
 	ValidatorSlashEvents            []ValidatorSlashEventRecord            `json:"validator_slash_events"`
+	AutoCompoundPeriod              int64                                  `json:"auto_compound_period"`
+	AutoCompoundMaxPerBlock         int64                                  `json:"auto_compound_max_per_block"`
+	AutoCompoundDelegations         []AutoCompoundRecord                   `json:"auto_compound_delegations"`
+	AutoCompoundCursor              *AutoCompoundRecord                    `json:"auto_compound_cursor"`
+	CommunityPoolStreams            []CommunityPoolStream                  `json:"community_pool_streams"`
+	NextCommunityPoolStreamID       uint64                                 `json:"next_community_pool_stream_id"`
 }
 
 func NewGenesisState(feePool FeePool, communityTax, baseProposerReward, bonusProposerReward sdk.Dec,
 	withdrawAddrEnabled bool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord,
-	slashes []ValidatorSlashEventRecord) GenesisState {
+	slashes []ValidatorSlashEventRecord, autoCompoundPeriod, autoCompoundMaxPerBlock int64,
+	autoCompounds []AutoCompoundRecord, autoCompoundCursor *AutoCompoundRecord,
+	streams []CommunityPoolStream, nextStreamID uint64) GenesisState {
 
 	return GenesisState{
 		FeePool:                         feePool,
@@ -90,6 +105,12 @@ func NewGenesisState(feePool FeePool, communityTax, baseProposerReward, bonusPro
 		ValidatorCurrentRewards:         cur,
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
+		AutoCompoundPeriod:              autoCompoundPeriod,
+		AutoCompoundMaxPerBlock:         autoCompoundMaxPerBlock,
+		AutoCompoundDelegations:         autoCompounds,
+		AutoCompoundCursor:              autoCompoundCursor,
+		CommunityPoolStreams:            streams,
+		NextCommunityPoolStreamID:       nextStreamID,
 	}
 }
 
@@ -109,6 +130,12 @@ func DefaultGenesisState() GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		AutoCompoundPeriod:              1000,
+		AutoCompoundMaxPerBlock:         100,
+		AutoCompoundDelegations:         []AutoCompoundRecord{},
+		AutoCompoundCursor:              nil,
+		CommunityPoolStreams:            []CommunityPoolStream{},
+		NextCommunityPoolStreamID:       1,
 	}
 }
 
@@ -126,11 +153,32 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("mint parameter BonusProposerReward should be positive, is %s",
 			data.BonusProposerReward.String())
 	}
-	if (data.BaseProposerReward.Add(data.BonusProposerReward)).
+	if (data.CommunityTax.Add(data.BaseProposerReward).Add(data.BonusProposerReward)).
 		GT(sdk.OneDec()) {
-		return fmt.Errorf("mint parameters BaseProposerReward and "+
-			"BonusProposerReward cannot add to be greater than one, "+
-			"adds to %s", data.BaseProposerReward.Add(data.BonusProposerReward).String())
+		return fmt.Errorf("mint parameters CommunityTax, BaseProposerReward and "+
+			"BonusProposerReward cannot add to be greater than one, adds to %s",
+			data.CommunityTax.Add(data.BaseProposerReward).Add(data.BonusProposerReward).String())
+	}
+	if data.AutoCompoundPeriod < 0 {
+		return fmt.Errorf("distribution parameter AutoCompoundPeriod should be non-negative, is %d",
+			data.AutoCompoundPeriod)
+	}
+	if data.AutoCompoundPeriod > 0 && data.AutoCompoundMaxPerBlock <= 0 {
+		return fmt.Errorf("distribution parameter AutoCompoundMaxPerBlock should be positive "+
+			"when auto-compounding is enabled, is %d", data.AutoCompoundMaxPerBlock)
+	}
+	if data.AutoCompoundMaxPerBlock < 0 {
+		return fmt.Errorf("distribution parameter AutoCompoundMaxPerBlock should be non-negative, is %d",
+			data.AutoCompoundMaxPerBlock)
+	}
+	for _, stream := range data.CommunityPoolStreams {
+		if err := stream.Validate(); err != nil {
+			return err
+		}
+		if stream.ID >= data.NextCommunityPoolStreamID {
+			return fmt.Errorf("community pool stream %d should have an ID lower than the next stream ID %d",
+				stream.ID, data.NextCommunityPoolStreamID)
+		}
 	}
 	return data.FeePool.ValidateGenesis()
 }
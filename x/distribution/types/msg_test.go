@@ -72,3 +72,48 @@ func TestMsgWithdrawValidatorCommission(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgSetAutoCompound
+func TestMsgSetAutoCompound(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		enabled       bool
+		expectPass    bool
+	}{
+		{delAddr1, valAddr1, true, true},
+		{delAddr1, valAddr1, false, true},
+		{emptyDelAddr, valAddr1, true, false},
+		{delAddr1, emptyValAddr, true, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetAutoCompound(tc.delegatorAddr, tc.validatorAddr, tc.enabled)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgFundCommunityPool
+func TestMsgFundCommunityPool(t *testing.T) {
+	tests := []struct {
+		amount     sdk.Coins
+		depositor  sdk.AccAddress
+		expectPass bool
+	}{
+		{sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000)), delAddr1, true},
+		{sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000)), emptyDelAddr, false},
+		{sdk.Coins{}, delAddr1, false},
+		{sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(-1)}}, delAddr1, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgFundCommunityPool(tc.amount, tc.depositor)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
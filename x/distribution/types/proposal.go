@@ -11,14 +11,26 @@ import (
 const (
 	// ProposalTypeCommunityPoolSpend defines the type for a CommunityPoolSpendProposal
 	ProposalTypeCommunityPoolSpend = "CommunityPoolSpend"
+	// ProposalTypeCommunityPoolStream defines the type for a CommunityPoolStreamProposal
+	ProposalTypeCommunityPoolStream = "CommunityPoolStream"
+	// ProposalTypeCancelCommunityPoolStream defines the type for a CancelCommunityPoolStreamProposal
+	ProposalTypeCancelCommunityPoolStream = "CancelCommunityPoolStream"
 )
 
-// Assert CommunityPoolSpendProposal implements govtypes.Content at compile-time
-var _ govtypes.Content = CommunityPoolSpendProposal{}
+// Assert the proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = CommunityPoolSpendProposal{}
+	_ govtypes.Content = CommunityPoolStreamProposal{}
+	_ govtypes.Content = CancelCommunityPoolStreamProposal{}
+)
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeCommunityPoolSpend)
 	govtypes.RegisterProposalTypeCodec(CommunityPoolSpendProposal{}, "cosmos-sdk/CommunityPoolSpendProposal")
+	govtypes.RegisterProposalType(ProposalTypeCommunityPoolStream)
+	govtypes.RegisterProposalTypeCodec(CommunityPoolStreamProposal{}, "cosmos-sdk/CommunityPoolStreamProposal")
+	govtypes.RegisterProposalType(ProposalTypeCancelCommunityPoolStream)
+	govtypes.RegisterProposalTypeCodec(CancelCommunityPoolStreamProposal{}, "cosmos-sdk/CancelCommunityPoolStreamProposal")
 }
 
 // CommunityPoolSpendProposal spends from the community pool
@@ -72,3 +84,108 @@ func (csp CommunityPoolSpendProposal) String() string {
 `, csp.Title, csp.Description, csp.Recipient, csp.Amount))
 	return b.String()
 }
+
+// CommunityPoolStreamProposal streams an amount from the community pool to a
+// recipient, paid out pro-rata in each block from the start to the end height
+type CommunityPoolStreamProposal struct {
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Recipient   sdk.AccAddress `json:"recipient"`
+	Amount      sdk.Coins      `json:"amount"`
+	StartHeight int64          `json:"start_height"`
+	EndHeight   int64          `json:"end_height"`
+}
+
+// NewCommunityPoolStreamProposal creates a new community pool stream proposal.
+func NewCommunityPoolStreamProposal(title, description string, recipient sdk.AccAddress, amount sdk.Coins,
+	startHeight, endHeight int64) CommunityPoolStreamProposal {
+
+	return CommunityPoolStreamProposal{title, description, recipient, amount, startHeight, endHeight}
+}
+
+// GetTitle returns the title of a community pool stream proposal.
+func (csp CommunityPoolStreamProposal) GetTitle() string { return csp.Title }
+
+// GetDescription returns the description of a community pool stream proposal.
+func (csp CommunityPoolStreamProposal) GetDescription() string { return csp.Description }
+
+// ProposalRoute returns the routing key of a community pool stream proposal.
+func (csp CommunityPoolStreamProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a community pool stream proposal.
+func (csp CommunityPoolStreamProposal) ProposalType() string { return ProposalTypeCommunityPoolStream }
+
+// ValidateBasic runs basic stateless validity checks
+func (csp CommunityPoolStreamProposal) ValidateBasic() sdk.Error {
+	err := govtypes.ValidateAbstract(DefaultCodespace, csp)
+	if err != nil {
+		return err
+	}
+	if !csp.Amount.IsValid() || csp.Amount.Empty() {
+		return ErrInvalidProposalAmount(DefaultCodespace)
+	}
+	if csp.Recipient.Empty() {
+		return ErrEmptyProposalRecipient(DefaultCodespace)
+	}
+	if csp.StartHeight <= 0 || csp.EndHeight < csp.StartHeight {
+		return ErrInvalidStreamHeights(DefaultCodespace)
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (csp CommunityPoolStreamProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Community Pool Stream Proposal:
+  Title:        %s
+  Description:  %s
+  Recipient:    %s
+  Amount:       %s
+  Start Height: %d
+  End Height:   %d
+`, csp.Title, csp.Description, csp.Recipient, csp.Amount, csp.StartHeight, csp.EndHeight))
+	return b.String()
+}
+
+// CancelCommunityPoolStreamProposal cancels a community pool stream, keeping
+// the amount paid out so far with the recipient
+type CancelCommunityPoolStreamProposal struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	StreamID    uint64 `json:"stream_id"`
+}
+
+// NewCancelCommunityPoolStreamProposal creates a new proposal to cancel a community pool stream.
+func NewCancelCommunityPoolStreamProposal(title, description string, streamID uint64) CancelCommunityPoolStreamProposal {
+	return CancelCommunityPoolStreamProposal{title, description, streamID}
+}
+
+// GetTitle returns the title of a community pool stream cancellation proposal.
+func (ccsp CancelCommunityPoolStreamProposal) GetTitle() string { return ccsp.Title }
+
+// GetDescription returns the description of a community pool stream cancellation proposal.
+func (ccsp CancelCommunityPoolStreamProposal) GetDescription() string { return ccsp.Description }
+
+// ProposalRoute returns the routing key of a community pool stream cancellation proposal.
+func (ccsp CancelCommunityPoolStreamProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a community pool stream cancellation proposal.
+func (ccsp CancelCommunityPoolStreamProposal) ProposalType() string {
+	return ProposalTypeCancelCommunityPoolStream
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (ccsp CancelCommunityPoolStreamProposal) ValidateBasic() sdk.Error {
+	return govtypes.ValidateAbstract(DefaultCodespace, ccsp)
+}
+
+// String implements the Stringer interface.
+func (ccsp CancelCommunityPoolStreamProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Cancel Community Pool Stream Proposal:
+  Title:       %s
+  Description: %s
+  Stream ID:   %d
+`, ccsp.Title, ccsp.Description, ccsp.StreamID))
+	return b.String()
+}
@@ -13,11 +13,19 @@ const (
 	QueryDelegatorValidators         = "delegator_validators"
 	QueryWithdrawAddr                = "withdraw_addr"
 	QueryCommunityPool               = "community_pool"
+	QueryDelegatorAutoCompound       = "delegator_auto_compound"
+	QueryCommunityPoolStreams        = "community_pool_streams"
+	QueryCommunityPoolStream         = "community_pool_stream"
+	QueryValidatorRewardRate         = "validator_reward_rate"
+	QueryValidatorAPR                = "validator_apr"
+	QueryDelegatorAccruedRewards     = "delegator_accrued_rewards"
 
-	ParamCommunityTax        = "community_tax"
-	ParamBaseProposerReward  = "base_proposer_reward"
-	ParamBonusProposerReward = "bonus_proposer_reward"
-	ParamWithdrawAddrEnabled = "withdraw_addr_enabled"
+	ParamCommunityTax            = "community_tax"
+	ParamBaseProposerReward      = "base_proposer_reward"
+	ParamBonusProposerReward     = "bonus_proposer_reward"
+	ParamWithdrawAddrEnabled     = "withdraw_addr_enabled"
+	ParamAutoCompoundPeriod      = "auto_compound_period"
+	ParamAutoCompoundMaxPerBlock = "auto_compound_max_per_block"
 )
 
 // params for query 'custom/distr/validator_outstanding_rewards'
@@ -44,6 +52,34 @@ func NewQueryValidatorCommissionParams(validatorAddr sdk.ValAddress) QueryValida
 	}
 }
 
+// params for query 'custom/distr/validator_apr'
+type QueryValidatorParams struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address"`
+}
+
+// creates a new instance of QueryValidatorParams
+func NewQueryValidatorParams(validatorAddr sdk.ValAddress) QueryValidatorParams {
+	return QueryValidatorParams{
+		ValidatorAddress: validatorAddr,
+	}
+}
+
+// params for query 'custom/distr/validator_reward_rate'
+type QueryValidatorRewardRateParams struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address"`
+	FromHeight       int64          `json:"from_height"`
+	ToHeight         int64          `json:"to_height"`
+}
+
+// creates a new instance of QueryValidatorRewardRateParams
+func NewQueryValidatorRewardRateParams(validatorAddr sdk.ValAddress, fromHeight, toHeight int64) QueryValidatorRewardRateParams {
+	return QueryValidatorRewardRateParams{
+		ValidatorAddress: validatorAddr,
+		FromHeight:       fromHeight,
+		ToHeight:         toHeight,
+	}
+}
+
 // params for query 'custom/distr/validator_slashes'
 type QueryValidatorSlashesParams struct {
 	ValidatorAddress sdk.ValAddress `json:"validator_address"`
@@ -74,7 +110,8 @@ func NewQueryDelegationRewardsParams(delegatorAddr sdk.AccAddress, validatorAddr
 	}
 }
 
-// params for query 'custom/distr/delegator_total_rewards' and 'custom/distr/delegator_validators'
+// params for query 'custom/distr/delegator_total_rewards', 'custom/distr/delegator_validators',
+// 'custom/distr/delegator_auto_compound' and 'custom/distr/delegator_accrued_rewards'
 type QueryDelegatorParams struct {
 	DelegatorAddress sdk.AccAddress `json:"delegator_address"`
 }
@@ -95,3 +132,13 @@ type QueryDelegatorWithdrawAddrParams struct {
 func NewQueryDelegatorWithdrawAddrParams(delegatorAddr sdk.AccAddress) QueryDelegatorWithdrawAddrParams {
 	return QueryDelegatorWithdrawAddrParams{DelegatorAddress: delegatorAddr}
 }
+
+// params for query 'custom/distr/community_pool_stream'
+type QueryCommunityPoolStreamParams struct {
+	StreamID uint64 `json:"stream_id"`
+}
+
+// creates a new instance of QueryCommunityPoolStreamParams
+func NewQueryCommunityPoolStreamParams(streamID uint64) QueryCommunityPoolStreamParams {
+	return QueryCommunityPoolStreamParams{StreamID: streamID}
+}
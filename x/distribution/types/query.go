@@ -44,3 +44,100 @@ func NewDelegationDelegatorReward(valAddr sdk.ValAddress,
 	reward sdk.DecCoins) DelegationDelegatorReward {
 	return DelegationDelegatorReward{ValidatorAddress: valAddr, Reward: reward}
 }
+
+// AutoCompoundValidators defines the validators of a delegator's delegations
+// that are opted into auto-compounding.
+type AutoCompoundValidators []sdk.ValAddress
+
+func (vals AutoCompoundValidators) String() string {
+	out := "Auto-Compounding Validators:"
+	for _, val := range vals {
+		out += fmt.Sprintf("\n  %s", val)
+	}
+	return out
+}
+
+// QueryDelegatorAccruedRewardsResponse defines the properties of
+// QueryDelegatorAccruedRewards query's response.
+type QueryDelegatorAccruedRewardsResponse struct {
+	Rewards []DelegationAccruedReward `json:"rewards"`
+	Total   sdk.DecCoins              `json:"total"`
+}
+
+// NewQueryDelegatorAccruedRewardsResponse constructs a QueryDelegatorAccruedRewardsResponse
+func NewQueryDelegatorAccruedRewardsResponse(rewards []DelegationAccruedReward,
+	total sdk.DecCoins) QueryDelegatorAccruedRewardsResponse {
+	return QueryDelegatorAccruedRewardsResponse{Rewards: rewards, Total: total}
+}
+
+func (res QueryDelegatorAccruedRewardsResponse) String() string {
+	out := "Delegator Accrued Rewards:\n"
+	out += "  Rewards:"
+	for _, reward := range res.Rewards {
+		out += fmt.Sprintf(`
+	ValidatorAddress: %s
+	Reward: %s
+	CommissionRate: %s`, reward.ValidatorAddress, reward.Reward, reward.CommissionRate)
+	}
+	out += fmt.Sprintf("\n  Total: %s\n", res.Total)
+	return strings.TrimSpace(out)
+}
+
+// DelegationAccruedReward defines the rewards a delegation has accrued since
+// the last withdrawal, net of the validator's commission at the given rate.
+type DelegationAccruedReward struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address"`
+	Reward           sdk.DecCoins   `json:"reward"`
+	CommissionRate   sdk.Dec        `json:"commission_rate"`
+}
+
+// NewDelegationAccruedReward constructs a DelegationAccruedReward.
+func NewDelegationAccruedReward(valAddr sdk.ValAddress, reward sdk.DecCoins,
+	commissionRate sdk.Dec) DelegationAccruedReward {
+	return DelegationAccruedReward{ValidatorAddress: valAddr, Reward: reward, CommissionRate: commissionRate}
+}
+
+// ValidatorRewardRate defines the rewards a validator's delegations earned per
+// bonded token between two heights.
+type ValidatorRewardRate struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address"`
+	FromHeight       int64          `json:"from_height"`
+	ToHeight         int64          `json:"to_height"`
+	Rate             sdk.DecCoins   `json:"rate"`
+}
+
+// NewValidatorRewardRate constructs a ValidatorRewardRate.
+func NewValidatorRewardRate(valAddr sdk.ValAddress, fromHeight, toHeight int64,
+	rate sdk.DecCoins) ValidatorRewardRate {
+	return ValidatorRewardRate{ValidatorAddress: valAddr, FromHeight: fromHeight, ToHeight: toHeight, Rate: rate}
+}
+
+func (r ValidatorRewardRate) String() string {
+	return fmt.Sprintf(`Validator Reward Rate:
+  ValidatorAddress: %s
+  FromHeight: %d
+  ToHeight: %d
+  Rate: %s`, r.ValidatorAddress, r.FromHeight, r.ToHeight, r.Rate)
+}
+
+// ValidatorAPR defines the projected annual rewards per bonded token of a
+// validator's delegations and the values it is derived from.
+type ValidatorAPR struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address"`
+	AnnualProvisions sdk.Dec        `json:"annual_provisions"`
+	BondedRatio      sdk.Dec        `json:"bonded_ratio"`
+	CommunityTax     sdk.Dec        `json:"community_tax"`
+	CommissionRate   sdk.Dec        `json:"commission_rate"`
+	APR              sdk.Dec        `json:"apr"`
+}
+
+func (apr ValidatorAPR) String() string {
+	return fmt.Sprintf(`Validator APR:
+  ValidatorAddress: %s
+  AnnualProvisions: %s
+  BondedRatio: %s
+  CommunityTax: %s
+  CommissionRate: %s
+  APR: %s`, apr.ValidatorAddress, apr.AnnualProvisions, apr.BondedRatio,
+		apr.CommunityTax, apr.CommissionRate, apr.APR)
+}
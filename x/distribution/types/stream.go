@@ -0,0 +1,99 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CommunityPoolStream pays out an amount from the community pool to a
+// recipient pro-rata over the blocks from the start to the end height
+// (inclusive)
+type CommunityPoolStream struct {
+	ID          uint64         `json:"id"`
+	Recipient   sdk.AccAddress `json:"recipient"`
+	Amount      sdk.Coins      `json:"amount"` // total amount to be paid out
+	StartHeight int64          `json:"start_height"`
+	EndHeight   int64          `json:"end_height"`
+	Paid        sdk.Coins      `json:"paid"` // amount paid out so far
+}
+
+// NewCommunityPoolStream creates a new community pool stream that has not paid
+// out anything yet
+func NewCommunityPoolStream(id uint64, recipient sdk.AccAddress, amount sdk.Coins,
+	startHeight, endHeight int64) CommunityPoolStream {
+
+	return CommunityPoolStream{
+		ID:          id,
+		Recipient:   recipient,
+		Amount:      amount,
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		Paid:        sdk.NewCoins(),
+	}
+}
+
+// AmountDue returns the amount the stream must pay out at the given height so
+// that the total paid out matches the elapsed share of the stream's blocks.
+// The whole remaining amount is due at the end height.
+func (s CommunityPoolStream) AmountDue(height int64) sdk.Coins {
+	if height < s.StartHeight {
+		return sdk.NewCoins()
+	}
+	if height >= s.EndHeight {
+		return s.Amount.Sub(s.Paid)
+	}
+
+	elapsed := sdk.NewInt(height - s.StartHeight + 1)
+	blocks := sdk.NewInt(s.EndHeight - s.StartHeight + 1)
+
+	var vested sdk.Coins
+	for _, coin := range s.Amount {
+		vested = append(vested, sdk.NewCoin(coin.Denom, coin.Amount.Mul(elapsed).Quo(blocks)))
+	}
+	return sdk.NewCoins(vested...).Sub(s.Paid)
+}
+
+// Validate performs a stateless validity check of the stream
+func (s CommunityPoolStream) Validate() error {
+	if s.Recipient.Empty() {
+		return fmt.Errorf("community pool stream %d has no recipient", s.ID)
+	}
+	if !s.Amount.IsValid() || s.Amount.Empty() {
+		return fmt.Errorf("community pool stream %d has an invalid amount, is %s", s.ID, s.Amount)
+	}
+	if s.StartHeight <= 0 || s.EndHeight < s.StartHeight {
+		return fmt.Errorf("community pool stream %d has invalid heights, starts at %d and ends at %d",
+			s.ID, s.StartHeight, s.EndHeight)
+	}
+	if !s.Paid.IsValid() || !s.Amount.IsAllGTE(s.Paid) {
+		return fmt.Errorf("community pool stream %d has paid out %s of %s", s.ID, s.Paid, s.Amount)
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (s CommunityPoolStream) String() string {
+	return fmt.Sprintf(`Community Pool Stream %d:
+  Recipient:    %s
+  Amount:       %s
+  Start Height: %d
+  End Height:   %d
+  Paid:         %s`, s.ID, s.Recipient, s.Amount, s.StartHeight, s.EndHeight, s.Paid)
+}
+
+// CommunityPoolStreams is a collection of community pool streams
+type CommunityPoolStreams []CommunityPoolStream
+
+func (streams CommunityPoolStreams) String() string {
+	if len(streams) == 0 {
+		return "[]"
+	}
+
+	out := make([]string, len(streams))
+	for i, stream := range streams {
+		out[i] = stream.String()
+	}
+	return strings.Join(out, "\n")
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestCommunityPoolStreamAmountDue(t *testing.T) {
+	amount := sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("stake", 100))
+	stream := NewCommunityPoolStream(1, delAddr1, amount, 5, 8)
+
+	require.True(t, stream.AmountDue(4).IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 2), sdk.NewInt64Coin("stake", 25)), stream.AmountDue(5))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 7), sdk.NewInt64Coin("stake", 75)), stream.AmountDue(7))
+
+	// only the remainder is due once part of the amount was paid out
+	stream.Paid = sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 50))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 2), sdk.NewInt64Coin("stake", 25)), stream.AmountDue(7))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 50)), stream.AmountDue(8))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 50)), stream.AmountDue(100))
+}
+
+func TestCommunityPoolStreamValidate(t *testing.T) {
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	tests := []struct {
+		stream    CommunityPoolStream
+		expectErr bool
+	}{
+		{NewCommunityPoolStream(1, delAddr1, amount, 5, 8), false},
+		{NewCommunityPoolStream(1, delAddr1, amount, 5, 5), false},
+		{NewCommunityPoolStream(1, nil, amount, 5, 8), true},
+		{NewCommunityPoolStream(1, delAddr1, sdk.Coins{}, 5, 8), true},
+		{NewCommunityPoolStream(1, delAddr1, amount, 0, 8), true},
+		{NewCommunityPoolStream(1, delAddr1, amount, 8, 5), true},
+		{CommunityPoolStream{1, delAddr1, amount, 5, 8, amount.Add(amount)}, true},
+	}
+
+	for i, tc := range tests {
+		err := tc.stream.Validate()
+		if tc.expectErr {
+			require.Error(t, err, "test: %v", i)
+		} else {
+			require.NoError(t, err, "test: %v", i)
+		}
+	}
+}
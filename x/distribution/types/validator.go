@@ -8,9 +8,10 @@ import (
 )
 
 // historical rewards for a validator
-// height is implicit within the store key
+// period is implicit within the store key
 // cumulative reward ratio is the sum from the zeroeth period
 // until this period of rewards / tokens, per the spec
+// height is the block height at which the period ended
 // The reference count indicates the number of objects
 // which might need to reference this historical entry
 // at any point.
@@ -21,13 +22,15 @@ import (
 type ValidatorHistoricalRewards struct {
 	CumulativeRewardRatio sdk.DecCoins `json:"cumulative_reward_ratio"`
 	ReferenceCount        uint16       `json:"reference_count"`
+	Height                int64        `json:"height"`
 }
 
 // create a new ValidatorHistoricalRewards
-func NewValidatorHistoricalRewards(cumulativeRewardRatio sdk.DecCoins, referenceCount uint16) ValidatorHistoricalRewards {
+func NewValidatorHistoricalRewards(cumulativeRewardRatio sdk.DecCoins, referenceCount uint16, height int64) ValidatorHistoricalRewards {
 	return ValidatorHistoricalRewards{
 		CumulativeRewardRatio: cumulativeRewardRatio,
 		ReferenceCount:        referenceCount,
+		Height:                height,
 	}
 }
 
@@ -0,0 +1,86 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/evidence/internal/keeper
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/evidence/internal/types
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/evidence/exported
+package evidence
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/types"
+)
+
+const (
+	ModuleName                  = types.ModuleName
+	StoreKey                    = types.StoreKey
+	RouterKey                   = types.RouterKey
+	QuerierRoute                = types.QuerierRoute
+	DefaultParamspace           = types.DefaultParamspace
+	DefaultCodespace            = types.DefaultCodespace
+	CodeNoEvidenceHandlerExists = types.CodeNoEvidenceHandlerExists
+	CodeInvalidEvidence         = types.CodeInvalidEvidence
+	CodeNoEvidenceExists        = types.CodeNoEvidenceExists
+	CodeEvidenceExists          = types.CodeEvidenceExists
+	EventTypeSubmitEvidence     = types.EventTypeSubmitEvidence
+	AttributeKeyEvidenceHash    = types.AttributeKeyEvidenceHash
+	AttributeValueCategory      = types.AttributeValueCategory
+	RouteEquivocation           = types.RouteEquivocation
+	TypeEquivocation            = types.TypeEquivocation
+	RouteDoubleSign             = types.RouteDoubleSign
+	TypeDoubleSign              = types.TypeDoubleSign
+	TypeMsgSubmitEvidence       = types.TypeMsgSubmitEvidence
+	DefaultMaxEvidenceAge       = types.DefaultMaxEvidenceAge
+	QueryParameters             = types.QueryParameters
+	QueryEvidence               = types.QueryEvidence
+	QueryAllEvidence            = types.QueryAllEvidence
+)
+
+var (
+	// functions aliases
+	NewKeeper                  = keeper.NewKeeper
+	NewQuerier                 = keeper.NewQuerier
+	RegisterCodec              = types.RegisterCodec
+	RegisterEvidenceTypeCodec  = types.RegisterEvidenceTypeCodec
+	ErrNoEvidenceHandlerExists = types.ErrNoEvidenceHandlerExists
+	ErrInvalidEvidence         = types.ErrInvalidEvidence
+	ErrNoEvidenceExists        = types.ErrNoEvidenceExists
+	ErrEvidenceExists          = types.ErrEvidenceExists
+	NewEquivocation            = types.NewEquivocation
+	ConvertDoubleSignEvidence  = types.ConvertDoubleSignEvidence
+	NewDoubleSignEvidence      = types.NewDoubleSignEvidence
+	NewGenesisState            = types.NewGenesisState
+	DefaultGenesisState        = types.DefaultGenesisState
+	ValidateGenesis            = types.ValidateGenesis
+	GetEvidenceKey             = types.GetEvidenceKey
+	NewMsgSubmitEvidence       = types.NewMsgSubmitEvidence
+	ParamKeyTable              = types.ParamKeyTable
+	NewParams                  = types.NewParams
+	DefaultParams              = types.DefaultParams
+	ValidateParams             = types.ValidateParams
+	NewQueryEvidenceParams     = types.NewQueryEvidenceParams
+	NewQueryAllEvidenceParams  = types.NewQueryAllEvidenceParams
+	NewRouter                  = types.NewRouter
+
+	// variable aliases
+	ModuleCdc             = types.ModuleCdc
+	KeyPrefixEvidence     = types.KeyPrefixEvidence
+	KeyMaxEvidenceAge     = types.KeyMaxEvidenceAge
+	DoubleSignJailEndTime = types.DoubleSignJailEndTime
+)
+
+type (
+	Keeper                 = keeper.Keeper
+	Evidence               = exported.Evidence
+	Equivocation           = types.Equivocation
+	DoubleSignEvidence     = types.DoubleSignEvidence
+	GenesisState           = types.GenesisState
+	MsgSubmitEvidence      = types.MsgSubmitEvidence
+	Params                 = types.Params
+	QueryEvidenceParams    = types.QueryEvidenceParams
+	QueryAllEvidenceParams = types.QueryAllEvidenceParams
+	QueryResEvidence       = types.QueryResEvidence
+	Handler                = types.Handler
+	Router                 = types.Router
+)
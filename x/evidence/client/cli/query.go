@@ -0,0 +1,157 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/types"
+)
+
+const (
+	flagPage  = "page"
+	flagLimit = "limit"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	evidenceQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the evidence module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	evidenceQueryCmd.AddCommand(client.GetCommands(
+		GetCmdQueryParams(cdc),
+		GetCmdQueryEvidence(cdc),
+		GetCmdQueryAllEvidence(cdc),
+	)...)
+
+	return evidenceQueryCmd
+}
+
+// GetCmdQueryParams implements a command to return the current evidence
+// parameters.
+func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Query the current evidence parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParameters)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := cdc.UnmarshalJSON(res, &params); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+// GetCmdQueryEvidence implements the query evidence by hash command.
+func GetCmdQueryEvidence(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "evidence [hash]",
+		Short: "Query processed evidence by hash",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a piece of processed evidence by its hash.
+
+Example:
+$ %s query %s evidence DF0C23E8634E480F84B9D5674A7CDC9816466DEC28A3358F73260F68D28D7660
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			hash, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid evidence hash: %s", err)
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryEvidenceParams(hash))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryEvidence)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var evidence exported.Evidence
+			if err := cdc.UnmarshalJSON(res, &evidence); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(evidence)
+		},
+	}
+}
+
+// GetCmdQueryAllEvidence implements the query all evidence command.
+func GetCmdQueryAllEvidence(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-evidence",
+		Short: "Query all processed evidence",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the processed evidence. The results can be paginated with the
+--page and --limit flags, a limit of 0 returns all the evidence.
+
+Example:
+$ %s query %s all-evidence --page=2 --limit=50
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryAllEvidenceParams(viper.GetInt(flagPage), viper.GetInt(flagLimit))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllEvidence)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var evidence types.QueryResEvidence
+			if err := cdc.UnmarshalJSON(res, &evidence); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(evidence)
+		},
+	}
+
+	cmd.Flags().Int(flagPage, 1, "Query a specific page of paginated results")
+	cmd.Flags().Int(flagLimit, 0, "Query number of evidence results per page returned")
+
+	return cmd
+}
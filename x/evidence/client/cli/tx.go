@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Evidence transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(client.PostCommands(
+		GetCmdSubmitEvidence(cdc),
+	)...)
+	return txCmd
+}
+
+// GetCmdSubmitEvidence implements the submit evidence command.
+func GetCmdSubmitEvidence(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "submit [evidence-file]",
+		Short: "Submit evidence of misbehavior",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit evidence of misbehavior that was detected off-chain. The evidence
+must be provided as a JSON file of one of the evidence types registered by the
+application, for example:
+
+{
+  "type": "my-app/MyEvidence",
+  "value": {
+    ...
+  }
+}
+
+Example:
+$ %s tx %s submit path/to/evidence.json --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var evidence exported.Evidence
+			if err := cdc.UnmarshalJSON(contents, &evidence); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitEvidence(evidence, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
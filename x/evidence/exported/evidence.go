@@ -0,0 +1,19 @@
+package exported
+
+import (
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+// Evidence defines the contract which concrete evidence types of misbehavior
+// must implement. Every evidence type is routed to the handler registered
+// under its route in the evidence Router.
+type Evidence interface {
+	Route() string
+	Type() string
+	String() string
+	Hash() cmn.HexBytes
+	ValidateBasic() error
+
+	// GetHeight returns the height at which the infraction occurred
+	GetHeight() int64
+}
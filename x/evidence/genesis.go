@@ -0,0 +1,34 @@
+package evidence
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis sets the evidence parameters and stores the evidence provided at
+// genesis.
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	if err := ValidateGenesis(data); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", ModuleName, err))
+	}
+
+	k.SetParams(ctx, data.Params)
+
+	for _, evidence := range data.Evidence {
+		if _, found := k.GetEvidence(ctx, evidence.Hash()); found {
+			panic(fmt.Sprintf("evidence with hash %s already exists", evidence.Hash()))
+		}
+		k.SetEvidence(ctx, evidence)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	evidence := k.GetAllEvidence(ctx)
+	if evidence == nil {
+		evidence = []Evidence{}
+	}
+
+	return NewGenesisState(k.GetParams(ctx), evidence)
+}
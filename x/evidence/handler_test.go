@@ -0,0 +1,116 @@
+package evidence_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+const chainID = "evidence-chain"
+
+// newSignedVote returns a precommit for the given block signed by privKey
+func newSignedVote(t *testing.T, privKey ed25519.PrivKeyEd25519, chainID string, height int64, block string) *tmtypes.Vote {
+	vote := &tmtypes.Vote{
+		Type:      tmtypes.PrecommitType,
+		Height:    height,
+		Timestamp: time.Unix(100, 0).UTC(),
+		BlockID: tmtypes.BlockID{
+			Hash:        tmhash.Sum([]byte(block)),
+			PartsHeader: tmtypes.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte(block + "parts"))},
+		},
+		ValidatorAddress: privKey.PubKey().Address(),
+	}
+
+	sig, err := privKey.Sign(vote.SignBytes(chainID))
+	require.NoError(t, err)
+	vote.Signature = sig
+	return vote
+}
+
+func TestHandleMsgSubmitDoubleSignEvidence(t *testing.T) {
+	ctx, k, sk, slk, bk := keeper.CreateTestInput(t)
+	handler := evidence.NewHandler(k)
+
+	privKey := ed25519.GenPrivKeyFromSecret([]byte("validator"))
+	operatorAddr := sdk.ValAddress(privKey.PubKey().Address())
+	consAddr := sdk.ConsAddress(privKey.PubKey().Address())
+	submitter := sdk.AccAddress([]byte("submitter___________"))
+
+	// create a bonded validator at height 1
+	ctx = ctx.WithChainID(chainID).WithBlockHeader(abci.Header{ChainID: chainID, Height: 1, Time: time.Unix(0, 0)})
+	power := int64(100)
+	amt := sdk.TokensFromConsensusPower(power)
+	_, err := bk.AddCoins(ctx, sdk.AccAddress(operatorAddr), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amt)))
+	require.NoError(t, err)
+
+	commission := staking.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	msgCreate := staking.NewMsgCreateValidator(operatorAddr, privKey.PubKey(),
+		sdk.NewCoin(sdk.DefaultBondDenom, amt), staking.Description{}, commission, sdk.OneInt())
+	require.True(t, staking.NewHandler(sk)(ctx, msgCreate).IsOK())
+	staking.EndBlocker(ctx, sk)
+	slk.HandleValidatorSignature(ctx, consAddr.Bytes(), power, true)
+
+	// the validator double signs at height 2, whose historical info is tracked
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: chainID, Height: 2, Time: time.Unix(100, 0)})
+	staking.BeginBlocker(ctx, sk)
+
+	voteA := newSignedVote(t, privKey, chainID, 2, "block A")
+	voteB := newSignedVote(t, privKey, chainID, 2, "block B")
+
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: chainID, Height: 3, Time: time.Unix(200, 0)})
+	oldTokens := sk.Validator(ctx, operatorAddr).GetTokens()
+
+	// votes signed on another chain are rejected
+	otherChain := evidence.NewDoubleSignEvidence(
+		newSignedVote(t, privKey, "other-chain", 2, "block A"),
+		newSignedVote(t, privKey, "other-chain", 2, "block B"),
+	)
+	msg := evidence.NewMsgSubmitEvidence(otherChain, submitter)
+	require.NoError(t, msg.ValidateBasic())
+	res := handler(ctx, msg)
+	require.False(t, res.IsOK())
+	require.Equal(t, evidence.CodeInvalidEvidence, res.Code)
+	require.False(t, sk.Validator(ctx, operatorAddr).IsJailed())
+
+	// votes for the same block are not a double sign
+	msg = evidence.NewMsgSubmitEvidence(evidence.NewDoubleSignEvidence(voteA, voteA), submitter)
+	require.Error(t, msg.ValidateBasic())
+
+	// conflicting votes slash, jail and tombstone the validator
+	doubleSign := evidence.NewDoubleSignEvidence(voteA, voteB)
+	msg = evidence.NewMsgSubmitEvidence(doubleSign, submitter)
+	require.NoError(t, msg.ValidateBasic())
+	res = handler(ctx, msg)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, []byte(doubleSign.Hash()), res.Data)
+
+	require.True(t, sk.Validator(ctx, operatorAddr).IsJailed())
+	require.True(t, slk.IsTombstoned(ctx, consAddr))
+	require.True(t, sk.Validator(ctx, operatorAddr).GetTokens().LT(oldTokens))
+
+	stored, found := k.GetEvidence(ctx, doubleSign.Hash())
+	require.True(t, found)
+	require.Equal(t, doubleSign, stored)
+
+	// the same evidence can't be submitted twice
+	res = handler(ctx, msg)
+	require.False(t, res.IsOK())
+	require.Equal(t, evidence.CodeEvidenceExists, res.Code)
+
+	// and the tombstoned validator can't be slashed again
+	newTokens := sk.Validator(ctx, operatorAddr).GetTokens()
+	res = handler(ctx, evidence.NewMsgSubmitEvidence(evidence.NewDoubleSignEvidence(voteB, voteA), submitter))
+	require.False(t, res.IsOK())
+	require.Equal(t, evidence.CodeInvalidEvidence, res.Code)
+	require.True(t, sk.Validator(ctx, operatorAddr).GetTokens().Equal(newTokens))
+}
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/types"
+)
+
+// HandleDoubleSign implements an equivocation evidence handler. Assuming the
+// evidence is valid, the validator committing the misbehavior will be slashed,
+// jailed and tombstoned. Once tombstoned, the validator will not be able to
+// recover. Note, the evidence contains the block time and height at the time
+// of the equivocation.
+//
+// The evidence is considered invalid if:
+// - the evidence is too old
+// - the validator is unbonded or does not exist
+// - the signing info does not exist (will panic)
+// - is already tombstoned
+//
+// Invalid evidence provided by Tendermint is logged and ignored.
+//
+// TODO: Some of the invalid constraints listed above may need to reconsidered
+// in the case of a lunatic attack.
+func (k Keeper) HandleDoubleSign(ctx sdk.Context, evidence types.Equivocation) {
+	if err := k.slashEquivocation(ctx, evidence); err != nil {
+		// Ignore evidence that cannot be handled.
+		//
+		// NOTE: We used to panic when the validator could not be found, but
+		// this couples the expectations of the app to both Tendermint and the
+		// simulator.  Both are expected to provide the full range of allowable
+		// but none of the disallowed evidence types.  Instead of getting this
+		// coordination right, it is easier to relax the constraints and ignore
+		// evidence that cannot be handled.
+		k.Logger(ctx).Info(fmt.Sprintf("Ignored double sign from %s at height %d: %s",
+			evidence.ConsensusAddress, evidence.GetHeight(), err))
+		return
+	}
+
+	// persist the evidence so that it can be queried like submitted evidence
+	k.SetEvidence(ctx, evidence)
+}
+
+// HandleDoubleSignEvidence is the Handler of DoubleSignEvidence submitted
+// through a MsgSubmitEvidence. The conflicting votes must be signed by the
+// registered consensus key of the validator on this chain. As the votes can't
+// be trusted for anything else, the time of the infraction and the power of
+// the validator are taken from the historical info of the infraction height,
+// which must still be stored by the staking module. The validator is then
+// punished like for an Equivocation provided by Tendermint.
+func (k Keeper) HandleDoubleSignEvidence(ctx sdk.Context, evidence exported.Evidence) error {
+	doubleSign, ok := evidence.(types.DoubleSignEvidence)
+	if !ok {
+		return fmt.Errorf("unexpected evidence type: %T", evidence)
+	}
+
+	consAddr := doubleSign.ConsensusAddress()
+	infractionHeight := doubleSign.GetHeight()
+
+	pubKey, err := k.slashingKeeper.GetPubkey(ctx, consAddr.Bytes())
+	if err != nil {
+		return fmt.Errorf("validator with consensus address %s not found", consAddr)
+	}
+
+	if err := doubleSign.Verify(ctx.ChainID(), pubKey); err != nil {
+		return err
+	}
+
+	if infractionHeight > ctx.BlockHeight() {
+		return fmt.Errorf("infraction height %d is greater than the current height %d", infractionHeight, ctx.BlockHeight())
+	}
+
+	hi, found := k.stakingKeeper.GetHistoricalInfo(ctx, infractionHeight)
+	if !found {
+		return fmt.Errorf("no historical info found for infraction height %d", infractionHeight)
+	}
+
+	var power int64
+	for _, val := range hi.ValSet {
+		if val.ConsAddress().Equals(consAddr) {
+			power = val.GetConsensusPower()
+			break
+		}
+	}
+	if power == 0 {
+		return fmt.Errorf("validator %s was not bonded at height %d", consAddr, infractionHeight)
+	}
+
+	return k.slashEquivocation(ctx, types.NewEquivocation(infractionHeight, hi.Header.Time, power, consAddr))
+}
+
+// slashEquivocation slashes, jails and tombstones the validator that committed
+// the given equivocation. An error is returned, and the validator is left
+// untouched, if the evidence is invalid.
+func (k Keeper) slashEquivocation(ctx sdk.Context, evidence types.Equivocation) error {
+	logger := k.Logger(ctx)
+	consAddr := evidence.ConsensusAddress
+	infractionHeight := evidence.GetHeight()
+
+	// calculate the age of the evidence
+	blockTime := ctx.BlockHeader().Time
+	age := blockTime.Sub(evidence.Time)
+
+	if _, err := k.slashingKeeper.GetPubkey(ctx, consAddr.Bytes()); err != nil {
+		return fmt.Errorf("validator with consensus address %s not found", consAddr)
+	}
+
+	// reject evidence if the double-sign is too old
+	if age > k.MaxEvidenceAge(ctx) {
+		return fmt.Errorf("age of %d past max age of %d", age, k.MaxEvidenceAge(ctx))
+	}
+
+	validator := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+	if validator == nil || validator.IsUnbonded() {
+		// Defensive: Simulation doesn't take unbonding periods into account, and
+		// Tendermint might break this assumption at some point.
+		return errors.New("validator is unbonded")
+	}
+
+	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr); !ok {
+		panic(fmt.Sprintf("expected signing info for validator %s but not found", consAddr))
+	}
+
+	// ignore if the validator is already tombstoned
+	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+		return errors.New("validator already tombstoned")
+	}
+
+	logger.Info(fmt.Sprintf("Confirmed double sign from %s at height %d, age of %d", consAddr, infractionHeight, age))
+
+	// We need to retrieve the stake distribution which signed the block, so we
+	// subtract ValidatorUpdateDelay from the evidence height.
+	// Note, that this *can* result in a negative "distributionHeight", up to
+	// -ValidatorUpdateDelay, i.e. at the end of the
+	// pre-genesis block (none) = at the beginning of the genesis block.
+	// That's fine since this is just used to filter unbonding delegations & redelegations.
+	distributionHeight := infractionHeight - sdk.ValidatorUpdateDelay
+
+	// Slash validator. The `power` is the int64 power of the validator as provided
+	// to/by Tendermint. This value is validator.Tokens as sent to Tendermint via
+	// ABCI, and now received as evidence. The fraction is passed in to separately
+	// to slash unbonding and rebonding delegations.
+	k.slashingKeeper.Slash(
+		ctx,
+		consAddr,
+		k.slashingKeeper.SlashFractionDoubleSign(ctx),
+		evidence.Power, distributionHeight,
+	)
+
+	// Jail the validator if not already jailed. This will begin unbonding the
+	// validator if not already unbonding (tombstoned).
+	if !validator.IsJailed() {
+		k.slashingKeeper.Jail(ctx, consAddr)
+	}
+
+	k.slashingKeeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime)
+	k.slashingKeeper.Tombstone(ctx, consAddr)
+	return nil
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+// Test that a validator is slashed correctly
+// when we discover evidence of infraction
+func TestHandleDoubleSign(t *testing.T) {
+	input := newTestInput(t)
+	ctx, sk, keeper := input.ctx, input.stakingKeeper, input.evidenceKeeper
+
+	// validator added pre-genesis
+	ctx = ctx.WithBlockHeight(-1)
+	power := int64(100)
+	amt := sdk.TokensFromConsensusPower(power)
+	operatorAddr, val := addrs[0], pks[0]
+	got := staking.NewHandler(sk)(ctx, newTestMsgCreateValidator(operatorAddr, val, amt))
+	require.True(t, got.IsOK())
+	staking.EndBlocker(ctx, sk)
+	require.Equal(
+		t, input.bankKeeper.GetCoins(ctx, sdk.AccAddress(operatorAddr)),
+		sdk.NewCoins(sdk.NewCoin(sk.GetParams(ctx).BondDenom, initTokens.Sub(amt))),
+	)
+	require.Equal(t, amt, sk.Validator(ctx, operatorAddr).GetBondedTokens())
+
+	// handle a signature to set signing info
+	input.slashingKeeper.HandleValidatorSignature(ctx, val.Address(), amt.Int64(), true)
+
+	oldTokens := sk.Validator(ctx, operatorAddr).GetTokens()
+
+	// double sign less than max age
+	evidence := types.NewEquivocation(0, time.Unix(0, 0), power, sdk.ConsAddress(val.Address()))
+	keeper.HandleDoubleSign(ctx, evidence)
+
+	// should be jailed and tombstoned
+	require.True(t, sk.Validator(ctx, operatorAddr).IsJailed())
+	require.True(t, input.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())))
+
+	// tokens should be decreased
+	newTokens := sk.Validator(ctx, operatorAddr).GetTokens()
+	require.True(t, newTokens.LT(oldTokens))
+
+	// the evidence should be stored
+	_, found := keeper.GetEvidence(ctx, evidence.Hash())
+	require.True(t, found)
+
+	// New evidence
+	keeper.HandleDoubleSign(ctx, types.NewEquivocation(1, time.Unix(0, 0), power, sdk.ConsAddress(val.Address())))
+
+	// tokens should be the same (capped slash)
+	require.True(t, sk.Validator(ctx, operatorAddr).GetTokens().Equal(newTokens))
+
+	// Jump to past the unbonding period
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(1, 0).Add(sk.GetParams(ctx).UnbondingTime)})
+
+	// Still shouldn't be able to unjail
+	msgUnjail := slashing.NewMsgUnjail(operatorAddr)
+	res := slashing.NewHandler(input.slashingKeeper)(ctx, msgUnjail)
+	require.False(t, res.IsOK())
+
+	// Should be able to unbond now
+	del, _ := sk.GetDelegation(ctx, sdk.AccAddress(operatorAddr), operatorAddr)
+	validator, _ := sk.GetValidator(ctx, operatorAddr)
+
+	totalBond := validator.TokensFromShares(del.GetShares()).TruncateInt()
+	msgUnbond := staking.NewMsgUndelegate(sdk.AccAddress(operatorAddr), operatorAddr, sdk.NewCoin(sk.GetParams(ctx).BondDenom, totalBond))
+	res = staking.NewHandler(sk)(ctx, msgUnbond)
+	require.True(t, res.IsOK())
+}
+
+// Test that a validator is not slashed
+// when the evidence is past the max evidence age
+func TestPastMaxEvidenceAge(t *testing.T) {
+	input := newTestInput(t)
+	ctx, sk, keeper := input.ctx, input.stakingKeeper, input.evidenceKeeper
+
+	// validator added pre-genesis
+	ctx = ctx.WithBlockHeight(-1)
+	power := int64(100)
+	amt := sdk.TokensFromConsensusPower(power)
+	operatorAddr, val := addrs[0], pks[0]
+	got := staking.NewHandler(sk)(ctx, newTestMsgCreateValidator(operatorAddr, val, amt))
+	require.True(t, got.IsOK())
+	staking.EndBlocker(ctx, sk)
+	require.Equal(
+		t, input.bankKeeper.GetCoins(ctx, sdk.AccAddress(operatorAddr)),
+		sdk.NewCoins(sdk.NewCoin(sk.GetParams(ctx).BondDenom, initTokens.Sub(amt))),
+	)
+	require.Equal(t, amt, sk.Validator(ctx, operatorAddr).GetBondedTokens())
+
+	// handle a signature to set signing info
+	input.slashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, true)
+
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(1, 0).Add(keeper.MaxEvidenceAge(ctx))})
+
+	oldPower := sk.Validator(ctx, operatorAddr).GetConsensusPower()
+
+	// double sign past max age
+	evidence := types.NewEquivocation(0, time.Unix(0, 0), power, sdk.ConsAddress(val.Address()))
+	keeper.HandleDoubleSign(ctx, evidence)
+
+	// should still be bonded
+	require.True(t, sk.Validator(ctx, operatorAddr).IsBonded())
+
+	// should still have same power
+	require.Equal(t, oldPower, sk.Validator(ctx, operatorAddr).GetConsensusPower())
+
+	// the evidence should not be stored
+	_, found := keeper.GetEvidence(ctx, evidence.Hash())
+	require.False(t, found)
+}
@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Keeper of the evidence store. The Keeper routes submitted evidence to the
+// Handler registered for its route and persists all processed evidence by
+// hash so that the same evidence can't be handled twice.
+type Keeper struct {
+	cdc            *codec.Codec
+	storeKey       sdk.StoreKey
+	paramSpace     params.Subspace
+	router         types.Router
+	stakingKeeper  types.StakingKeeper
+	slashingKeeper types.SlashingKeeper
+	codespace      sdk.CodespaceType
+}
+
+// NewKeeper creates a new evidence Keeper instance. The evidence types of the
+// module are routed to the keeper's own handlers, so their routes must not be
+// registered on the given router.
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace, codespace sdk.CodespaceType,
+	stakingKeeper types.StakingKeeper, slashingKeeper types.SlashingKeeper, rtr types.Router) Keeper {
+
+	k := Keeper{
+		cdc:            cdc,
+		storeKey:       key,
+		paramSpace:     paramSpace.WithKeyTable(types.ParamKeyTable()),
+		router:         rtr,
+		stakingKeeper:  stakingKeeper,
+		slashingKeeper: slashingKeeper,
+		codespace:      codespace,
+	}
+
+	// route the evidence types of the module to the keeper's own handlers
+	rtr.AddRoute(types.RouteDoubleSign, k.HandleDoubleSignEvidence)
+
+	// It is vital to seal the evidence router here as to not allow further
+	// handlers to be registered after the keeper is created since this could
+	// create invalid or non-deterministic behavior.
+	rtr.Seal()
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// Codespace returns the keeper's codespace.
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// GetEvidenceHandler returns the Handler registered for a given evidence
+// route. An error is returned if no Handler exists for the route.
+func (k Keeper) GetEvidenceHandler(evidenceRoute string) (types.Handler, sdk.Error) {
+	if !k.router.HasRoute(evidenceRoute) {
+		return nil, types.ErrNoEvidenceHandlerExists(k.codespace, evidenceRoute)
+	}
+
+	return k.router.GetRoute(evidenceRoute), nil
+}
+
+// GetParams returns the total set of evidence parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of evidence parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// MaxEvidenceAge returns the maximum age of evidence that is still handled
+func (k Keeper) MaxEvidenceAge(ctx sdk.Context) (res time.Duration) {
+	k.paramSpace.Get(ctx, types.KeyMaxEvidenceAge, &res)
+	return
+}
+
+// SubmitEvidence attempts to match evidence against the registered Handler of
+// its route and executes it. The evidence is persisted by hash once it has
+// been successfully handled. An error is returned if the evidence has already
+// been processed, if no Handler exists for its route or if the Handler fails.
+func (k Keeper) SubmitEvidence(ctx sdk.Context, evidence exported.Evidence) sdk.Error {
+	if _, found := k.GetEvidence(ctx, evidence.Hash()); found {
+		return types.ErrEvidenceExists(k.codespace, evidence.Hash().String())
+	}
+
+	handler, err := k.GetEvidenceHandler(evidence.Route())
+	if err != nil {
+		return err
+	}
+
+	if err := handler(ctx, evidence); err != nil {
+		return types.ErrInvalidEvidence(k.codespace, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitEvidence,
+			sdk.NewAttribute(types.AttributeKeyEvidenceHash, evidence.Hash().String()),
+		),
+	)
+
+	k.SetEvidence(ctx, evidence)
+	return nil
+}
+
+// SetEvidence stores a piece of evidence by its hash
+func (k Keeper) SetEvidence(ctx sdk.Context, evidence exported.Evidence) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(evidence)
+	store.Set(types.GetEvidenceKey(evidence.Hash()), bz)
+}
+
+// GetEvidence returns a piece of evidence by its hash
+func (k Keeper) GetEvidence(ctx sdk.Context, hash cmn.HexBytes) (evidence exported.Evidence, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetEvidenceKey(hash))
+	if bz == nil {
+		return nil, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &evidence)
+	return evidence, true
+}
+
+// IterateEvidence iterates over all the stored evidence
+func (k Keeper) IterateEvidence(ctx sdk.Context, cb func(evidence exported.Evidence) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixEvidence)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var evidence exported.Evidence
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &evidence)
+		if cb(evidence) {
+			break
+		}
+	}
+}
+
+// GetAllEvidence returns all the stored evidence
+func (k Keeper) GetAllEvidence(ctx sdk.Context) (evidence []exported.Evidence) {
+	k.IterateEvidence(ctx, func(e exported.Evidence) bool {
+		evidence = append(evidence, e)
+		return false
+	})
+	return evidence
+}
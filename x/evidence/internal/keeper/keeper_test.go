@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/types"
+)
+
+func TestSubmitEvidence(t *testing.T) {
+	input := newTestInput(t)
+	ctx, keeper := input.ctx, input.evidenceKeeper
+
+	// evidence rejected by its handler is not stored
+	invalid := testEvidence{Height: 1, Valid: false}
+	err := keeper.SubmitEvidence(ctx, invalid)
+	require.Error(t, err)
+	require.Equal(t, types.CodeInvalidEvidence, err.Code())
+	_, found := keeper.GetEvidence(ctx, invalid.Hash())
+	require.False(t, found)
+
+	// valid evidence is handled and stored by hash
+	valid := testEvidence{Height: 1, Valid: true}
+	require.NoError(t, keeper.SubmitEvidence(ctx, valid))
+	stored, found := keeper.GetEvidence(ctx, valid.Hash())
+	require.True(t, found)
+	require.Equal(t, valid, stored)
+
+	// the same evidence can't be submitted twice
+	err = keeper.SubmitEvidence(ctx, valid)
+	require.Error(t, err)
+	require.Equal(t, types.CodeEvidenceExists, err.Code())
+
+	// evidence without a registered handler is rejected, equivocation is only
+	// handled in BeginBlock
+	equivocation := types.NewEquivocation(1, ctx.BlockHeader().Time, 10, addrs[0].Bytes())
+	err = keeper.SubmitEvidence(ctx, equivocation)
+	require.Error(t, err)
+	require.Equal(t, types.CodeNoEvidenceHandlerExists, err.Code())
+}
+
+func TestIterateEvidence(t *testing.T) {
+	input := newTestInput(t)
+	ctx, keeper := input.ctx, input.evidenceKeeper
+
+	require.Empty(t, keeper.GetAllEvidence(ctx))
+
+	evidence := []exported.Evidence{
+		testEvidence{Height: 1, Valid: true},
+		testEvidence{Height: 2, Valid: true},
+		testEvidence{Height: 3, Valid: true},
+	}
+	for _, e := range evidence {
+		keeper.SetEvidence(ctx, e)
+	}
+
+	all := keeper.GetAllEvidence(ctx)
+	require.Len(t, all, len(evidence))
+	for _, e := range evidence {
+		require.Contains(t, all, e)
+	}
+
+	// stop the iteration early
+	count := 0
+	keeper.IterateEvidence(ctx, func(exported.Evidence) bool {
+		count++
+		return count == 2
+	})
+	require.Equal(t, 2, count)
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/types"
+)
+
+func TestNewQuerier(t *testing.T) {
+	input := newTestInput(t)
+	querier := NewQuerier(input.evidenceKeeper)
+
+	query := abci.RequestQuery{
+		Path: "",
+		Data: []byte{},
+	}
+
+	_, err := querier(input.ctx, []string{types.QueryParameters}, query)
+	require.NoError(t, err)
+
+	_, err = querier(input.ctx, []string{"foo"}, query)
+	require.Error(t, err)
+}
+
+func TestQueryEvidence(t *testing.T) {
+	input := newTestInput(t)
+	querier := NewQuerier(input.evidenceKeeper)
+
+	evidence := testEvidence{Height: 1, Valid: true}
+	input.evidenceKeeper.SetEvidence(input.ctx, evidence)
+
+	query := abci.RequestQuery{
+		Path: "",
+		Data: input.cdc.MustMarshalJSON(types.NewQueryEvidenceParams(evidence.Hash())),
+	}
+
+	res, sdkErr := querier(input.ctx, []string{types.QueryEvidence}, query)
+	require.NoError(t, sdkErr)
+
+	var stored exported.Evidence
+	require.NoError(t, input.cdc.UnmarshalJSON(res, &stored))
+	require.Equal(t, evidence, stored)
+
+	// unknown evidence
+	query.Data = input.cdc.MustMarshalJSON(types.NewQueryEvidenceParams(testEvidence{Height: 2}.Hash()))
+	_, sdkErr = querier(input.ctx, []string{types.QueryEvidence}, query)
+	require.Error(t, sdkErr)
+}
+
+func TestQueryAllEvidence(t *testing.T) {
+	input := newTestInput(t)
+	querier := NewQuerier(input.evidenceKeeper)
+
+	for i := int64(1); i <= 5; i++ {
+		input.evidenceKeeper.SetEvidence(input.ctx, testEvidence{Height: i, Valid: true})
+	}
+
+	tests := []struct {
+		page, limit int
+		expectedLen int
+	}{
+		{0, 0, 5},
+		{1, 2, 2},
+		{3, 2, 1},
+		{4, 2, 0},
+	}
+
+	for _, tc := range tests {
+		query := abci.RequestQuery{
+			Path: "",
+			Data: input.cdc.MustMarshalJSON(types.NewQueryAllEvidenceParams(tc.page, tc.limit)),
+		}
+
+		res, sdkErr := querier(input.ctx, []string{types.QueryAllEvidence}, query)
+		require.NoError(t, sdkErr)
+
+		var evidence []exported.Evidence
+		require.NoError(t, input.cdc.UnmarshalJSON(res, &evidence))
+		require.Len(t, evidence, tc.expectedLen, "page %d limit %d", tc.page, tc.limit)
+	}
+}
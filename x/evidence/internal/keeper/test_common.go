@@ -0,0 +1,202 @@
+// nolint:deadcode unused
+package keeper
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/x/evidence/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+)
+
+const routeTestEvidence = "testevidence"
+
+var (
+	pks = []crypto.PubKey{
+		newPubKey("0B485CFC0EECC619440448436F8FC9DF40566F2369E72400281454CB552AFB50"),
+		newPubKey("0B485CFC0EECC619440448436F8FC9DF40566F2369E72400281454CB552AFB51"),
+		newPubKey("0B485CFC0EECC619440448436F8FC9DF40566F2369E72400281454CB552AFB52"),
+	}
+	addrs = []sdk.ValAddress{
+		sdk.ValAddress(pks[0].Address()),
+		sdk.ValAddress(pks[1].Address()),
+		sdk.ValAddress(pks[2].Address()),
+	}
+	initTokens = sdk.TokensFromConsensusPower(200)
+	initCoins  = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initTokens))
+)
+
+// testEvidence is a minimal evidence type used to exercise the evidence router
+type testEvidence struct {
+	Height int64 `json:"height"`
+	Valid  bool  `json:"valid"`
+}
+
+var _ exported.Evidence = testEvidence{}
+
+func (e testEvidence) Route() string    { return routeTestEvidence }
+func (e testEvidence) Type() string     { return "test" }
+func (e testEvidence) String() string   { return fmt.Sprintf("test evidence at height %d", e.Height) }
+func (e testEvidence) GetHeight() int64 { return e.Height }
+
+func (e testEvidence) Hash() cmn.HexBytes {
+	return tmhash.Sum(types.ModuleCdc.MustMarshalBinaryBare(e))
+}
+
+func (e testEvidence) ValidateBasic() error {
+	if e.Height < 1 {
+		return errors.New("invalid height")
+	}
+	return nil
+}
+
+// testEvidenceHandler accepts valid test evidence and rejects invalid one
+func testEvidenceHandler(_ sdk.Context, evidence exported.Evidence) error {
+	if !evidence.(testEvidence).Valid {
+		return errors.New("evidence is not valid")
+	}
+	return nil
+}
+
+func init() {
+	types.RegisterEvidenceTypeCodec(testEvidence{}, "cosmos-sdk/TestEvidence")
+}
+
+type testInput struct {
+	ctx            sdk.Context
+	cdc            *codec.Codec
+	bankKeeper     bank.Keeper
+	stakingKeeper  staking.Keeper
+	slashingKeeper slashing.Keeper
+	evidenceKeeper Keeper
+}
+
+// create a codec used only for testing
+func makeTestCodec() *codec.Codec {
+	var cdc = codec.New()
+
+	sdk.RegisterCodec(cdc)
+	auth.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	bank.RegisterCodec(cdc)
+	staking.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	cdc.RegisterConcrete(testEvidence{}, "cosmos-sdk/TestEvidence", nil)
+
+	return cdc
+}
+
+func newTestInput(t *testing.T) testInput {
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keyStaking := sdk.NewKVStoreKey(staking.StoreKey)
+	tkeyStaking := sdk.NewTransientStoreKey(staking.TStoreKey)
+	keySlashing := sdk.NewKVStoreKey(slashing.StoreKey)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	keyEvidence := sdk.NewKVStoreKey(types.StoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyStaking, sdk.StoreTypeTransient, nil)
+	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySlashing, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(keyEvidence, sdk.StoreTypeIAVL, db)
+	err := ms.LoadLatestVersion()
+	require.Nil(t, err)
+
+	ctx := sdk.NewContext(ms, abci.Header{Time: time.Unix(0, 0)}, false, log.NewNopLogger())
+	cdc := makeTestCodec()
+
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tkeyParams, params.DefaultCodespace)
+	accountKeeper := auth.NewAccountKeeper(cdc, keyAcc, paramsKeeper.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, paramsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace)
+	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bankKeeper, supply.DefaultCodespace,
+		[]string{auth.FeeCollectorName}, []string{}, []string{staking.NotBondedPoolName, staking.BondedPoolName})
+
+	totalSupply := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initTokens.MulRaw(int64(len(addrs)))))
+	supplyKeeper.SetSupply(ctx, supply.NewSupply(totalSupply))
+
+	stakingKeeper := staking.NewKeeper(cdc, keyStaking, tkeyStaking, accountKeeper, supplyKeeper,
+		paramsKeeper.Subspace(staking.DefaultParamspace), staking.DefaultCodespace)
+
+	// set module accounts
+	feeCollectorAcc := supply.NewEmptyModuleAccount(auth.FeeCollectorName, supply.Basic)
+	notBondedPool := supply.NewEmptyModuleAccount(staking.NotBondedPoolName, supply.Burner)
+	bondPool := supply.NewEmptyModuleAccount(staking.BondedPoolName, supply.Burner)
+
+	supplyKeeper.SetModuleAccount(ctx, feeCollectorAcc)
+	supplyKeeper.SetModuleAccount(ctx, bondPool)
+	supplyKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	_ = staking.InitGenesis(ctx, stakingKeeper, accountKeeper, supplyKeeper, staking.DefaultGenesisState())
+
+	for _, addr := range addrs {
+		_, err = bankKeeper.AddCoins(ctx, sdk.AccAddress(addr), initCoins)
+		require.Nil(t, err)
+	}
+
+	slashingKeeper := slashing.NewKeeper(cdc, keySlashing, &stakingKeeper,
+		paramsKeeper.Subspace(slashing.DefaultParamspace), slashing.DefaultCodespace)
+	stakingKeeper.SetHooks(slashingKeeper.Hooks())
+	slashing.InitGenesis(ctx, slashingKeeper, stakingKeeper, slashing.DefaultGenesisState())
+
+	router := types.NewRouter().AddRoute(routeTestEvidence, testEvidenceHandler)
+	evidenceKeeper := NewKeeper(cdc, keyEvidence, paramsKeeper.Subspace(types.DefaultParamspace),
+		types.DefaultCodespace, &stakingKeeper, slashingKeeper, router)
+	evidenceKeeper.SetParams(ctx, types.DefaultParams())
+
+	return testInput{ctx, cdc, bankKeeper, stakingKeeper, slashingKeeper, evidenceKeeper}
+}
+
+// CreateTestInput returns a context along with the evidence, staking, slashing
+// and bank keepers of a test application
+func CreateTestInput(t *testing.T) (sdk.Context, Keeper, staking.Keeper, slashing.Keeper, bank.Keeper) {
+	input := newTestInput(t)
+	return input.ctx, input.evidenceKeeper, input.stakingKeeper, input.slashingKeeper, input.bankKeeper
+}
+
+func newPubKey(pk string) (res crypto.PubKey) {
+	pkBytes, err := hex.DecodeString(pk)
+	if err != nil {
+		panic(err)
+	}
+	var pkEd ed25519.PubKeyEd25519
+	copy(pkEd[:], pkBytes[:])
+	return pkEd
+}
+
+func newTestMsgCreateValidator(address sdk.ValAddress, pubKey crypto.PubKey, amt sdk.Int) staking.MsgCreateValidator {
+	commission := staking.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	return staking.NewMsgCreateValidator(
+		address, pubKey, sdk.NewCoin(sdk.DefaultBondDenom, amt),
+		staking.Description{}, commission, sdk.OneInt(),
+	)
+}
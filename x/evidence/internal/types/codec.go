@@ -0,0 +1,34 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+)
+
+// RegisterCodec registers the Evidence interface and the concrete types of the
+// evidence module on the codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterInterface((*exported.Evidence)(nil), nil)
+	cdc.RegisterConcrete(MsgSubmitEvidence{}, "cosmos-sdk/MsgSubmitEvidence", nil)
+	cdc.RegisterConcrete(Equivocation{}, "cosmos-sdk/Equivocation", nil)
+	cdc.RegisterConcrete(DoubleSignEvidence{}, "cosmos-sdk/DoubleSignEvidence", nil)
+}
+
+// RegisterEvidenceTypeCodec registers an external evidence type on the module
+// codec so that it can be submitted through MsgSubmitEvidence. The type must
+// also be registered on the application codec.
+//
+// NOTE: the module codec is not sealed so that applications can register
+// their own evidence types before starting the chain.
+func RegisterEvidenceTypeCodec(o interface{}, name string) {
+	ModuleCdc.RegisterConcrete(o, name, nil)
+}
+
+// ModuleCdc generic codec to be used throughout module
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Evidence errors reserve 100 ~ 199.
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeNoEvidenceHandlerExists sdk.CodeType = 101
+	CodeInvalidEvidence         sdk.CodeType = 102
+	CodeNoEvidenceExists        sdk.CodeType = 103
+	CodeEvidenceExists          sdk.CodeType = 104
+)
+
+// ErrNoEvidenceHandlerExists is an error
+func ErrNoEvidenceHandlerExists(codespace sdk.CodespaceType, route string) sdk.Error {
+	return sdk.NewError(codespace, CodeNoEvidenceHandlerExists, fmt.Sprintf("route '%s' does not have a registered evidence handler", route))
+}
+
+// ErrInvalidEvidence is an error
+func ErrInvalidEvidence(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidEvidence, fmt.Sprintf("invalid evidence: %s", msg))
+}
+
+// ErrNoEvidenceExists is an error
+func ErrNoEvidenceExists(codespace sdk.CodespaceType, hash string) sdk.Error {
+	return sdk.NewError(codespace, CodeNoEvidenceExists, fmt.Sprintf("evidence with hash %s does not exist", hash))
+}
+
+// ErrEvidenceExists is an error
+func ErrEvidenceExists(codespace sdk.CodespaceType, hash string) sdk.Error {
+	return sdk.NewError(codespace, CodeEvidenceExists, fmt.Sprintf("evidence with hash %s already exists", hash))
+}
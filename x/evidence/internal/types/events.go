@@ -0,0 +1,10 @@
+package types
+
+// Evidence module event types
+const (
+	EventTypeSubmitEvidence = "submit_evidence"
+
+	AttributeKeyEvidenceHash = "evidence_hash"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,186 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+)
+
+// Evidence type constants
+const (
+	RouteEquivocation = "equivocation"
+	TypeEquivocation  = "equivocation"
+
+	RouteDoubleSign = "doublesign"
+	TypeDoubleSign  = "double_sign"
+)
+
+// DoubleSignJailEndTime is the time until which a double signing validator is
+// jailed. It ends at the max time supported by Amino (Dec 31, 9999 - 23:59:59 GMT).
+var DoubleSignJailEndTime = time.Unix(253402300799, 0)
+
+var _ exported.Evidence = Equivocation{}
+
+// Equivocation implements the Evidence interface and defines evidence of a
+// validator signing two conflicting blocks at the same height (double
+// signing). Equivocation evidence is provided by Tendermint through BeginBlock
+// and is trusted as is. It is not routed through the evidence Router, so it
+// can't be submitted with a MsgSubmitEvidence: double signing detected
+// off-chain is submitted as DoubleSignEvidence instead.
+type Equivocation struct {
+	Height           int64           `json:"height" yaml:"height"`
+	Time             time.Time       `json:"time" yaml:"time"`
+	Power            int64           `json:"power" yaml:"power"`
+	ConsensusAddress sdk.ConsAddress `json:"consensus_address" yaml:"consensus_address"`
+}
+
+// NewEquivocation creates a new Equivocation instance
+func NewEquivocation(height int64, time time.Time, power int64, consAddr sdk.ConsAddress) Equivocation {
+	return Equivocation{
+		Height:           height,
+		Time:             time,
+		Power:            power,
+		ConsensusAddress: consAddr,
+	}
+}
+
+// ConvertDoubleSignEvidence converts Tendermint duplicate vote evidence into
+// an Equivocation
+func ConvertDoubleSignEvidence(evidence abci.Evidence) Equivocation {
+	return NewEquivocation(
+		evidence.Height, evidence.Time, evidence.Validator.Power,
+		sdk.ConsAddress(evidence.Validator.Address),
+	)
+}
+
+// Route returns the Evidence route of an Equivocation
+func (e Equivocation) Route() string { return RouteEquivocation }
+
+// Type returns the Evidence type of an Equivocation
+func (e Equivocation) Type() string { return TypeEquivocation }
+
+// String implements the Stringer interface
+func (e Equivocation) String() string {
+	return fmt.Sprintf(`Equivocation:
+  Height:            %d
+  Time:              %s
+  Power:             %d
+  Consensus Address: %s`, e.Height, e.Time, e.Power, e.ConsensusAddress)
+}
+
+// Hash returns the hash of an Equivocation
+func (e Equivocation) Hash() cmn.HexBytes {
+	return tmhash.Sum(ModuleCdc.MustMarshalBinaryBare(e))
+}
+
+// ValidateBasic performs basic stateless validation checks on an Equivocation
+func (e Equivocation) ValidateBasic() error {
+	if e.Time.IsZero() {
+		return fmt.Errorf("invalid equivocation time: %s", e.Time)
+	}
+	if e.Height < 1 {
+		return fmt.Errorf("invalid equivocation height: %d", e.Height)
+	}
+	if e.Power < 1 {
+		return fmt.Errorf("invalid equivocation validator power: %d", e.Power)
+	}
+	if e.ConsensusAddress.Empty() {
+		return fmt.Errorf("invalid equivocation validator consensus address: %s", e.ConsensusAddress)
+	}
+	return nil
+}
+
+// GetHeight returns the height at which the equivocation occurred
+func (e Equivocation) GetHeight() int64 {
+	return e.Height
+}
+
+var _ exported.Evidence = DoubleSignEvidence{}
+
+// DoubleSignEvidence implements the Evidence interface and defines evidence of
+// a validator signing two conflicting votes at the same height, round and
+// step. Unlike an Equivocation, it can be submitted by anyone with a
+// MsgSubmitEvidence as it carries both signed votes, which are verified against
+// the consensus public key of the validator before it is punished.
+type DoubleSignEvidence struct {
+	VoteA *tmtypes.Vote `json:"vote_a" yaml:"vote_a"`
+	VoteB *tmtypes.Vote `json:"vote_b" yaml:"vote_b"`
+}
+
+// NewDoubleSignEvidence creates a new DoubleSignEvidence instance
+func NewDoubleSignEvidence(voteA, voteB *tmtypes.Vote) DoubleSignEvidence {
+	return DoubleSignEvidence{
+		VoteA: voteA,
+		VoteB: voteB,
+	}
+}
+
+// Route returns the Evidence route of a DoubleSignEvidence
+func (e DoubleSignEvidence) Route() string { return RouteDoubleSign }
+
+// Type returns the Evidence type of a DoubleSignEvidence
+func (e DoubleSignEvidence) Type() string { return TypeDoubleSign }
+
+// String implements the Stringer interface
+func (e DoubleSignEvidence) String() string {
+	return fmt.Sprintf(`DoubleSignEvidence:
+  Vote A: %s
+  Vote B: %s`, e.VoteA, e.VoteB)
+}
+
+// Hash returns the hash of a DoubleSignEvidence
+func (e DoubleSignEvidence) Hash() cmn.HexBytes {
+	return tmhash.Sum(ModuleCdc.MustMarshalBinaryBare(e))
+}
+
+// ValidateBasic performs basic stateless validation checks on a
+// DoubleSignEvidence. The vote signatures are checked by Verify, which
+// requires the consensus public key of the validator.
+func (e DoubleSignEvidence) ValidateBasic() error {
+	if e.VoteA == nil || e.VoteB == nil {
+		return fmt.Errorf("one or both of the votes are empty %v, %v", e.VoteA, e.VoteB)
+	}
+	if err := e.VoteA.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid vote A: %v", err)
+	}
+	if err := e.VoteB.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid vote B: %v", err)
+	}
+	if e.VoteA.Height != e.VoteB.Height || e.VoteA.Round != e.VoteB.Round || e.VoteA.Type != e.VoteB.Type {
+		return fmt.Errorf("votes are not for the same height, round and step")
+	}
+	if !bytes.Equal(e.VoteA.ValidatorAddress, e.VoteB.ValidatorAddress) {
+		return fmt.Errorf("votes are not from the same validator")
+	}
+	if e.VoteA.BlockID.Equals(e.VoteB.BlockID) {
+		return fmt.Errorf("votes are for the same block %s", e.VoteA.BlockID)
+	}
+	return nil
+}
+
+// Verify returns an error if the votes are not conflicting votes of the
+// validator with the given consensus public key, signed on the given chain
+func (e DoubleSignEvidence) Verify(chainID string, pubKey crypto.PubKey) error {
+	evidence := tmtypes.DuplicateVoteEvidence{PubKey: pubKey, VoteA: e.VoteA, VoteB: e.VoteB}
+	return evidence.Verify(chainID, pubKey)
+}
+
+// GetHeight returns the height at which the conflicting votes were signed
+func (e DoubleSignEvidence) GetHeight() int64 {
+	return e.VoteA.Height
+}
+
+// ConsensusAddress returns the consensus address of the validator that signed
+// the conflicting votes
+func (e DoubleSignEvidence) ConsensusAddress() sdk.ConsAddress {
+	return sdk.ConsAddress(e.VoteA.ValidatorAddress)
+}
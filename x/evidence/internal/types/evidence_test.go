@@ -0,0 +1,59 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestEquivocation(t *testing.T) {
+	consAddr := sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address())
+	now := time.Now().UTC()
+
+	tests := []struct {
+		name       string
+		evidence   Equivocation
+		expectPass bool
+	}{
+		{"valid", NewEquivocation(10, now, 100, consAddr), true},
+		{"zero time", NewEquivocation(10, time.Time{}, 100, consAddr), false},
+		{"zero height", NewEquivocation(0, now, 100, consAddr), false},
+		{"zero power", NewEquivocation(10, now, 0, consAddr), false},
+		{"empty address", NewEquivocation(10, now, 100, nil), false},
+	}
+
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.evidence.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.Error(t, tc.evidence.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+
+	e := NewEquivocation(10, now, 100, consAddr)
+	require.Equal(t, RouteEquivocation, e.Route())
+	require.Equal(t, TypeEquivocation, e.Type())
+	require.Equal(t, int64(10), e.GetHeight())
+	require.Equal(t, e.Hash(), NewEquivocation(10, now, 100, consAddr).Hash())
+	require.NotEqual(t, e.Hash(), NewEquivocation(11, now, 100, consAddr).Hash())
+}
+
+func TestConvertDoubleSignEvidence(t *testing.T) {
+	pk := ed25519.GenPrivKey().PubKey()
+	now := time.Now().UTC()
+
+	tmEvidence := abci.Evidence{
+		Type:      tmtypes.ABCIEvidenceTypeDuplicateVote,
+		Validator: abci.Validator{Address: pk.Address(), Power: 100},
+		Height:    10,
+		Time:      now,
+	}
+
+	require.Equal(t, NewEquivocation(10, now, 100, sdk.ConsAddress(pk.Address())), ConvertDoubleSignEvidence(tmEvidence))
+}
@@ -0,0 +1,31 @@
+package types // noalias
+
+import (
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingKeeper defines the staking module interface contract needed by the
+// evidence module
+type StakingKeeper interface {
+	ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) stakingexported.ValidatorI
+	GetHistoricalInfo(sdk.Context, int64) (stakingtypes.HistoricalInfo, bool)
+}
+
+// SlashingKeeper defines the slashing module interface contract needed by the
+// evidence module
+type SlashingKeeper interface {
+	GetPubkey(sdk.Context, crypto.Address) (crypto.PubKey, error)
+	HasValidatorSigningInfo(sdk.Context, sdk.ConsAddress) bool
+	IsTombstoned(sdk.Context, sdk.ConsAddress) bool
+	Tombstone(sdk.Context, sdk.ConsAddress)
+	Slash(sdk.Context, sdk.ConsAddress, sdk.Dec, int64, int64)
+	SlashFractionDoubleSign(sdk.Context) sdk.Dec
+	Jail(sdk.Context, sdk.ConsAddress)
+	JailUntil(sdk.Context, sdk.ConsAddress, time.Time)
+}
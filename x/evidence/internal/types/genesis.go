@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+)
+
+// GenesisState - evidence genesis state
+type GenesisState struct {
+	Params   Params              `json:"params" yaml:"params"`
+	Evidence []exported.Evidence `json:"evidence" yaml:"evidence"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, evidence []exported.Evidence) GenesisState {
+	return GenesisState{
+		Params:   params,
+		Evidence: evidence,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), []exported.Evidence{})
+}
+
+// ValidateGenesis performs basic validation of evidence genesis data
+// returning an error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	if err := ValidateParams(data.Params); err != nil {
+		return err
+	}
+
+	seenEvidence := make(map[string]bool)
+	for _, evidence := range data.Evidence {
+		if err := evidence.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid evidence %s: %s", evidence.Hash(), err)
+		}
+
+		hash := evidence.Hash().String()
+		if seenEvidence[hash] {
+			return fmt.Errorf("duplicate evidence %s", hash)
+		}
+		seenEvidence[hash] = true
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package types
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "evidence"
+
+	// StoreKey is the store key string for evidence
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for evidence
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for evidence
+	QuerierRoute = ModuleName
+
+	// DefaultParamspace is the default paramspace for the params keeper
+	DefaultParamspace = ModuleName
+)
+
+// Keys for evidence store
+// Items are stored with the following key: values
+//
+// - 0x00<evidenceHash_Bytes>: Evidence
+var (
+	KeyPrefixEvidence = []byte{0x00}
+)
+
+// GetEvidenceKey returns the store key of a piece of evidence by its hash
+func GetEvidenceKey(hash []byte) []byte {
+	return append(KeyPrefixEvidence, hash...)
+}
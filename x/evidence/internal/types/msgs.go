@@ -0,0 +1,58 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+)
+
+// Evidence message types
+const (
+	TypeMsgSubmitEvidence = "submit_evidence"
+)
+
+var _ sdk.Msg = MsgSubmitEvidence{}
+
+// MsgSubmitEvidence defines a message to submit evidence of misbehavior that
+// was detected off-chain. Any account can submit evidence.
+type MsgSubmitEvidence struct {
+	Evidence  exported.Evidence `json:"evidence" yaml:"evidence"`
+	Submitter sdk.AccAddress    `json:"submitter" yaml:"submitter"`
+}
+
+// NewMsgSubmitEvidence creates a new MsgSubmitEvidence instance
+func NewMsgSubmitEvidence(evidence exported.Evidence, submitter sdk.AccAddress) MsgSubmitEvidence {
+	return MsgSubmitEvidence{
+		Evidence:  evidence,
+		Submitter: submitter,
+	}
+}
+
+// Route implements Msg
+func (msg MsgSubmitEvidence) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgSubmitEvidence) Type() string { return TypeMsgSubmitEvidence }
+
+// ValidateBasic implements Msg
+func (msg MsgSubmitEvidence) ValidateBasic() sdk.Error {
+	if msg.Evidence == nil {
+		return ErrInvalidEvidence(DefaultCodespace, "missing evidence")
+	}
+	if err := msg.Evidence.ValidateBasic(); err != nil {
+		return ErrInvalidEvidence(DefaultCodespace, err.Error())
+	}
+	if msg.Submitter.Empty() {
+		return sdk.ErrInvalidAddress("missing submitter address")
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgSubmitEvidence) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements Msg
+func (msg MsgSubmitEvidence) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Submitter}
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+)
+
+func TestMsgSubmitEvidence(t *testing.T) {
+	pk := ed25519.GenPrivKey().PubKey()
+	submitter := sdk.AccAddress(pk.Address())
+	valid := NewEquivocation(10, time.Now().UTC(), 100, sdk.ConsAddress(pk.Address()))
+	invalid := NewEquivocation(0, time.Now().UTC(), 100, sdk.ConsAddress(pk.Address()))
+
+	tests := []struct {
+		name       string
+		evidence   exported.Evidence
+		submitter  sdk.AccAddress
+		expectPass bool
+	}{
+		{"valid", valid, submitter, true},
+		{"missing evidence", nil, submitter, false},
+		{"invalid evidence", invalid, submitter, false},
+		{"empty submitter", valid, sdk.AccAddress{}, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgSubmitEvidence(tc.evidence, tc.submitter)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+
+	msg := NewMsgSubmitEvidence(valid, submitter)
+	require.Equal(t, []sdk.AccAddress{submitter}, msg.GetSigners())
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Default parameter values
+const (
+	DefaultMaxEvidenceAge time.Duration = 60 * 2 * time.Second
+)
+
+// Parameter store keys
+var (
+	KeyMaxEvidenceAge = []byte("MaxEvidenceAge")
+)
+
+// Params defines the parameters for the evidence module
+type Params struct {
+	MaxEvidenceAge time.Duration `json:"max_evidence_age" yaml:"max_evidence_age"` // maximum age of evidence that is still handled
+}
+
+// ParamKeyTable for the evidence module
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(maxEvidenceAge time.Duration) Params {
+	return Params{
+		MaxEvidenceAge: maxEvidenceAge,
+	}
+}
+
+// DefaultParams returns the default evidence module parameters
+func DefaultParams() Params {
+	return Params{
+		MaxEvidenceAge: DefaultMaxEvidenceAge,
+	}
+}
+
+// ValidateParams validates the evidence parameters
+func ValidateParams(params Params) error {
+	return validateMaxEvidenceAge(params.MaxEvidenceAge)
+}
+
+// String implements the Stringer interface
+func (p Params) String() string {
+	return fmt.Sprintf(`Evidence Params:
+  Max Evidence Age: %s`, p.MaxEvidenceAge)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyMaxEvidenceAge, &p.MaxEvidenceAge, validateMaxEvidenceAge),
+	}
+}
+
+func validateMaxEvidenceAge(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 1*time.Minute {
+		return fmt.Errorf("max evidence age must be at least 1 minute, is %s", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"strings"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+)
+
+// query endpoints supported by the evidence Querier
+const (
+	QueryParameters  = "parameters"
+	QueryEvidence    = "evidence"
+	QueryAllEvidence = "all_evidence"
+)
+
+// QueryEvidenceParams defines the params for the following queries:
+//
+// - 'custom/evidence/evidence'
+type QueryEvidenceParams struct {
+	EvidenceHash cmn.HexBytes
+}
+
+// NewQueryEvidenceParams creates a new instance of QueryEvidenceParams
+func NewQueryEvidenceParams(hash cmn.HexBytes) QueryEvidenceParams {
+	return QueryEvidenceParams{hash}
+}
+
+// QueryAllEvidenceParams defines the params for the following queries:
+//
+// - 'custom/evidence/all_evidence'
+type QueryAllEvidenceParams struct {
+	Page, Limit int
+}
+
+// NewQueryAllEvidenceParams creates a new instance of QueryAllEvidenceParams
+func NewQueryAllEvidenceParams(page, limit int) QueryAllEvidenceParams {
+	return QueryAllEvidenceParams{page, limit}
+}
+
+// QueryResEvidence defines the response of the 'custom/evidence/all_evidence'
+// query
+type QueryResEvidence []exported.Evidence
+
+// String implements fmt.Stringer
+func (e QueryResEvidence) String() string {
+	out := make([]string, len(e))
+	for i, evidence := range e {
+		out[i] = evidence.String()
+	}
+	return strings.Join(out, "\n")
+}
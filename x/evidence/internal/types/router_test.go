@@ -0,0 +1,34 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+)
+
+func testHandler(sdk.Context, exported.Evidence) error { return nil }
+
+func TestRouter(t *testing.T) {
+	rtr := NewRouter()
+
+	// only alphanumeric routes are allowed
+	require.Panics(t, func() { rtr.AddRoute("an/invalid/route", testHandler) })
+
+	rtr.AddRoute(RouteEquivocation, testHandler)
+	require.True(t, rtr.HasRoute(RouteEquivocation))
+	require.NotNil(t, rtr.GetRoute(RouteEquivocation))
+
+	// routes can't be registered twice
+	require.Panics(t, func() { rtr.AddRoute(RouteEquivocation, testHandler) })
+
+	require.False(t, rtr.HasRoute("unknown"))
+	require.Panics(t, func() { rtr.GetRoute("unknown") })
+
+	// no routes can be added once sealed
+	rtr.Seal()
+	require.Panics(t, func() { rtr.AddRoute("other", testHandler) })
+	require.Panics(t, func() { rtr.Seal() })
+}
@@ -23,6 +23,10 @@ const (
 	CodeInvalidGenesis           = types.CodeInvalidGenesis
 	CodeInvalidProposalStatus    = types.CodeInvalidProposalStatus
 	CodeProposalHandlerNotExists = types.CodeProposalHandlerNotExists
+	CodeInvalidProposalMsg       = types.CodeInvalidProposalMsg
+	CodeInvalidProposer          = types.CodeInvalidProposer
+	CodeInvalidVoteDelegation    = types.CodeInvalidVoteDelegation
+	CodeUnknownVoteDelegation    = types.CodeUnknownVoteDelegation
 	ModuleName                   = types.ModuleName
 	StoreKey                     = types.StoreKey
 	RouterKey                    = types.RouterKey
@@ -30,6 +34,10 @@ const (
 	DefaultParamspace            = types.DefaultParamspace
 	TypeMsgDeposit               = types.TypeMsgDeposit
 	TypeMsgVote                  = types.TypeMsgVote
+	TypeMsgVoteWeighted          = types.TypeMsgVoteWeighted
+	TypeMsgCancelProposal        = types.TypeMsgCancelProposal
+	TypeMsgDelegateVote          = types.TypeMsgDelegateVote
+	TypeMsgUndelegateVote        = types.TypeMsgUndelegateVote
 	TypeMsgSubmitProposal        = types.TypeMsgSubmitProposal
 	StatusNil                    = types.StatusNil
 	StatusDepositPeriod          = types.StatusDepositPeriod
@@ -37,8 +45,10 @@ const (
 	StatusPassed                 = types.StatusPassed
 	StatusRejected               = types.StatusRejected
 	StatusFailed                 = types.StatusFailed
+	StatusCancelled              = types.StatusCancelled
 	ProposalTypeText             = types.ProposalTypeText
 	ProposalTypeSoftwareUpgrade  = types.ProposalTypeSoftwareUpgrade
+	ProposalTypeMsgs             = types.ProposalTypeMsgs
 	QueryParams                  = types.QueryParams
 	QueryProposals               = types.QueryProposals
 	QueryProposal                = types.QueryProposal
@@ -47,6 +57,9 @@ const (
 	QueryVotes                   = types.QueryVotes
 	QueryVote                    = types.QueryVote
 	QueryTally                   = types.QueryTally
+	QueryVoteDelegations         = types.QueryVoteDelegations
+	QueryVoteDelegators          = types.QueryVoteDelegators
+	MaxVoteDelegationDepth       = types.MaxVoteDelegationDepth
 	ParamDeposit                 = types.ParamDeposit
 	ParamVoting                  = types.ParamVoting
 	ParamTallying                = types.ParamTallying
@@ -61,7 +74,10 @@ var (
 	// functions aliases
 	RegisterCodec                 = types.RegisterCodec
 	RegisterProposalTypeCodec     = types.RegisterProposalTypeCodec
+	RegisterProposalMsgCodec      = types.RegisterProposalMsgCodec
 	ValidateAbstract              = types.ValidateAbstract
+	WithProposalID                = types.WithProposalID
+	ProposalIDFromContext         = types.ProposalIDFromContext
 	NewDeposit                    = types.NewDeposit
 	ErrUnknownProposal            = types.ErrUnknownProposal
 	ErrInactiveProposal           = types.ErrInactiveProposal
@@ -71,8 +87,13 @@ var (
 	ErrInvalidProposalContent     = types.ErrInvalidProposalContent
 	ErrInvalidProposalType        = types.ErrInvalidProposalType
 	ErrInvalidVote                = types.ErrInvalidVote
+	ErrInvalidWeightedVote        = types.ErrInvalidWeightedVote
 	ErrInvalidGenesis             = types.ErrInvalidGenesis
 	ErrNoProposalHandlerExists    = types.ErrNoProposalHandlerExists
+	ErrInvalidProposalMsg         = types.ErrInvalidProposalMsg
+	ErrInvalidProposer            = types.ErrInvalidProposer
+	ErrInvalidVoteDelegation      = types.ErrInvalidVoteDelegation
+	ErrUnknownVoteDelegation      = types.ErrUnknownVoteDelegation
 	ProposalKey                   = types.ProposalKey
 	ActiveProposalByTimeKey       = types.ActiveProposalByTimeKey
 	ActiveProposalQueueKey        = types.ActiveProposalQueueKey
@@ -81,15 +102,28 @@ var (
 	DepositsKey                   = types.DepositsKey
 	DepositKey                    = types.DepositKey
 	VotesKey                      = types.VotesKey
+	VotePruningQueueKey           = types.VotePruningQueueKey
 	VoteKey                       = types.VoteKey
+	VoteDelegationsKey            = types.VoteDelegationsKey
+	VoteDelegationKey             = types.VoteDelegationKey
+	VoteDelegatorsKey             = types.VoteDelegatorsKey
+	VoteDelegatorKey              = types.VoteDelegatorKey
+	ValidatorVoteSharesKey        = types.ValidatorVoteSharesKey
+	ValidatorVoteShareKey         = types.ValidatorVoteShareKey
+	SplitKeyVoteDelegator         = types.SplitKeyVoteDelegator
 	SplitProposalKey              = types.SplitProposalKey
 	SplitActiveProposalQueueKey   = types.SplitActiveProposalQueueKey
 	SplitInactiveProposalQueueKey = types.SplitInactiveProposalQueueKey
 	SplitKeyDeposit               = types.SplitKeyDeposit
 	SplitKeyVote                  = types.SplitKeyVote
+	SplitVotePruningQueueKey      = types.SplitVotePruningQueueKey
 	NewMsgSubmitProposal          = types.NewMsgSubmitProposal
 	NewMsgDeposit                 = types.NewMsgDeposit
 	NewMsgVote                    = types.NewMsgVote
+	NewMsgVoteWeighted            = types.NewMsgVoteWeighted
+	NewMsgCancelProposal          = types.NewMsgCancelProposal
+	NewMsgDelegateVote            = types.NewMsgDelegateVote
+	NewMsgUndelegateVote          = types.NewMsgUndelegateVote
 	ParamKeyTable                 = types.ParamKeyTable
 	NewDepositParams              = types.NewDepositParams
 	NewTallyParams                = types.NewTallyParams
@@ -103,6 +137,7 @@ var (
 	EmptyTallyResult              = types.EmptyTallyResult
 	NewTextProposal               = types.NewTextProposal
 	NewSoftwareUpgradeProposal    = types.NewSoftwareUpgradeProposal
+	NewMsgsProposal               = types.NewMsgsProposal
 	RegisterProposalType          = types.RegisterProposalType
 	ContentFromProposalType       = types.ContentFromProposalType
 	IsValidProposalType           = types.IsValidProposalType
@@ -110,48 +145,70 @@ var (
 	NewQueryProposalParams        = types.NewQueryProposalParams
 	NewQueryDepositParams         = types.NewQueryDepositParams
 	NewQueryVoteParams            = types.NewQueryVoteParams
+	NewQueryVoteDelegationsParams = types.NewQueryVoteDelegationsParams
 	NewQueryProposalsParams       = types.NewQueryProposalsParams
 	NewVote                       = types.NewVote
+	NewWeightedVote               = types.NewWeightedVote
+	NewVoteDelegation             = types.NewVoteDelegation
+	NewValidatorVoteShares        = types.NewValidatorVoteShares
+	NewWeightedVoteOption         = types.NewWeightedVoteOption
+	NewNonSplitVoteOption         = types.NewNonSplitVoteOption
 	VoteOptionFromString          = types.VoteOptionFromString
 	ValidVoteOption               = types.ValidVoteOption
+	ValidWeightedVoteOptions      = types.ValidWeightedVoteOptions
 
 	// variable aliases
-	ModuleCdc                   = types.ModuleCdc
-	ProposalsKeyPrefix          = types.ProposalsKeyPrefix
-	ActiveProposalQueuePrefix   = types.ActiveProposalQueuePrefix
-	InactiveProposalQueuePrefix = types.InactiveProposalQueuePrefix
-	ProposalIDKey               = types.ProposalIDKey
-	DepositsKeyPrefix           = types.DepositsKeyPrefix
-	VotesKeyPrefix              = types.VotesKeyPrefix
-	ParamStoreKeyDepositParams  = types.ParamStoreKeyDepositParams
-	ParamStoreKeyVotingParams   = types.ParamStoreKeyVotingParams
-	ParamStoreKeyTallyParams    = types.ParamStoreKeyTallyParams
+	ModuleCdc                    = types.ModuleCdc
+	ProposalsKeyPrefix           = types.ProposalsKeyPrefix
+	ActiveProposalQueuePrefix    = types.ActiveProposalQueuePrefix
+	InactiveProposalQueuePrefix  = types.InactiveProposalQueuePrefix
+	ProposalIDKey                = types.ProposalIDKey
+	DepositsKeyPrefix            = types.DepositsKeyPrefix
+	VotesKeyPrefix               = types.VotesKeyPrefix
+	VotePruningQueuePrefix       = types.VotePruningQueuePrefix
+	VoteDelegationsKeyPrefix     = types.VoteDelegationsKeyPrefix
+	VoteDelegatorsKeyPrefix      = types.VoteDelegatorsKeyPrefix
+	ValidatorVoteSharesKeyPrefix = types.ValidatorVoteSharesKeyPrefix
+	ParamStoreKeyDepositParams   = types.ParamStoreKeyDepositParams
+	ParamStoreKeyVotingParams    = types.ParamStoreKeyVotingParams
+	ParamStoreKeyTallyParams     = types.ParamStoreKeyTallyParams
 )
 
 type (
-	Content                 = types.Content
-	Handler                 = types.Handler
-	Deposit                 = types.Deposit
-	Deposits                = types.Deposits
-	MsgSubmitProposal       = types.MsgSubmitProposal
-	MsgDeposit              = types.MsgDeposit
-	MsgVote                 = types.MsgVote
-	DepositParams           = types.DepositParams
-	TallyParams             = types.TallyParams
-	VotingParams            = types.VotingParams
-	Params                  = types.Params
-	Proposal                = types.Proposal
-	Proposals               = types.Proposals
-	ProposalQueue           = types.ProposalQueue
-	ProposalStatus          = types.ProposalStatus
-	TallyResult             = types.TallyResult
-	TextProposal            = types.TextProposal
-	SoftwareUpgradeProposal = types.SoftwareUpgradeProposal
-	QueryProposalParams     = types.QueryProposalParams
-	QueryDepositParams      = types.QueryDepositParams
-	QueryVoteParams         = types.QueryVoteParams
-	QueryProposalsParams    = types.QueryProposalsParams
-	Vote                    = types.Vote
-	Votes                   = types.Votes
-	VoteOption              = types.VoteOption
+	Content                    = types.Content
+	Handler                    = types.Handler
+	Deposit                    = types.Deposit
+	Deposits                   = types.Deposits
+	MsgSubmitProposal          = types.MsgSubmitProposal
+	MsgDeposit                 = types.MsgDeposit
+	MsgVote                    = types.MsgVote
+	MsgVoteWeighted            = types.MsgVoteWeighted
+	MsgCancelProposal          = types.MsgCancelProposal
+	MsgDelegateVote            = types.MsgDelegateVote
+	MsgUndelegateVote          = types.MsgUndelegateVote
+	DepositParams              = types.DepositParams
+	TallyParams                = types.TallyParams
+	VotingParams               = types.VotingParams
+	Params                     = types.Params
+	Proposal                   = types.Proposal
+	Proposals                  = types.Proposals
+	ProposalQueue              = types.ProposalQueue
+	ProposalStatus             = types.ProposalStatus
+	TallyResult                = types.TallyResult
+	TextProposal               = types.TextProposal
+	SoftwareUpgradeProposal    = types.SoftwareUpgradeProposal
+	MsgsProposal               = types.MsgsProposal
+	QueryProposalParams        = types.QueryProposalParams
+	QueryDepositParams         = types.QueryDepositParams
+	QueryVoteParams            = types.QueryVoteParams
+	QueryVoteDelegationsParams = types.QueryVoteDelegationsParams
+	QueryProposalsParams       = types.QueryProposalsParams
+	Vote                       = types.Vote
+	Votes                      = types.Votes
+	VoteDelegation             = types.VoteDelegation
+	VoteDelegations            = types.VoteDelegations
+	ValidatorVoteShares        = types.ValidatorVoteShares
+	VoteOption                 = types.VoteOption
+	WeightedVoteOption         = types.WeightedVoteOption
+	WeightedVoteOptions        = types.WeightedVoteOptions
 )
@@ -42,6 +42,14 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	require.Equal(t, "My awesome proposal", proposal1.Description)
 	require.Equal(t, "Text", proposal1.Type)
 	require.Equal(t, "1000test", proposal1.Deposit)
+	require.False(t, proposal1.Expedited)
+
+	// ok json with the expedited flag
+	viper.Set(FlagExpedited, true)
+	proposal1, err = parseSubmitProposalFlags()
+	require.Nil(t, err, "unexpected error")
+	require.True(t, proposal1.Expedited)
+	viper.Set(FlagExpedited, false)
 
 	// flags that can't be used with --proposal
 	for _, incompatibleFlag := range ProposalFlags {
@@ -63,6 +71,13 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	require.Equal(t, proposal1.Description, proposal2.Description)
 	require.Equal(t, proposal1.Type, proposal2.Type)
 	require.Equal(t, proposal1.Deposit, proposal2.Deposit)
+	require.False(t, proposal2.Expedited)
+
+	viper.Set(FlagExpedited, true)
+	proposal2, err = parseSubmitProposalFlags()
+	require.Nil(t, err, "unexpected error")
+	require.True(t, proposal2.Expedited)
+	viper.Set(FlagExpedited, false)
 
 	err = okJSON.Close()
 	require.Nil(t, err, "unexpected error")
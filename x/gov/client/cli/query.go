@@ -38,7 +38,9 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryProposer(queryRoute, cdc),
 		GetCmdQueryDeposit(queryRoute, cdc),
 		GetCmdQueryDeposits(queryRoute, cdc),
-		GetCmdQueryTally(queryRoute, cdc))...)
+		GetCmdQueryTally(queryRoute, cdc),
+		GetCmdQueryVoteDelegations(queryRoute, cdc),
+		GetCmdQueryVoteDelegators(queryRoute, cdc))...)
 
 	return govQueryCmd
 }
@@ -162,7 +164,7 @@ $ %s query gov proposals --status (DepositPeriod|VotingPeriod|Passed|Rejected)
 	cmd.Flags().String(flagNumLimit, "", "(optional) limit to latest [number] proposals. Defaults to all proposals")
 	cmd.Flags().String(flagDepositor, "", "(optional) filter by proposals deposited on by depositor")
 	cmd.Flags().String(flagVoter, "", "(optional) filter by proposals voted on by voted")
-	cmd.Flags().String(flagStatus, "", "(optional) filter proposals by proposal status, status: deposit_period/voting_period/passed/rejected")
+	cmd.Flags().String(flagStatus, "", "(optional) filter proposals by proposal status, status: deposit_period/voting_period/passed/rejected/cancelled")
 
 	return cmd
 }
@@ -587,4 +589,69 @@ $ %s query gov proposer 1
 	}
 }
 
+// GetCmdQueryVoteDelegations implements the query vote delegations command.
+func GetCmdQueryVoteDelegations(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "vote-delegations [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the governance delegates of an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whom an address delegates its governance vote to, per proposal type.
+
+Example:
+$ %s query gov vote-delegations cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return queryVoteDelegations(cdc, queryRoute, types.QueryVoteDelegations, args[0])
+		},
+	}
+}
+
+// GetCmdQueryVoteDelegators implements the query vote delegators command.
+func GetCmdQueryVoteDelegators(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "vote-delegators [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the accounts delegating their governance vote to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query who delegates its governance vote to an address, per proposal type.
+
+Example:
+$ %s query gov vote-delegators cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return queryVoteDelegations(cdc, queryRoute, types.QueryVoteDelegators, args[0])
+		},
+	}
+}
+
+func queryVoteDelegations(cdc *codec.Codec, queryRoute, queryPath, bech32Addr string) error {
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+	addr, err := sdk.AccAddressFromBech32(bech32Addr)
+	if err != nil {
+		return err
+	}
+
+	bz, err := cdc.MarshalJSON(types.NewQueryVoteDelegationsParams(addr))
+	if err != nil {
+		return err
+	}
+
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, queryPath), bz)
+	if err != nil {
+		return err
+	}
+
+	var voteDelegations types.VoteDelegations
+	cdc.MustUnmarshalJSON(res, &voteDelegations)
+	return cliCtx.PrintOutput(voteDelegations)
+}
+
 // DONTCOVER
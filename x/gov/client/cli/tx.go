@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -29,6 +30,7 @@ const (
 	flagStatus       = "status"
 	flagNumLimit     = "limit"
 	FlagProposal     = "proposal"
+	FlagExpedited    = "expedited"
 )
 
 type proposal struct {
@@ -36,6 +38,7 @@ type proposal struct {
 	Description string
 	Type        string
 	Deposit     string
+	Expedited   bool
 }
 
 // ProposalFlags defines the core required fields of a proposal. It is used to
@@ -63,6 +66,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec, pcmds []*cobra.Command) *cobra.
 	}
 
 	cmdSubmitProp := GetCmdSubmitProposal(cdc)
+	cmdSubmitProp.AddCommand(client.PostCommands(GetCmdSubmitMsgsProposal(cdc))[0])
 	for _, pcmd := range pcmds {
 		cmdSubmitProp.AddCommand(client.PostCommands(pcmd)[0])
 	}
@@ -70,6 +74,10 @@ func GetTxCmd(storeKey string, cdc *codec.Codec, pcmds []*cobra.Command) *cobra.
 	govTxCmd.AddCommand(client.PostCommands(
 		GetCmdDeposit(cdc),
 		GetCmdVote(cdc),
+		GetCmdWeightedVote(cdc),
+		GetCmdCancelProposal(cdc),
+		GetCmdDelegateVote(cdc),
+		GetCmdUndelegateVote(cdc),
 		cmdSubmitProp,
 	)...)
 
@@ -100,8 +108,13 @@ Where proposal.json contains:
 Which is equivalent to:
 
 $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="10test" --from mykey
+
+Expedited proposals require a higher deposit and threshold but have a shorter voting period.
+They are converted to regular proposals if they do not pass:
+
+$ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="50test" --expedited --from mykey
 `,
-				version.ClientName, version.ClientName,
+				version.ClientName, version.ClientName, version.ClientName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -121,6 +134,7 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 			content := types.ContentFromProposalType(proposal.Title, proposal.Description, proposal.Type)
 
 			msg := types.NewMsgSubmitProposal(content, amount, cliCtx.GetFromAddress())
+			msg.Expedited = proposal.Expedited
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -134,10 +148,77 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 	cmd.Flags().String(flagProposalType, "", "proposalType of proposal, types: text/parameter_change/software_upgrade")
 	cmd.Flags().String(FlagDeposit, "", "deposit of proposal")
 	cmd.Flags().String(FlagProposal, "", "proposal file path (if this path is given, other proposal flags are ignored)")
+	cmd.Flags().Bool(FlagExpedited, false, "submit the proposal as an expedited proposal")
 
 	return cmd
 }
 
+// GetCmdSubmitMsgsProposal implements submitting a proposal which executes
+// messages signed by the governance module account once it passes.
+func GetCmdSubmitMsgsProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "msgs [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal executing messages signed by the governance account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal along with an initial deposit which, once passed,
+executes the given messages in order. Every message must be signed by the governance
+module account only and the state changes are only committed if all of them succeed.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal msgs <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Change denom admin",
+  "description": "Hand over the administration of the denom",
+  "msgs": [
+    {
+      "type": "cosmos-sdk/MsgChangeAdmin",
+      "value": {
+        "sender": "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+        "denom": "factory/cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn/token",
+        "new_admin": "cosmos1jv65s3grqf6v6jl3dp4t6c9t9rk99cd88lyufl"
+      }
+    }
+  ],
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ],
+  "expedited": false
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := govutils.ParseMsgsProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewMsgsProposal(proposal.Title, proposal.Description, proposal.Msgs)
+
+			msg := types.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			msg.Expedited = proposal.Expedited
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // GetCmdDeposit implements depositing tokens for an active proposal.
 func GetCmdDeposit(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -232,4 +313,165 @@ $ %s tx gov vote 1 yes --from mykey
 	}
 }
 
+// GetCmdWeightedVote implements creating a new weighted vote command.
+func GetCmdWeightedVote(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "weighted-vote [proposal-id] [weighted-options]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Vote for an active proposal with split options, e.g. yes=0.6,no=0.4",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a weighted vote for an active proposal. The voting power
+is split across the given options according to their weights, which must sum
+up to 1. You can find the proposal-id by running "%s query gov proposals".
+
+
+Example:
+$ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.1 --from mykey
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Get voting address
+			from := cliCtx.GetFromAddress()
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			// Find out which vote options and weights user chose
+			options, err := govutils.ParseWeightedVoteOptions(args[1])
+			if err != nil {
+				return err
+			}
+
+			// Build vote message and run basic validation
+			msg := types.NewMsgVoteWeighted(from, proposalID, options)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdCancelProposal implements cancelling a proposal command.
+func GetCmdCancelProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a proposal in its deposit or voting period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a proposal submitted by the sender while it is in its deposit or
+voting period. Its votes are deleted and its deposits are refunded minus the
+proposal cancel ratio, which is burned.
+
+Example:
+$ %s tx gov cancel-proposal 1 --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			msg := types.NewMsgCancelProposal(cliCtx.GetFromAddress(), proposalID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdDelegateVote implements delegating the vote of an account command.
+func GetCmdDelegateVote(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-vote [delegate-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Designate the governance delegate of the sender",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Designate the account whose vote is counted with the sender's staked power on
+proposals the sender does not vote on. The delegation applies to all proposal
+types unless a proposal type is given, in which case it takes precedence over
+the delegation for all types on proposals of that type.
+
+Example:
+$ %s tx gov delegate-vote cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --from mykey
+$ %s tx gov delegate-vote cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --type ParameterChange --from mykey
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delegate, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDelegateVote(cliCtx.GetFromAddress(), delegate, viper.GetString(flagProposalType))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagProposalType, "", "proposal type the delegation applies to, all types if empty")
+	return cmd
+}
+
+// GetCmdUndelegateVote implements removing the governance delegate of an account command.
+func GetCmdUndelegateVote(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undelegate-vote",
+		Args:  cobra.NoArgs,
+		Short: "Remove the governance delegate of the sender",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove the governance delegate of the sender for all proposal types, or for
+the given proposal type only.
+
+Example:
+$ %s tx gov undelegate-vote --from mykey
+$ %s tx gov undelegate-vote --type ParameterChange --from mykey
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgUndelegateVote(cliCtx.GetFromAddress(), viper.GetString(flagProposalType))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagProposalType, "", "proposal type of the removed delegation, all types if empty")
+	return cmd
+}
+
 // DONTCOVER
@@ -1,6 +1,40 @@
 package utils
 
-import "github.com/cosmos/cosmos-sdk/x/gov/types"
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// MsgsProposalJSON defines a MsgsProposal with a deposit used to parse
+// proposals executing messages from a JSON file.
+type MsgsProposalJSON struct {
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Msgs        []sdk.Msg `json:"msgs"`
+	Deposit     sdk.Coins `json:"deposit"`
+	Expedited   bool      `json:"expedited"`
+}
+
+// ParseMsgsProposalJSON reads and parses a MsgsProposalJSON from file.
+func ParseMsgsProposalJSON(cdc *codec.Codec, proposalFile string) (MsgsProposalJSON, error) {
+	proposal := MsgsProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
 
 // NormalizeVoteOption - normalize user specified vote option
 func NormalizeVoteOption(option string) string {
@@ -22,6 +56,32 @@ func NormalizeVoteOption(option string) string {
 	}
 }
 
+// ParseWeightedVoteOptions - parse user specified weighted vote options of
+// the form "yes=0.6,no=0.4"
+func ParseWeightedVoteOptions(s string) (types.WeightedVoteOptions, error) {
+	var options types.WeightedVoteOptions
+	for _, part := range strings.Split(s, ",") {
+		fields := strings.Split(strings.TrimSpace(part), "=")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid weighted vote option %q, expected option=weight", part)
+		}
+
+		option, err := types.VoteOptionFromString(NormalizeVoteOption(fields[0]))
+		if err != nil {
+			return nil, err
+		}
+
+		weight, err := sdk.NewDecFromStr(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight %q for option %s: %v", fields[1], fields[0], err)
+		}
+
+		options = append(options, types.NewWeightedVoteOption(option, weight))
+	}
+
+	return options, nil
+}
+
 //NormalizeProposalType - normalize user specified proposal type
 func NormalizeProposalType(proposalType string) string {
 	switch proposalType {
@@ -47,6 +107,8 @@ func NormalizeProposalStatus(status string) string {
 		return "Passed"
 	case "Rejected", "rejected":
 		return "Rejected"
+	case "Cancelled", "cancelled":
+		return "Cancelled"
 	}
 	return ""
 }
@@ -51,7 +51,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
-	if proposal.Status == StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(keeper.GetDepositParams(ctx).MinDeposit) {
+	if proposal.Status == StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(keeper.getMinDeposit(ctx, proposal)) {
 		keeper.activateVotingPeriod(ctx, proposal)
 		activatedVotingPeriod = true
 	}
@@ -100,6 +100,15 @@ func (keeper Keeper) GetDepositsIterator(ctx sdk.Context, proposalID uint64) sdk
 	return sdk.KVStorePrefixIterator(store, types.DepositsKey(proposalID))
 }
 
+// getMinDeposit returns the deposit required for the proposal to enter its
+// voting period
+func (keeper Keeper) getMinDeposit(ctx sdk.Context, proposal Proposal) sdk.Coins {
+	if proposal.Expedited {
+		return keeper.GetDepositParams(ctx).ExpeditedMinDeposit
+	}
+	return keeper.GetDepositParams(ctx).MinDeposit
+}
+
 // RefundDeposits refunds and deletes all the deposits on a specific proposal
 func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -115,6 +124,40 @@ func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID uint64) {
 	})
 }
 
+// refundAndBurnDeposits burns the given fraction of every deposit on a specific
+// proposal, refunds the rest to the depositors and deletes the deposits
+func (keeper Keeper) refundAndBurnDeposits(ctx sdk.Context, proposalID uint64, burnRatio sdk.Dec) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	keeper.IterateDeposits(ctx, proposalID, func(deposit types.Deposit) bool {
+		var burnAmount sdk.Coins
+		for _, coin := range deposit.Amount {
+			amount := coin.Amount.ToDec().Mul(burnRatio).TruncateInt()
+			if amount.IsPositive() {
+				burnAmount = append(burnAmount, sdk.NewCoin(coin.Denom, amount))
+			}
+		}
+
+		if !burnAmount.Empty() {
+			err := keeper.supplyKeeper.BurnCoins(ctx, types.ModuleName, burnAmount)
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		refundAmount := deposit.Amount.Sub(burnAmount)
+		if !refundAmount.Empty() {
+			err := keeper.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, deposit.Depositor, refundAmount)
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		store.Delete(DepositKey(proposalID, deposit.Depositor))
+		return false
+	})
+}
+
 // DeleteDeposits deletes all the deposits on a specific proposal without refunding them
 func (keeper Keeper) DeleteDeposits(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
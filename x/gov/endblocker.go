@@ -7,10 +7,17 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
+// maxVotesPrunedPerBlock bounds the number of votes of ended proposals that
+// are deleted in a single block
+const maxVotesPrunedPerBlock = 1000
+
 // EndBlocker called every block, process inflation, update validator set.
 func EndBlocker(ctx sdk.Context, keeper Keeper) {
 	logger := keeper.Logger(ctx)
 
+	// delete a bounded batch of the votes of proposals that have ended
+	keeper.pruneVotes(ctx, maxVotesPrunedPerBlock)
+
 	// delete inactive proposal from store and its deposits
 	keeper.IterateInactiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal Proposal) bool {
 		keeper.DeleteProposal(ctx, proposal.ProposalID)
@@ -28,7 +35,7 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 			fmt.Sprintf("proposal %d (%s) didn't meet minimum deposit of %s (had only %s); deleted",
 				proposal.ProposalID,
 				proposal.GetTitle(),
-				keeper.GetDepositParams(ctx).MinDeposit,
+				keeper.getMinDeposit(ctx, proposal),
 				proposal.TotalDeposit,
 			),
 		)
@@ -41,6 +48,39 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 
 		passes, burnDeposits, tallyResults := tally(ctx, keeper, proposal)
 
+		// An expedited proposal that does not pass is converted to a regular
+		// proposal with a fresh voting period. Its votes and deposits are kept.
+		// Vetoed proposals and proposals that missed quorum are rejected as usual.
+		if !passes && !burnDeposits && proposal.Expedited {
+			keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+
+			proposal.Expedited = false
+			proposal.VotingEndTime = ctx.BlockHeader().Time.Add(keeper.GetVotingParams(ctx).VotingPeriod)
+
+			keeper.SetProposal(ctx, proposal)
+			keeper.InsertActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+
+			logger.Info(
+				fmt.Sprintf(
+					"expedited proposal %d (%s) tallied; result: converted to a regular proposal",
+					proposal.ProposalID, proposal.GetTitle(),
+				),
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeActiveProposal,
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalID)),
+					sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueExpeditedProposalRejected),
+				),
+			)
+			return false
+		}
+
+		// the votes are deleted over the following blocks by pruneVotes
+		keeper.insertVotePruningQueue(ctx, proposal.ProposalID)
+		keeper.deleteValidatorVoteShares(ctx, proposal.ProposalID)
+
 		if burnDeposits {
 			keeper.DeleteDeposits(ctx, proposal.ProposalID)
 		} else {
@@ -48,13 +88,15 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 		}
 
 		if passes {
-			handler := keeper.router.GetRoute(proposal.ProposalRoute())
 			cacheCtx, writeCache := ctx.CacheContext()
-
-			// The proposal handler may execute state mutating logic depending
-			// on the proposal content. If the handler fails, no state mutation
-			// is written and the error message is logged.
-			err := handler(cacheCtx, proposal.Content)
+			cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+			cacheCtx = types.WithProposalID(cacheCtx, proposal.ProposalID)
+
+			// The proposal handler or messages may execute state mutating logic
+			// depending on the proposal content. If the execution fails, no state
+			// mutation is written and the error message is logged and stored on
+			// the proposal.
+			err := keeper.executeProposal(cacheCtx, proposal.Content)
 			if err == nil {
 				proposal.Status = StatusPassed
 				tagValue = types.AttributeValueProposalPassed
@@ -62,8 +104,10 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 
 				// write state to the underlying multi-store
 				writeCache()
+				ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
 			} else {
 				proposal.Status = StatusFailed
+				proposal.FailureLog = err.ABCILog()
 				tagValue = types.AttributeValueProposalFailed
 				logMsg = fmt.Sprintf("passed, but failed on execution: %s", err.ABCILog())
 			}
@@ -8,7 +8,9 @@ import (
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
@@ -332,3 +334,284 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// validate that the proposal fails/has been rejected
 	EndBlocker(ctx, input.keeper)
 }
+
+func TestEndBlockerMsgsProposal(t *testing.T) {
+	input := getMockApp(t, 2, GenesisState{}, nil)
+	SortAddresses(input.addrs)
+
+	handler := NewHandler(input.keeper)
+	stakingHandler := staking.NewHandler(input.sk)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	valAddr := sdk.ValAddress(input.addrs[0])
+
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	staking.EndBlocker(ctx, input.sk)
+
+	govAddr := input.keeper.GetGovernanceAccount(ctx).GetAddress()
+	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10)))
+
+	// the messages of a proposal must be signed by the governance account
+	content := NewMsgsProposal("Test", "description", []sdk.Msg{NewMsgVote(input.addrs[0], 1, OptionYes)})
+	_, err := input.keeper.SubmitProposal(ctx, content)
+	require.Error(t, err)
+
+	// the messages must be routable
+	content = NewMsgsProposal("Test", "description", []sdk.Msg{NewMsgDeposit(govAddr, 1, proposalCoins)})
+	msgRouter := input.keeper.msgRouter
+	input.keeper.msgRouter = baseapp.NewRouter()
+	_, err = input.keeper.SubmitProposal(ctx, content)
+	require.Error(t, err)
+	input.keeper.msgRouter = msgRouter
+
+	// a text proposal the governance account votes on
+	textProposal, err := input.keeper.SubmitProposal(ctx, testProposal())
+	require.NoError(t, err)
+	textProposal.Status = StatusVotingPeriod
+	input.keeper.SetProposal(ctx, textProposal)
+	input.keeper.RemoveFromInactiveProposalQueue(ctx, textProposal.ProposalID, textProposal.DepositEndTime)
+
+	contents := []Content{
+		// the second vote fails, so the first one must not be committed either
+		NewMsgsProposal("Test", "description", []sdk.Msg{
+			NewMsgVote(govAddr, textProposal.ProposalID, OptionNo),
+			NewMsgVote(govAddr, 100, OptionNo),
+		}),
+		// sending coins would spend the deposits held by the governance account
+		NewMsgsProposal("Test", "description", []sdk.Msg{
+			bank.MsgSend{FromAddress: govAddr, ToAddress: input.addrs[1], Amount: proposalCoins},
+		}),
+		NewMsgsProposal("Test", "description", []sdk.Msg{
+			NewMsgVote(govAddr, textProposal.ProposalID, OptionYes),
+		}),
+	}
+
+	var proposalIDs []uint64
+	for _, content := range contents {
+		proposal, err := input.keeper.SubmitProposal(ctx, content)
+		require.NoError(t, err)
+		proposalIDs = append(proposalIDs, proposal.ProposalID)
+
+		res := handler(ctx, NewMsgDeposit(input.addrs[0], proposal.ProposalID, proposalCoins))
+		require.True(t, res.IsOK())
+
+		err = input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], OptionYes)
+		require.NoError(t, err)
+	}
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(input.keeper.GetDepositParams(ctx).MaxDepositPeriod).Add(input.keeper.GetVotingParams(ctx).VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	EndBlocker(ctx, input.keeper)
+
+	for i, expected := range []ProposalStatus{StatusFailed, StatusFailed, StatusPassed} {
+		proposal, ok := input.keeper.GetProposal(ctx, proposalIDs[i])
+		require.True(t, ok)
+		require.Equal(t, expected, proposal.Status, "proposal %d: %s", i, proposal.FailureLog)
+		require.Equal(t, expected == StatusFailed, proposal.FailureLog != "", "proposal %d", i)
+	}
+
+	vote, found := input.keeper.GetVote(ctx, textProposal.ProposalID, govAddr)
+	require.True(t, found)
+	require.Equal(t, OptionYes, vote.Option)
+
+	require.NoError(t, ModuleAccountInvariant(input.keeper)(ctx))
+}
+
+func TestEndBlockerExpeditedProposalPasses(t *testing.T) {
+	input := getMockApp(t, 4, GenesisState{}, nil)
+	SortAddresses(input.addrs)
+
+	handler := NewHandler(input.keeper)
+	stakingHandler := staking.NewHandler(input.sk)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(input.addrs[0]), sdk.ValAddress(input.addrs[1])}
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{7, 3})
+	staking.EndBlocker(ctx, input.sk)
+
+	// the regular min deposit does not activate an expedited proposal
+	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(30))}
+	newProposalMsg := NewMsgSubmitProposal(testProposal(), proposalCoins, input.addrs[2])
+	newProposalMsg.Expedited = true
+	res := handler(ctx, newProposalMsg)
+	require.True(t, res.IsOK())
+
+	var proposalID uint64
+	input.keeper.cdc.MustUnmarshalBinaryLengthPrefixed(res.Data, &proposalID)
+	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.True(t, proposal.Expedited)
+	require.Equal(t, StatusDepositPeriod, proposal.Status)
+
+	proposalCoins = sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(20))}
+	res = handler(ctx, NewMsgDeposit(input.addrs[3], proposalID, proposalCoins))
+	require.True(t, res.IsOK())
+
+	proposal, ok = input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusVotingPeriod, proposal.Status)
+	require.Equal(t, proposal.VotingStartTime.Add(input.keeper.GetVotingParams(ctx).ExpeditedVotingPeriod), proposal.VotingEndTime)
+
+	require.NoError(t, input.keeper.AddVote(ctx, proposalID, input.addrs[0], OptionYes))
+	require.NoError(t, input.keeper.AddVote(ctx, proposalID, input.addrs[1], OptionNo))
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = proposal.VotingEndTime
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	EndBlocker(ctx, input.keeper)
+
+	proposal, ok = input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusPassed, proposal.Status)
+	require.True(t, proposal.Expedited)
+	require.Len(t, input.keeper.GetVotes(ctx, proposalID), 2)
+	require.Empty(t, input.keeper.GetDeposits(ctx, proposalID))
+
+	// the votes are pruned by the next block
+	EndBlocker(ctx, input.keeper)
+	require.Empty(t, input.keeper.GetVotes(ctx, proposalID))
+}
+
+func TestEndBlockerExpeditedProposalConverted(t *testing.T) {
+	input := getMockApp(t, 4, GenesisState{}, nil)
+	SortAddresses(input.addrs)
+
+	handler := NewHandler(input.keeper)
+	stakingHandler := staking.NewHandler(input.sk)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(input.addrs[0]), sdk.ValAddress(input.addrs[1])}
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{6, 4})
+	staking.EndBlocker(ctx, input.sk)
+
+	proposal, err := input.keeper.SubmitExpeditedProposal(ctx, testProposal())
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+
+	for i, power := range []int64{30, 20} {
+		proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(power))}
+		res := handler(ctx, NewMsgDeposit(input.addrs[i+2], proposalID, proposalCoins))
+		require.True(t, res.IsOK())
+	}
+
+	// 60% of yes votes pass the regular threshold but not the expedited one
+	require.NoError(t, input.keeper.AddVote(ctx, proposalID, input.addrs[0], OptionYes))
+	require.NoError(t, input.keeper.AddVote(ctx, proposalID, input.addrs[1], OptionNo))
+
+	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	expeditedEndTime := proposal.VotingEndTime
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = expeditedEndTime
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	EndBlocker(ctx, input.keeper)
+
+	proposal, ok = input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusVotingPeriod, proposal.Status)
+	require.False(t, proposal.Expedited)
+	require.Equal(t, expeditedEndTime.Add(input.keeper.GetVotingParams(ctx).VotingPeriod), proposal.VotingEndTime)
+	require.Len(t, input.keeper.GetVotes(ctx, proposalID), 2)
+	require.Len(t, input.keeper.GetDeposits(ctx, proposalID), 2)
+
+	activeQueue := input.keeper.ActiveProposalQueueIterator(ctx, ctx.BlockHeader().Time)
+	require.False(t, activeQueue.Valid())
+	activeQueue.Close()
+
+	newHeader = ctx.BlockHeader()
+	newHeader.Time = proposal.VotingEndTime
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	EndBlocker(ctx, input.keeper)
+
+	proposal, ok = input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusPassed, proposal.Status)
+	require.Len(t, input.keeper.GetVotes(ctx, proposalID), 2)
+	require.Empty(t, input.keeper.GetDeposits(ctx, proposalID))
+
+	EndBlocker(ctx, input.keeper)
+	require.Empty(t, input.keeper.GetVotes(ctx, proposalID))
+}
+
+func TestEndBlockerExpeditedProposalRejected(t *testing.T) {
+	testCases := []struct {
+		name   string
+		powers []int64
+		votes  []VoteOption
+	}{
+		// a veto is final and is not retried in a regular voting period
+		{"vetoed", []int64{6, 4}, []VoteOption{OptionYes, OptionNoWithVeto}},
+		// 20% of the voting power does not reach the quorum
+		{"no quorum", []int64{8, 2}, []VoteOption{OptionEmpty, OptionYes}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			input := getMockApp(t, 4, GenesisState{}, nil)
+			SortAddresses(input.addrs)
+
+			handler := NewHandler(input.keeper)
+			stakingHandler := staking.NewHandler(input.sk)
+
+			header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+			input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+			ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+			valAddrs := []sdk.ValAddress{sdk.ValAddress(input.addrs[0]), sdk.ValAddress(input.addrs[1])}
+			createValidators(t, stakingHandler, ctx, valAddrs, tc.powers)
+			staking.EndBlocker(ctx, input.sk)
+
+			proposal, err := input.keeper.SubmitExpeditedProposal(ctx, testProposal())
+			require.NoError(t, err)
+			proposalID := proposal.ProposalID
+
+			for i, power := range []int64{30, 20} {
+				proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(power))}
+				res := handler(ctx, NewMsgDeposit(input.addrs[i+2], proposalID, proposalCoins))
+				require.True(t, res.IsOK())
+			}
+
+			for i, option := range tc.votes {
+				if option == OptionEmpty {
+					continue
+				}
+				require.NoError(t, input.keeper.AddVote(ctx, proposalID, input.addrs[i], option))
+			}
+
+			proposal, ok := input.keeper.GetProposal(ctx, proposalID)
+			require.True(t, ok)
+			require.Equal(t, StatusVotingPeriod, proposal.Status)
+
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = proposal.VotingEndTime
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			EndBlocker(ctx, input.keeper)
+
+			proposal, ok = input.keeper.GetProposal(ctx, proposalID)
+			require.True(t, ok)
+			require.Equal(t, StatusRejected, proposal.Status)
+			require.True(t, proposal.Expedited)
+			require.Empty(t, input.keeper.GetDeposits(ctx, proposalID))
+
+			activeQueue := input.keeper.ActiveProposalQueueIterator(ctx, newHeader.Time.Add(input.keeper.GetVotingParams(ctx).VotingPeriod))
+			require.False(t, activeQueue.Valid())
+			activeQueue.Close()
+		})
+	}
+}
@@ -12,17 +12,21 @@ import (
 const (
 	// Default period for deposits & voting
 	DefaultPeriod time.Duration = 86400 * 2 * time.Second // 2 days
+
+	// Default voting period of expedited proposals
+	DefaultExpeditedPeriod time.Duration = 86400 * time.Second // 1 day
 )
 
 // GenesisState - all staking state that must be provided at genesis
 type GenesisState struct {
-	StartingProposalID uint64        `json:"starting_proposal_id"`
-	Deposits           Deposits      `json:"deposits"`
-	Votes              Votes         `json:"votes"`
-	Proposals          []Proposal    `json:"proposals"`
-	DepositParams      DepositParams `json:"deposit_params"`
-	VotingParams       VotingParams  `json:"voting_params"`
-	TallyParams        TallyParams   `json:"tally_params"`
+	StartingProposalID uint64          `json:"starting_proposal_id"`
+	Deposits           Deposits        `json:"deposits"`
+	Votes              Votes           `json:"votes"`
+	VoteDelegations    VoteDelegations `json:"vote_delegations"`
+	Proposals          []Proposal      `json:"proposals"`
+	DepositParams      DepositParams   `json:"deposit_params"`
+	VotingParams       VotingParams    `json:"voting_params"`
+	TallyParams        TallyParams     `json:"tally_params"`
 }
 
 // NewGenesisState creates a new genesis state for the governance module
@@ -38,19 +42,24 @@ func NewGenesisState(startingProposalID uint64, dp DepositParams, vp VotingParam
 // get raw genesis raw message for testing
 func DefaultGenesisState() GenesisState {
 	minDepositTokens := sdk.TokensFromConsensusPower(10)
+	expeditedMinDepositTokens := sdk.TokensFromConsensusPower(50)
 	return GenesisState{
 		StartingProposalID: 1,
 		DepositParams: DepositParams{
-			MinDeposit:       sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, minDepositTokens)},
-			MaxDepositPeriod: DefaultPeriod,
+			MinDeposit:          sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, minDepositTokens)},
+			MaxDepositPeriod:    DefaultPeriod,
+			ExpeditedMinDeposit: sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, expeditedMinDepositTokens)},
+			ProposalCancelRatio: sdk.NewDecWithPrec(5, 1),
 		},
 		VotingParams: VotingParams{
-			VotingPeriod: DefaultPeriod,
+			VotingPeriod:          DefaultPeriod,
+			ExpeditedVotingPeriod: DefaultExpeditedPeriod,
 		},
 		TallyParams: TallyParams{
-			Quorum:    sdk.NewDecWithPrec(334, 3),
-			Threshold: sdk.NewDecWithPrec(5, 1),
-			Veto:      sdk.NewDecWithPrec(334, 3),
+			Quorum:             sdk.NewDecWithPrec(334, 3),
+			Threshold:          sdk.NewDecWithPrec(5, 1),
+			Veto:               sdk.NewDecWithPrec(334, 3),
+			ExpeditedThreshold: sdk.NewDecWithPrec(667, 3),
 		},
 	}
 }
@@ -70,21 +79,22 @@ func (data GenesisState) IsEmpty() bool {
 
 // ValidateGenesis checks if parameters are within valid ranges
 func ValidateGenesis(data GenesisState) error {
-	threshold := data.TallyParams.Threshold
-	if threshold.IsNegative() || threshold.GT(sdk.OneDec()) {
-		return fmt.Errorf("Governance vote threshold should be positive and less or equal to one, is %s",
-			threshold.String())
+	if err := data.TallyParams.Validate(); err != nil {
+		return err
 	}
 
-	veto := data.TallyParams.Veto
-	if veto.IsNegative() || veto.GT(sdk.OneDec()) {
-		return fmt.Errorf("Governance vote veto threshold should be positive and less or equal to one, is %s",
-			veto.String())
+	if err := data.DepositParams.Validate(); err != nil {
+		return err
 	}
 
-	if !data.DepositParams.MinDeposit.IsValid() {
-		return fmt.Errorf("Governance deposit amount must be a valid sdk.Coins amount, is %s",
-			data.DepositParams.MinDeposit.String())
+	if err := data.VotingParams.Validate(); err != nil {
+		return err
+	}
+
+	for _, voteDelegation := range data.VoteDelegations {
+		if err := voteDelegation.Validate(); err != nil {
+			return fmt.Errorf("Governance vote delegation of %s is invalid: %s", voteDelegation.Delegator, err.Error())
+		}
 	}
 
 	return nil
@@ -110,8 +120,14 @@ func InitGenesis(ctx sdk.Context, k Keeper, supplyKeeper SupplyKeeper, data Gene
 		totalDeposits = totalDeposits.Add(deposit.Amount)
 	}
 
+	votedProposals := make(map[uint64]bool)
 	for _, vote := range data.Votes {
 		k.setVote(ctx, vote.ProposalID, vote.Voter, vote)
+		votedProposals[vote.ProposalID] = true
+	}
+
+	for _, voteDelegation := range data.VoteDelegations {
+		k.setVoteDelegation(ctx, voteDelegation)
 	}
 
 	for _, proposal := range data.Proposals {
@@ -120,10 +136,22 @@ func InitGenesis(ctx sdk.Context, k Keeper, supplyKeeper SupplyKeeper, data Gene
 			k.InsertInactiveProposalQueue(ctx, proposal.ProposalID, proposal.DepositEndTime)
 		case StatusVotingPeriod:
 			k.InsertActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+		default:
+			// the votes of ended proposals that weren't pruned yet
+			if votedProposals[proposal.ProposalID] {
+				k.insertVotePruningQueue(ctx, proposal.ProposalID)
+			}
 		}
 		k.SetProposal(ctx, proposal)
 	}
 
+	// the vote shares are derived from the votes and the staking delegations
+	for _, proposal := range data.Proposals {
+		if proposal.Status == StatusVotingPeriod {
+			k.initValidatorVoteShares(ctx, proposal)
+		}
+	}
+
 	// add coins if not provided on genesis
 	if moduleAcc.GetCoins().IsZero() {
 		if err := moduleAcc.SetCoins(totalDeposits); err != nil {
@@ -156,6 +184,7 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 		StartingProposalID: startingProposalID,
 		Deposits:           proposalsDeposits,
 		Votes:              proposalsVotes,
+		VoteDelegations:    k.GetAllVoteDelegations(ctx),
 		Proposals:          proposals,
 		DepositParams:      depositParams,
 		VotingParams:       votingParams,
@@ -129,3 +129,33 @@ func TestImportExportQueues(t *testing.T) {
 	require.True(t, ok)
 	require.True(t, proposal2.Status == StatusRejected)
 }
+
+func TestImportExportVoteDelegations(t *testing.T) {
+	input := getMockApp(t, 3, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	require.Nil(t, input.keeper.DelegateVote(ctx, input.addrs[0], input.addrs[1], ""))
+	require.Nil(t, input.keeper.DelegateVote(ctx, input.addrs[0], input.addrs[2], ProposalTypeText))
+
+	genAccs := input.mApp.AccountKeeper.GetAllAccounts(ctx)
+
+	genState := ExportGenesis(ctx, input.keeper)
+	require.Len(t, genState.VoteDelegations, 2)
+	require.NoError(t, ValidateGenesis(genState))
+
+	input2 := getMockApp(t, 3, genState, genAccs)
+
+	header = abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input2.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx2 := input2.mApp.BaseApp.NewContext(false, abci.Header{})
+	require.Equal(t, genState.VoteDelegations, input2.keeper.GetAllVoteDelegations(ctx2))
+
+	// self delegations are rejected
+	genState.VoteDelegations = append(genState.VoteDelegations, NewVoteDelegation(input.addrs[1], input.addrs[1], ""))
+	require.Error(t, ValidateGenesis(genState))
+}
@@ -10,12 +10,18 @@ import (
 // RegisterInvariants registers all governance invariants
 func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
 	ir.RegisterRoute(types.ModuleName, "module-account", ModuleAccountInvariant(keeper))
+	ir.RegisterRoute(types.ModuleName, "vote-shares", VoteSharesInvariant(keeper))
 }
 
 // AllInvariants runs all invariants of the governance module
 func AllInvariants(keeper Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) error {
-		return ModuleAccountInvariant(keeper)(ctx)
+		err := ModuleAccountInvariant(keeper)(ctx)
+		if err != nil {
+			return err
+		}
+
+		return VoteSharesInvariant(keeper)(ctx)
 	}
 }
 
@@ -40,3 +46,30 @@ func ModuleAccountInvariant(keeper Keeper) sdk.Invariant {
 		return nil
 	}
 }
+
+// VoteSharesInvariant checks that the vote shares accumulated for the proposals
+// in their voting period match the shares computed from their votes
+func VoteSharesInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		for _, proposal := range keeper.getVotingProposals(ctx) {
+			expected := keeper.computeValidatorVoteShares(ctx, proposal)
+
+			stored := keeper.GetAllValidatorVoteShares(ctx, proposal.ProposalID)
+			if len(stored) != len(expected) {
+				return fmt.Errorf("vote shares invariance:\n"+
+					"\tproposal %d has vote shares for %d validators, expected %d",
+					proposal.ProposalID, len(stored), len(expected))
+			}
+
+			for _, voteShares := range stored {
+				if !voteShares.Equal(expected[voteShares.Validator.String()]) {
+					return fmt.Errorf("vote shares invariance:\n"+
+						"\tstored: %s\n"+
+						"\texpected: %s", voteShares, expected[voteShares.Validator.String()])
+				}
+			}
+		}
+
+		return nil
+	}
+}
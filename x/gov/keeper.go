@@ -38,16 +38,24 @@ type Keeper struct {
 
 	// Proposal router
 	router Router
+
+	// Msg router used to execute the messages of passed proposals
+	msgRouter sdk.Router
+
+	// Strategy used to tally the votes of proposals
+	tallyStrategy TallyStrategy
 }
 
 // NewKeeper returns a governance keeper. It handles:
 // - submitting governance proposals
 // - depositing funds into proposals, and activating upon sufficient funds being deposited
 // - users voting on proposals, with weight proportional to stake in the system
-// - and tallying the result of the vote.
+// - tallying the result of the vote with the given tally strategy
+// - and executing the messages of passed proposals through the given msg router.
 func NewKeeper(
 	cdc *codec.Codec, key sdk.StoreKey, paramsKeeper params.Keeper, paramSpace params.Subspace,
-	supplyKeeper SupplyKeeper, sk StakingKeeper, codespace sdk.CodespaceType, rtr Router,
+	supplyKeeper SupplyKeeper, sk StakingKeeper, codespace sdk.CodespaceType, rtr Router, msgRouter sdk.Router,
+	tallyStrategy TallyStrategy,
 ) Keeper {
 
 	// ensure governance module account is set
@@ -61,14 +69,16 @@ func NewKeeper(
 	rtr.Seal()
 
 	return Keeper{
-		storeKey:     key,
-		paramsKeeper: paramsKeeper,
-		paramSpace:   paramSpace.WithKeyTable(ParamKeyTable()),
-		supplyKeeper: supplyKeeper,
-		sk:           sk,
-		cdc:          cdc,
-		codespace:    codespace,
-		router:       rtr,
+		storeKey:      key,
+		paramsKeeper:  paramsKeeper,
+		paramSpace:    paramSpace.WithKeyTable(ParamKeyTable()),
+		supplyKeeper:  supplyKeeper,
+		sk:            sk,
+		cdc:           cdc,
+		codespace:     codespace,
+		router:        rtr,
+		msgRouter:     msgRouter,
+		tallyStrategy: tallyStrategy,
 	}
 }
 
@@ -106,15 +116,21 @@ func (keeper Keeper) GetTallyParams(ctx sdk.Context) TallyParams {
 }
 
 func (keeper Keeper) setDepositParams(ctx sdk.Context, depositParams DepositParams) {
-	keeper.paramSpace.Set(ctx, ParamStoreKeyDepositParams, &depositParams)
+	if err := keeper.paramSpace.Set(ctx, ParamStoreKeyDepositParams, &depositParams); err != nil {
+		panic(err)
+	}
 }
 
 func (keeper Keeper) setVotingParams(ctx sdk.Context, votingParams VotingParams) {
-	keeper.paramSpace.Set(ctx, ParamStoreKeyVotingParams, &votingParams)
+	if err := keeper.paramSpace.Set(ctx, ParamStoreKeyVotingParams, &votingParams); err != nil {
+		panic(err)
+	}
 }
 
 func (keeper Keeper) setTallyParams(ctx sdk.Context, tallyParams TallyParams) {
-	keeper.paramSpace.Set(ctx, ParamStoreKeyTallyParams, &tallyParams)
+	if err := keeper.paramSpace.Set(ctx, ParamStoreKeyTallyParams, &tallyParams); err != nil {
+		panic(err)
+	}
 }
 
 // ProposalQueues
@@ -263,6 +279,95 @@ func (keeper Keeper) IterateVotes(ctx sdk.Context, proposalID uint64, cb func(vo
 	}
 }
 
+// IterateAllVoteDelegations iterates over all the vote delegations and performs a callback function
+func (keeper Keeper) IterateAllVoteDelegations(ctx sdk.Context, cb func(voteDelegation types.VoteDelegation) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VoteDelegationsKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var voteDelegation types.VoteDelegation
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &voteDelegation)
+
+		if cb(voteDelegation) {
+			break
+		}
+	}
+}
+
+// IterateVoteDelegations iterates over the vote delegations of an address and performs a callback function
+func (keeper Keeper) IterateVoteDelegations(ctx sdk.Context, delegator sdk.AccAddress, cb func(voteDelegation types.VoteDelegation) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VoteDelegationsKey(delegator))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var voteDelegation types.VoteDelegation
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &voteDelegation)
+
+		if cb(voteDelegation) {
+			break
+		}
+	}
+}
+
+// IterateVoteDelegators iterates over the vote delegations made to an address
+// and performs a callback function
+func (keeper Keeper) IterateVoteDelegators(ctx sdk.Context, delegate sdk.AccAddress, cb func(voteDelegation types.VoteDelegation) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VoteDelegatorsKey(delegate))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		delegator, proposalType := types.SplitKeyVoteDelegator(iterator.Key())
+		voteDelegation, found := keeper.GetVoteDelegation(ctx, delegator, proposalType)
+		if !found {
+			panic(fmt.Sprintf("vote delegation of %s on %q proposals does not exist", delegator, proposalType))
+		}
+
+		if cb(voteDelegation) {
+			break
+		}
+	}
+}
+
+// IterateValidatorVoteShares iterates over the validator vote shares of a
+// proposal and performs a callback function
+func (keeper Keeper) IterateValidatorVoteShares(ctx sdk.Context, proposalID uint64, cb func(voteShares types.ValidatorVoteShares) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorVoteSharesKey(proposalID))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var voteShares types.ValidatorVoteShares
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &voteShares)
+
+		if cb(voteShares) {
+			break
+		}
+	}
+}
+
+// IterateVotingProposals iterates over the proposals in their voting period
+// and performs a callback function
+func (keeper Keeper) IterateVotingProposals(ctx sdk.Context, cb func(proposal types.Proposal) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ActiveProposalQueuePrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		proposalID, _ := types.SplitActiveProposalQueueKey(iterator.Key())
+		proposal, found := keeper.GetProposal(ctx, proposalID)
+		if !found {
+			panic(fmt.Sprintf("proposal %d does not exist", proposalID))
+		}
+
+		if cb(proposal) {
+			break
+		}
+	}
+}
+
 // ActiveProposalQueueIterator returns an sdk.Iterator for all the proposals in the Active Queue that expire by endTime
 func (keeper Keeper) ActiveProposalQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
 	store := ctx.KVStore(keeper.storeKey)
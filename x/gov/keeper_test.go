@@ -224,6 +224,30 @@ func TestVotes(t *testing.T) {
 	require.Equal(t, proposalID, vote.ProposalID)
 	require.Equal(t, OptionNoWithVeto, vote.Option)
 
+	// Test weighted vote
+	options := WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(60, 2)),
+		NewWeightedVoteOption(OptionAbstain, sdk.NewDecWithPrec(40, 2)),
+	}
+	err = input.keeper.AddWeightedVote(ctx, proposalID, input.addrs[1], options)
+	require.Nil(t, err)
+	vote, found = input.keeper.GetVote(ctx, proposalID, input.addrs[1])
+	require.True(t, found)
+	require.Equal(t, OptionEmpty, vote.Option)
+	require.True(t, options.Equals(vote.GetOptions()))
+
+	// Test invalid weighted vote
+	invalid := WeightedVoteOptions{NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(50, 2))}
+	err = input.keeper.AddWeightedVote(ctx, proposalID, input.addrs[1], invalid)
+	require.NotNil(t, err)
+
+	// Test plain vote replaces weighted vote
+	input.keeper.AddVote(ctx, proposalID, input.addrs[1], OptionNoWithVeto)
+	vote, found = input.keeper.GetVote(ctx, proposalID, input.addrs[1])
+	require.True(t, found)
+	require.Equal(t, OptionNoWithVeto, vote.Option)
+	require.True(t, NewNonSplitVoteOption(OptionNoWithVeto).Equals(vote.GetOptions()))
+
 	// Test vote iterator
 	votesIterator := input.keeper.GetVotesIterator(ctx, proposalID)
 	require.True(t, votesIterator.Valid())
@@ -244,6 +268,40 @@ func TestVotes(t *testing.T) {
 	votesIterator.Close()
 }
 
+func TestPruneVotes(t *testing.T) {
+	input := getMockApp(t, 5, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	// proposal 1 has 3 votes and proposal 2 has 2 votes
+	for i, addr := range input.addrs {
+		proposalID := uint64(1 + i/3)
+		input.keeper.setVote(ctx, proposalID, addr, NewVote(proposalID, addr, OptionYes))
+	}
+	input.keeper.insertVotePruningQueue(ctx, 1)
+	input.keeper.insertVotePruningQueue(ctx, 2)
+
+	// each batch deletes at most 2 votes, moving on to the next proposal
+	// once all the votes of a proposal are deleted
+	input.keeper.pruneVotes(ctx, 2)
+	require.Len(t, input.keeper.GetVotes(ctx, 1), 1)
+	require.Len(t, input.keeper.GetVotes(ctx, 2), 2)
+
+	input.keeper.pruneVotes(ctx, 2)
+	require.Empty(t, input.keeper.GetVotes(ctx, 1))
+	require.Len(t, input.keeper.GetVotes(ctx, 2), 1)
+
+	input.keeper.pruneVotes(ctx, 2)
+	require.Empty(t, input.keeper.GetVotes(ctx, 2))
+
+	queueIterator := sdk.KVStorePrefixIterator(ctx.KVStore(input.keeper.storeKey), VotePruningQueuePrefix)
+	require.False(t, queueIterator.Valid())
+	queueIterator.Close()
+}
+
 func TestProposalQueues(t *testing.T) {
 	input := getMockApp(t, 0, GenesisState{}, nil)
 
@@ -354,3 +412,100 @@ func TestSubmitProposal(t *testing.T) {
 		require.Equal(t, tc.expectedErr, err, "unexpected type of error: %s", err)
 	}
 }
+
+func TestCancelProposalDepositPeriod(t *testing.T) {
+	input := getMockApp(t, 2, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	proposal, err := input.keeper.submitProposal(ctx, testProposal(), input.addrs[0], false)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	require.Equal(t, input.addrs[0], proposal.Proposer)
+
+	fourStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(4)))
+	twoStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(2)))
+
+	addr1Initial := input.mApp.AccountKeeper.GetAccount(ctx, input.addrs[1]).GetCoins()
+
+	err, votingStarted := input.keeper.AddDeposit(ctx, proposalID, input.addrs[1], fourStake)
+	require.Nil(t, err)
+	require.False(t, votingStarted)
+
+	// only the proposer can cancel the proposal
+	err = input.keeper.CancelProposal(ctx, proposalID, input.addrs[1])
+	require.Error(t, err)
+	require.Equal(t, CodeInvalidProposer, err.Code())
+
+	err = input.keeper.CancelProposal(ctx, proposalID+1, input.addrs[0])
+	require.Error(t, err)
+	require.Equal(t, CodeUnknownProposal, err.Code())
+
+	require.Nil(t, input.keeper.CancelProposal(ctx, proposalID, input.addrs[0]))
+
+	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusCancelled, proposal.Status)
+
+	inactiveIterator := input.keeper.InactiveProposalQueueIterator(ctx, proposal.DepositEndTime)
+	require.False(t, inactiveIterator.Valid())
+	inactiveIterator.Close()
+
+	// half of the deposit is burned with the default cancel ratio
+	_, found := input.keeper.GetDeposit(ctx, proposalID, input.addrs[1])
+	require.False(t, found)
+	require.Equal(t, addr1Initial.Sub(twoStake), input.mApp.AccountKeeper.GetAccount(ctx, input.addrs[1]).GetCoins())
+	require.True(t, input.keeper.GetGovernanceAccount(ctx).GetCoins().Empty())
+
+	// a cancelled proposal cannot be cancelled again
+	err = input.keeper.CancelProposal(ctx, proposalID, input.addrs[0])
+	require.Error(t, err)
+	require.Equal(t, CodeAlreadyFinishedProposal, err.Code())
+
+	proposals := input.keeper.GetProposalsFiltered(ctx, nil, nil, StatusCancelled, 0)
+	require.Len(t, proposals, 1)
+	require.Equal(t, proposalID, proposals[0].ProposalID)
+}
+
+func TestCancelProposalVotingPeriod(t *testing.T) {
+	input := getMockApp(t, 2, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	proposal, err := input.keeper.submitProposal(ctx, testProposal(), input.addrs[0], false)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+
+	depositParams := input.keeper.GetDepositParams(ctx)
+	depositParams.ProposalCancelRatio = sdk.ZeroDec()
+	input.keeper.setDepositParams(ctx, depositParams)
+
+	addr0Initial := input.mApp.AccountKeeper.GetAccount(ctx, input.addrs[0]).GetCoins()
+
+	err, votingStarted := input.keeper.AddDeposit(ctx, proposalID, input.addrs[0], depositParams.MinDeposit)
+	require.Nil(t, err)
+	require.True(t, votingStarted)
+	require.Nil(t, input.keeper.AddVote(ctx, proposalID, input.addrs[1], OptionYes))
+
+	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Nil(t, input.keeper.CancelProposal(ctx, proposalID, input.addrs[0]))
+
+	activeIterator := input.keeper.ActiveProposalQueueIterator(ctx, proposal.VotingEndTime)
+	require.False(t, activeIterator.Valid())
+	activeIterator.Close()
+
+	require.Len(t, input.keeper.GetVotes(ctx, proposalID), 1)
+	EndBlocker(ctx, input.keeper)
+	require.Empty(t, input.keeper.GetVotes(ctx, proposalID))
+	require.Equal(t, addr0Initial, input.mApp.AccountKeeper.GetAccount(ctx, input.addrs[0]).GetCoins())
+
+	// votes are rejected once the proposal is cancelled
+	require.NotNil(t, input.keeper.AddVote(ctx, proposalID, input.addrs[1], OptionNo))
+}
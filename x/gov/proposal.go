@@ -9,17 +9,38 @@ import (
 
 // SubmitProposal create new proposal given a content
 func (keeper Keeper) SubmitProposal(ctx sdk.Context, content Content) (Proposal, sdk.Error) {
-	if !keeper.router.HasRoute(content.ProposalRoute()) {
-		return Proposal{}, ErrNoProposalHandlerExists(keeper.codespace, content)
-	}
+	return keeper.submitProposal(ctx, content, nil, false)
+}
+
+// SubmitExpeditedProposal creates a new expedited proposal given a content.
+// Expedited proposals use the expedited min deposit, voting period and
+// threshold and are converted to regular proposals if they do not pass.
+func (keeper Keeper) SubmitExpeditedProposal(ctx sdk.Context, content Content) (Proposal, sdk.Error) {
+	return keeper.submitProposal(ctx, content, nil, true)
+}
+
+// submitProposal creates a new proposal. Only proposals with a proposer can be
+// cancelled.
+func (keeper Keeper) submitProposal(ctx sdk.Context, content Content, proposer sdk.AccAddress, expedited bool) (Proposal, sdk.Error) {
+	if msgsProposal, ok := content.(MsgsProposal); ok {
+		// Messages are only executed once the proposal passes as their outcome
+		// may depend on the state at that time.
+		if err := keeper.validateProposalMsgs(ctx, msgsProposal.Msgs); err != nil {
+			return Proposal{}, err
+		}
+	} else {
+		if !keeper.router.HasRoute(content.ProposalRoute()) {
+			return Proposal{}, ErrNoProposalHandlerExists(keeper.codespace, content)
+		}
 
-	// Execute the proposal content in a cache-wrapped context to validate the
-	// actual parameter changes before the proposal proceeds through the
-	// governance process. State is not persisted.
-	cacheCtx, _ := ctx.CacheContext()
-	handler := keeper.router.GetRoute(content.ProposalRoute())
-	if err := handler(cacheCtx, content); err != nil {
-		return Proposal{}, ErrInvalidProposalContent(keeper.codespace, err.Result().Log)
+		// Execute the proposal content in a cache-wrapped context to validate the
+		// actual parameter changes before the proposal proceeds through the
+		// governance process. State is not persisted.
+		cacheCtx, _ := ctx.CacheContext()
+		handler := keeper.router.GetRoute(content.ProposalRoute())
+		if err := handler(cacheCtx, content); err != nil {
+			return Proposal{}, ErrInvalidProposalContent(keeper.codespace, err.Result().Log)
+		}
 	}
 
 	proposalID, err := keeper.GetProposalID(ctx)
@@ -31,6 +52,8 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content Content) (Proposal,
 	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
 
 	proposal := NewProposal(content, proposalID, submitTime, submitTime.Add(depositPeriod))
+	proposal.Expedited = expedited
+	proposal.Proposer = proposer
 
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
@@ -46,6 +69,66 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content Content) (Proposal,
 	return proposal, nil
 }
 
+// validateProposalMsgs checks that every message of a proposal can be routed
+// and is signed by the governance module account only.
+func (keeper Keeper) validateProposalMsgs(ctx sdk.Context, msgs []sdk.Msg) sdk.Error {
+	govAddr := keeper.supplyKeeper.GetModuleAddress(types.ModuleName)
+
+	for i, msg := range msgs {
+		if keeper.msgRouter.Route(msg.Route()) == nil {
+			return ErrInvalidProposalMsg(keeper.codespace, i, fmt.Sprintf("unrecognized msg route %s", msg.Route()))
+		}
+
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(govAddr) {
+			return ErrInvalidProposalMsg(keeper.codespace, i, fmt.Sprintf("signer must be the governance module account %s", govAddr))
+		}
+	}
+
+	return nil
+}
+
+// executeProposal executes the content of a passed proposal. The messages of
+// a MsgsProposal are run in order through the msg router, any other content is
+// handled by its governance proposal handler. The caller is responsible for
+// only committing the state changes if no error is returned.
+//
+// NOTE: The governance module account only holds the deposits of proposals, so
+// the messages must not change its coins.
+func (keeper Keeper) executeProposal(ctx sdk.Context, content Content) sdk.Error {
+	msgsProposal, ok := content.(MsgsProposal)
+	if !ok {
+		handler := keeper.router.GetRoute(content.ProposalRoute())
+		return handler(ctx, content)
+	}
+
+	deposits := keeper.GetGovernanceAccount(ctx).GetCoins()
+
+	for i, msg := range msgsProposal.Msgs {
+		handler := keeper.msgRouter.Route(msg.Route())
+		if handler == nil {
+			return ErrInvalidProposalMsg(keeper.codespace, i, fmt.Sprintf("unrecognized msg route %s", msg.Route()))
+		}
+
+		res := handler(ctx, msg)
+		if !res.IsOK() {
+			return ErrInvalidProposalMsg(keeper.codespace, i, res.Log)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type())),
+		)
+	}
+
+	coins := keeper.GetGovernanceAccount(ctx).GetCoins()
+	if !coins.IsAllGTE(deposits) || !deposits.IsAllGTE(coins) {
+		return ErrInvalidProposalContent(keeper.codespace,
+			fmt.Sprintf("messages must not change the deposits held by the governance module account: %s != %s", coins, deposits))
+	}
+
+	return nil
+}
+
 // GetProposal get Proposal from store by ProposalID
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (proposal Proposal, ok bool) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -150,9 +233,52 @@ func (keeper Keeper) setProposalID(ctx sdk.Context, proposalID uint64) {
 	store.Set(ProposalIDKey, bz)
 }
 
+// CancelProposal cancels a proposal in its deposit or voting period on behalf
+// of its proposer. The proposal is removed from the proposal queues, its votes
+// are deleted and its deposits are refunded minus the proposal cancel ratio,
+// which is burned.
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer sdk.AccAddress) sdk.Error {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return ErrUnknownProposal(keeper.codespace, proposalID)
+	}
+
+	if proposal.Proposer.Empty() || !proposal.Proposer.Equals(proposer) {
+		return ErrInvalidProposer(keeper.codespace, proposalID, proposer)
+	}
+
+	switch proposal.Status {
+	case StatusDepositPeriod:
+		keeper.RemoveFromInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
+	case StatusVotingPeriod:
+		keeper.RemoveFromActiveProposalQueue(ctx, proposalID, proposal.VotingEndTime)
+	default:
+		return ErrAlreadyFinishedProposal(keeper.codespace, proposalID)
+	}
+
+	keeper.insertVotePruningQueue(ctx, proposalID)
+	keeper.deleteValidatorVoteShares(ctx, proposalID)
+	keeper.refundAndBurnDeposits(ctx, proposalID, keeper.GetDepositParams(ctx).ProposalCancelRatio)
+
+	proposal.Status = StatusCancelled
+	keeper.SetProposal(ctx, proposal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+		),
+	)
+
+	return nil
+}
+
 func (keeper Keeper) activateVotingPeriod(ctx sdk.Context, proposal Proposal) {
 	proposal.VotingStartTime = ctx.BlockHeader().Time
 	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
+	if proposal.Expedited {
+		votingPeriod = keeper.GetVotingParams(ctx).ExpeditedVotingPeriod
+	}
 	proposal.VotingEndTime = proposal.VotingStartTime.Add(votingPeriod)
 	proposal.Status = StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
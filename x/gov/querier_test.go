@@ -169,6 +169,22 @@ func getQueriedTally(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sd
 	return tally
 }
 
+func getQueriedVoteDelegations(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier, queryPath string, address sdk.AccAddress) []VoteDelegation {
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QuerierRoute, queryPath}, "/"),
+		Data: cdc.MustMarshalJSON(NewQueryVoteDelegationsParams(address)),
+	}
+
+	bz, err := querier(ctx, []string{queryPath}, query)
+	require.Nil(t, err)
+	require.NotNil(t, bz)
+
+	var voteDelegations []VoteDelegation
+	err2 := cdc.UnmarshalJSON(bz, &voteDelegations)
+	require.Nil(t, err2)
+	return voteDelegations
+}
+
 func TestQueryParams(t *testing.T) {
 	cdc := codec.New()
 	input := getMockApp(t, 1000, GenesisState{}, nil)
@@ -300,3 +316,35 @@ func TestQueries(t *testing.T) {
 	proposals = getQueriedProposals(t, ctx, cdc, querier, input.addrs[0], input.addrs[0], StatusNil, 0)
 	require.Equal(t, proposalID2, (proposals[0]).ProposalID)
 }
+
+func TestQueryVoteDelegations(t *testing.T) {
+	cdc := codec.New()
+	input := getMockApp(t, 3, GenesisState{}, nil)
+	querier := NewQuerier(input.keeper)
+	handler := NewHandler(input.keeper)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.NewContext(false, abci.Header{})
+
+	res := handler(ctx, NewMsgDelegateVote(input.addrs[0], input.addrs[2], ""))
+	require.True(t, res.IsOK())
+	res = handler(ctx, NewMsgDelegateVote(input.addrs[1], input.addrs[2], ProposalTypeText))
+	require.True(t, res.IsOK())
+
+	voteDelegations := getQueriedVoteDelegations(t, ctx, cdc, querier, QueryVoteDelegations, input.addrs[0])
+	require.Equal(t, []VoteDelegation{NewVoteDelegation(input.addrs[0], input.addrs[2], "")}, voteDelegations)
+
+	voteDelegations = getQueriedVoteDelegations(t, ctx, cdc, querier, QueryVoteDelegators, input.addrs[2])
+	require.Len(t, voteDelegations, 2)
+
+	res = handler(ctx, NewMsgUndelegateVote(input.addrs[1], ProposalTypeText))
+	require.True(t, res.IsOK())
+	res = handler(ctx, NewMsgUndelegateVote(input.addrs[1], ProposalTypeText))
+	require.False(t, res.IsOK())
+
+	voteDelegations = getQueriedVoteDelegations(t, ctx, cdc, querier, QueryVoteDelegators, input.addrs[2])
+	require.Len(t, voteDelegations, 1)
+	require.Empty(t, getQueriedVoteDelegations(t, ctx, cdc, querier, QueryVoteDelegations, input.addrs[1]))
+}
@@ -114,8 +114,27 @@ func SimulateTextProposalContent(r *rand.Rand, _ *baseapp.BaseApp, _ sdk.Context
 	)
 }
 
+// SimulateMsgsProposalContent returns random proposal content executing a
+// weighted vote of the governance account on an existing proposal.
+func SimulateMsgsProposalContent(k gov.Keeper) ContentSimulator {
+	return func(r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, _ []simulation.Account) gov.Content {
+		proposalID, _ := k.GetProposalID(ctx)
+		if proposalID > 1 {
+			proposalID = uint64(r.Int63n(int64(proposalID-1))) + 1
+		}
+
+		govAddr := k.GetGovernanceAccount(ctx).GetAddress()
+		return gov.NewMsgsProposal(
+			simulation.RandStringOfLength(r, 140),
+			simulation.RandStringOfLength(r, 5000),
+			[]sdk.Msg{gov.NewMsgVoteWeighted(govAddr, proposalID, randomWeightedVotingOptions(r))},
+		)
+	}
+}
+
 func simulationCreateMsgSubmitProposal(r *rand.Rand, c gov.Content, s simulation.Account) (msg gov.MsgSubmitProposal, err error) {
 	msg = gov.NewMsgSubmitProposal(c, randomDeposit(r), s.Address)
+	msg.Expedited = r.Intn(5) == 0
 	if msg.ValidateBasic() != nil {
 		err = fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
 	}
@@ -188,6 +207,138 @@ func operationSimulateMsgVote(k gov.Keeper, acc simulation.Account, proposalID u
 	}
 }
 
+// SimulateMsgVoteWeighted
+func SimulateMsgVoteWeighted(k gov.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		// only proposals in their voting period accept votes
+		proposals := k.GetProposalsFiltered(ctx, nil, nil, gov.StatusVotingPeriod, 0)
+		if len(proposals) == 0 {
+			return simulation.NoOpMsg(), nil, nil
+		}
+
+		acc := simulation.RandomAcc(r, accs)
+		proposalID := proposals[r.Intn(len(proposals))].ProposalID
+		options := randomWeightedVotingOptions(r)
+
+		msg := gov.NewMsgVoteWeighted(acc.Address, proposalID, options)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := gov.NewHandler(k)(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgCancelProposal
+func SimulateMsgCancelProposal(k gov.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		// only proposals in their deposit or voting period can be cancelled
+		proposals := append(
+			k.GetProposalsFiltered(ctx, nil, nil, gov.StatusDepositPeriod, 0),
+			k.GetProposalsFiltered(ctx, nil, nil, gov.StatusVotingPeriod, 0)...,
+		)
+		if len(proposals) == 0 {
+			return simulation.NoOpMsg(), nil, nil
+		}
+
+		proposal := proposals[r.Intn(len(proposals))]
+		if proposal.Proposer.Empty() {
+			return simulation.NoOpMsg(), nil, nil
+		}
+
+		msg := gov.NewMsgCancelProposal(proposal.Proposer, proposal.ProposalID)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := gov.NewHandler(k)(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgDelegateVote
+func SimulateMsgDelegateVote(k gov.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		delegator := simulation.RandomAcc(r, accs)
+		delegate := simulation.RandomAcc(r, accs)
+		if delegator.Equals(delegate) {
+			return simulation.NoOpMsg(), nil, nil
+		}
+
+		msg := gov.NewMsgDelegateVote(delegator.Address, delegate.Address, randomVoteDelegationProposalType(r))
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := gov.NewHandler(k)(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgUndelegateVote
+func SimulateMsgUndelegateVote(k gov.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		voteDelegations := k.GetAllVoteDelegations(ctx)
+		if len(voteDelegations) == 0 {
+			return simulation.NoOpMsg(), nil, nil
+		}
+
+		voteDelegation := voteDelegations[r.Intn(len(voteDelegations))]
+		msg := gov.NewMsgUndelegateVote(voteDelegation.Delegator, voteDelegation.ProposalType)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := gov.NewHandler(k)(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// Pick a random proposal type for a vote delegation, all types being the most likely
+func randomVoteDelegationProposalType(r *rand.Rand) string {
+	switch r.Intn(4) {
+	case 0:
+		return gov.ProposalTypeText
+	case 1:
+		return gov.ProposalTypeMsgs
+	default:
+		return ""
+	}
+}
+
 // Pick a random deposit
 func randomDeposit(r *rand.Rand) sdk.Coins {
 	// TODO Choose based on account balance and min deposit
@@ -221,3 +372,24 @@ func randomVotingOption(r *rand.Rand) gov.VoteOption {
 	}
 	panic("should not happen")
 }
+
+// Pick random weighted voting options whose weights sum up to 1
+func randomWeightedVotingOptions(r *rand.Rand) gov.WeightedVoteOptions {
+	voteOptions := []gov.VoteOption{gov.OptionYes, gov.OptionAbstain, gov.OptionNo, gov.OptionNoWithVeto}
+
+	// split 100 percent between the options, the last one takes the remainder
+	var options gov.WeightedVoteOptions
+	remaining := int64(100)
+	for i, option := range voteOptions {
+		weight := remaining
+		if i < len(voteOptions)-1 {
+			weight = r.Int63n(remaining + 1)
+		}
+		if weight > 0 {
+			options = append(options, gov.NewWeightedVoteOption(option, sdk.NewDecWithPrec(weight, 2)))
+		}
+		remaining -= weight
+	}
+
+	return options
+}
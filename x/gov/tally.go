@@ -8,35 +8,119 @@ import (
 
 // validatorGovInfo used for tallying
 type validatorGovInfo struct {
-	Address             sdk.ValAddress // address of the validator operator
-	BondedTokens        sdk.Int        // Power of a Validator
-	DelegatorShares     sdk.Dec        // Total outstanding delegator shares
-	DelegatorDeductions sdk.Dec        // Delegator deductions from validator's delegators voting independently
-	Vote                VoteOption     // Vote of the validator
+	Address         sdk.ValAddress      // address of the validator operator
+	BondedTokens    sdk.Int             // Power of a Validator
+	DelegatorShares sdk.Dec             // Total outstanding delegator shares
+	VoteShares      ValidatorVoteShares // Shares of the validator's delegators voting independently
+	Vote            WeightedVoteOptions // Vote of the validator
 }
 
-func newValidatorGovInfo(address sdk.ValAddress, bondedTokens sdk.Int, delegatorShares,
-	delegatorDeductions sdk.Dec, vote VoteOption) validatorGovInfo {
+func newValidatorGovInfo(address sdk.ValAddress, bondedTokens sdk.Int, delegatorShares sdk.Dec,
+	voteShares ValidatorVoteShares, vote WeightedVoteOptions) validatorGovInfo {
 
 	return validatorGovInfo{
-		Address:             address,
-		BondedTokens:        bondedTokens,
-		DelegatorShares:     delegatorShares,
-		DelegatorDeductions: delegatorDeductions,
-		Vote:                vote,
+		Address:         address,
+		BondedTokens:    bondedTokens,
+		DelegatorShares: delegatorShares,
+		VoteShares:      voteShares,
+		Vote:            vote,
 	}
 }
 
-// TODO: Break into several smaller functions for clarity
+// TallyStrategy defines how the votes cast on a proposal are weighed and
+// whether the proposal passes once its voting period has ended.
+type TallyStrategy interface {
+	// Name returns the name recorded in the proposal's tally result
+	Name() string
+
+	// Tally computes the outcome of the proposal from its votes. It must not
+	// modify the store.
+	Tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, burnDeposits bool, tallyResults TallyResult)
+}
+
+// tally computes the outcome of a proposal with the keeper's tally strategy
+// and records the strategy in the results.
 func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, burnDeposits bool, tallyResults TallyResult) {
+	passes, burnDeposits, tallyResults = keeper.tallyStrategy.Tally(ctx, keeper, proposal)
+	tallyResults.Strategy = keeper.tallyStrategy.Name()
+	return passes, burnDeposits, tallyResults
+}
+
+// getTallyParams returns the tally params that apply to the proposal. Expedited
+// proposals must reach the expedited threshold to pass.
+func getTallyParams(ctx sdk.Context, keeper Keeper, proposal Proposal) TallyParams {
+	tallyParams := keeper.GetTallyParams(ctx)
+	if proposal.Expedited {
+		tallyParams.Threshold = tallyParams.ExpeditedThreshold
+	}
+	return tallyParams
+}
+
+// newTallyResultsMap returns a map holding zero voting power for every option
+func newTallyResultsMap() map[VoteOption]sdk.Dec {
 	results := make(map[VoteOption]sdk.Dec)
 	results[OptionYes] = sdk.ZeroDec()
 	results[OptionAbstain] = sdk.ZeroDec()
 	results[OptionNo] = sdk.ZeroDec()
 	results[OptionNoWithVeto] = sdk.ZeroDec()
+	return results
+}
+
+// tallyOutcome applies the tally params to the voting power cast per option.
+// participation is the fraction of the eligible voting power that voted and
+// is checked against the quorum.
+func tallyOutcome(tallyParams TallyParams, results map[VoteOption]sdk.Dec,
+	totalVotingPower, participation sdk.Dec) (passes bool, burnDeposits bool) {
+
+	// If there is not enough quorum of votes, the proposal fails
+	if participation.LT(tallyParams.Quorum) {
+		return false, true
+	}
+
+	// If no one votes (everyone abstains), proposal fails
+	if totalVotingPower.Sub(results[OptionAbstain]).Equal(sdk.ZeroDec()) {
+		return false, false
+	}
+
+	// If more than 1/3 of voters veto, proposal fails
+	if results[OptionNoWithVeto].Quo(totalVotingPower).GT(tallyParams.Veto) {
+		return false, true
+	}
+
+	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
+	if results[OptionYes].Quo(totalVotingPower.Sub(results[OptionAbstain])).GT(tallyParams.Threshold) {
+		return true, false
+	}
+
+	// If more than 1/2 of non-abstaining voters vote No, proposal fails
+	return false, false
+}
+
+// StakeWeightedTally weighs votes by bonded stake. Accounts that do not vote
+// cast the vote of their governance delegate, if any, and delegators left
+// without a vote inherit the vote of the validators they are bonded to.
+//
+// The delegator shares held by voters are accumulated per validator as votes
+// are cast and delegations change, so the cost of the tally only depends on
+// the number of bonded validators.
+type StakeWeightedTally struct{}
+
+var _ TallyStrategy = StakeWeightedTally{}
+
+// NewStakeWeightedTally returns the default tally strategy
+func NewStakeWeightedTally() TallyStrategy {
+	return StakeWeightedTally{}
+}
+
+// Name implements TallyStrategy
+func (StakeWeightedTally) Name() string { return "stake_weighted" }
 
+// Tally implements TallyStrategy
+func (StakeWeightedTally) Tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, burnDeposits bool, tallyResults TallyResult) {
+	results := newTallyResultsMap()
 	totalVotingPower := sdk.ZeroDec()
 	currValidators := make(map[string]validatorGovInfo)
+	var operators []sdk.ValAddress
 
 	// fetch all the bonded validators, insert them into currValidators
 	keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator exported.ValidatorI) (stop bool) {
@@ -44,88 +128,86 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, burn
 			validator.GetOperator(),
 			validator.GetBondedTokens(),
 			validator.GetDelegatorShares(),
-			sdk.ZeroDec(),
-			OptionEmpty,
+			NewValidatorVoteShares(proposal.ProposalID, validator.GetOperator()),
+			WeightedVoteOptions{},
 		)
+		operators = append(operators, validator.GetOperator())
 
 		return false
 	})
 
-	keeper.IterateVotes(ctx, proposal.ProposalID, func(vote types.Vote) bool {
-		// if validator, just record it in the map
-		// if delegator tally voting power
-		valAddrStr := sdk.ValAddress(vote.Voter).String()
-		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Option
-			currValidators[valAddrStr] = val
-		} else {
-			// iterate over all delegations from voter, deduct from any delegated-to validators
-			keeper.sk.IterateDelegations(ctx, vote.Voter, func(index int64, delegation exported.DelegationI) (stop bool) {
-				valAddrStr := delegation.GetValidatorAddr().String()
-
-				if val, ok := currValidators[valAddrStr]; ok {
-					val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
-					currValidators[valAddrStr] = val
-
-					delegatorShare := delegation.GetShares().Quo(val.DelegatorShares)
-					votingPower := delegatorShare.MulInt(val.BondedTokens)
-
-					results[vote.Option] = results[vote.Option].Add(votingPower)
-					totalVotingPower = totalVotingPower.Add(votingPower)
-				}
-
-				return false
-			})
+	// fetch the shares of the delegators that voted
+	keeper.IterateValidatorVoteShares(ctx, proposal.ProposalID, func(voteShares types.ValidatorVoteShares) bool {
+		if val, ok := currValidators[voteShares.Validator.String()]; ok {
+			val.VoteShares = voteShares
+			currValidators[voteShares.Validator.String()] = val
 		}
-
-		keeper.deleteVote(ctx, vote.ProposalID, vote.Voter)
 		return false
 	})
 
-	// iterate over the validators again to tally their voting power
-	for _, val := range currValidators {
-		if val.Vote == OptionEmpty {
+	// a validator that voted votes with the shares of its delegators that did
+	// not. The delegations of its operator were accumulated as those of any
+	// voter, they are taken back as they are not tallied separately.
+	for _, operator := range operators {
+		options, ok := keeper.getEffectiveVote(ctx, proposal, sdk.AccAddress(operator))
+		if !ok {
+			continue
+		}
+
+		val := currValidators[operator.String()]
+		val.Vote = options
+		currValidators[operator.String()] = val
+
+		keeper.sk.IterateDelegations(ctx, sdk.AccAddress(operator), func(index int64, delegation exported.DelegationI) (stop bool) {
+			valAddrStr := delegation.GetValidatorAddr().String()
+
+			if val, ok := currValidators[valAddrStr]; ok {
+				val.VoteShares = val.VoteShares.SubVote(delegation.GetShares(), options)
+				currValidators[valAddrStr] = val
+			}
+
+			return false
+		})
+	}
+
+	// iterate over the validators to tally the voting power of their delegators
+	// that voted and their own
+	for _, operator := range operators {
+		val := currValidators[operator.String()]
+
+		if !val.VoteShares.VotedShares.IsZero() {
+			for option := range results {
+				subPower := val.VoteShares.OptionShares(option).MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+				results[option] = results[option].Add(subPower)
+			}
+			votingPower := val.VoteShares.VotedShares.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+			totalVotingPower = totalVotingPower.Add(votingPower)
+		}
+
+		if len(val.Vote) == 0 {
 			continue
 		}
 
-		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
+		sharesAfterDeductions := val.DelegatorShares.Sub(val.VoteShares.VotedShares)
 		fractionAfterDeductions := sharesAfterDeductions.Quo(val.DelegatorShares)
 		votingPower := fractionAfterDeductions.MulInt(val.BondedTokens)
 
-		results[val.Vote] = results[val.Vote].Add(votingPower)
+		for _, option := range val.Vote {
+			subPower := votingPower.Mul(option.Weight)
+			results[option.Option] = results[option.Option].Add(subPower)
+		}
 		totalVotingPower = totalVotingPower.Add(votingPower)
 	}
 
-	tallyParams := keeper.GetTallyParams(ctx)
 	tallyResults = NewTallyResultFromMap(results)
 
-	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
 	// If there is no staked coins, the proposal fails
-	if keeper.sk.TotalBondedTokens(ctx).IsZero() {
-		return false, false, tallyResults
-	}
-
-	// If there is not enough quorum of votes, the proposal fails
-	percentVoting := totalVotingPower.Quo(keeper.sk.TotalBondedTokens(ctx).ToDec())
-	if percentVoting.LT(tallyParams.Quorum) {
-		return false, true, tallyResults
-	}
-
-	// If no one votes (everyone abstains), proposal fails
-	if totalVotingPower.Sub(results[OptionAbstain]).Equal(sdk.ZeroDec()) {
+	totalBonded := keeper.sk.TotalBondedTokens(ctx)
+	if totalBonded.IsZero() {
 		return false, false, tallyResults
 	}
 
-	// If more than 1/3 of voters veto, proposal fails
-	if results[OptionNoWithVeto].Quo(totalVotingPower).GT(tallyParams.Veto) {
-		return false, true, tallyResults
-	}
-
-	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
-	if results[OptionYes].Quo(totalVotingPower.Sub(results[OptionAbstain])).GT(tallyParams.Threshold) {
-		return true, false, tallyResults
-	}
-
-	// If more than 1/2 of non-abstaining voters vote No, proposal fails
-	return false, false, tallyResults
+	percentVoting := totalVotingPower.Quo(totalBonded.ToDec())
+	passes, burnDeposits = tallyOutcome(getTallyParams(ctx, keeper, proposal), results, totalVotingPower, percentVoting)
+	return passes, burnDeposits, tallyResults
 }
@@ -0,0 +1,138 @@
+package gov
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/exported"
+)
+
+// OneAccountOneVoteTally gives every account of a whitelisted set one vote,
+// regardless of its stake. Votes from accounts outside the set are ignored
+// and the quorum is measured against the size of the set. As an account can't
+// be split, a weighted vote counts for the option with the largest weight,
+// ties going to the option listed first, so that the tally result holds whole
+// accounts and the outcome is decided on the stored result.
+type OneAccountOneVoteTally struct {
+	voters map[string]bool
+}
+
+var _ TallyStrategy = OneAccountOneVoteTally{}
+
+// NewOneAccountOneVoteTally returns a tally strategy restricted to the given voters
+func NewOneAccountOneVoteTally(voters []sdk.AccAddress) TallyStrategy {
+	whitelist := make(map[string]bool, len(voters))
+	for _, voter := range voters {
+		whitelist[voter.String()] = true
+	}
+	return OneAccountOneVoteTally{voters: whitelist}
+}
+
+// Name implements TallyStrategy
+func (OneAccountOneVoteTally) Name() string { return "one_account_one_vote" }
+
+// Tally implements TallyStrategy
+func (t OneAccountOneVoteTally) Tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, burnDeposits bool, tallyResults TallyResult) {
+	results := newTallyResultsMap()
+	totalVotingPower := sdk.ZeroDec()
+
+	keeper.IterateVotes(ctx, proposal.ProposalID, func(vote types.Vote) bool {
+		if !t.voters[vote.Voter.String()] {
+			return false
+		}
+
+		option := largestVoteOption(vote.GetOptions())
+		results[option] = results[option].Add(sdk.OneDec())
+		totalVotingPower = totalVotingPower.Add(sdk.OneDec())
+		return false
+	})
+
+	tallyResults = NewTallyResultFromMap(results)
+
+	// If there is no one allowed to vote, the proposal fails
+	if len(t.voters) == 0 {
+		return false, false, tallyResults
+	}
+
+	percentVoting := totalVotingPower.QuoInt64(int64(len(t.voters)))
+	passes, burnDeposits = tallyOutcome(getTallyParams(ctx, keeper, proposal), results, totalVotingPower, percentVoting)
+	return passes, burnDeposits, tallyResults
+}
+
+// largestVoteOption returns the option of a weighted vote with the largest
+// weight, or the first one listed among the options with the largest weight
+func largestVoteOption(options types.WeightedVoteOptions) VoteOption {
+	largest := options[0]
+	for _, option := range options[1:] {
+		if option.Weight.GT(largest.Weight) {
+			largest = option
+		}
+	}
+	return largest.Option
+}
+
+// QuadraticTally weighs each vote by the square root of the voter's own
+// bonded stake. Delegators do not inherit the votes of their validators. The
+// quorum is measured on the bonded stake that voted, not on voting power.
+type QuadraticTally struct{}
+
+var _ TallyStrategy = QuadraticTally{}
+
+// NewQuadraticTally returns a quadratic voting tally strategy
+func NewQuadraticTally() TallyStrategy {
+	return QuadraticTally{}
+}
+
+// Name implements TallyStrategy
+func (QuadraticTally) Name() string { return "quadratic" }
+
+// Tally implements TallyStrategy
+func (QuadraticTally) Tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, burnDeposits bool, tallyResults TallyResult) {
+	results := newTallyResultsMap()
+	totalVotingPower := sdk.ZeroDec()
+	totalStakeVoted := sdk.ZeroDec()
+	currValidators := make(map[string]exported.ValidatorI)
+
+	keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator exported.ValidatorI) (stop bool) {
+		currValidators[validator.GetOperator().String()] = validator
+		return false
+	})
+
+	keeper.IterateVotes(ctx, proposal.ProposalID, func(vote types.Vote) bool {
+		stake := sdk.ZeroDec()
+		keeper.sk.IterateDelegations(ctx, vote.Voter, func(index int64, delegation exported.DelegationI) (stop bool) {
+			if val, ok := currValidators[delegation.GetValidatorAddr().String()]; ok {
+				delegatorShare := delegation.GetShares().Quo(val.GetDelegatorShares())
+				stake = stake.Add(delegatorShare.MulInt(val.GetBondedTokens()))
+			}
+			return false
+		})
+
+		votingPower := sqrtInt(stake.TruncateInt()).ToDec()
+		for _, option := range vote.GetOptions() {
+			subPower := votingPower.Mul(option.Weight)
+			results[option.Option] = results[option.Option].Add(subPower)
+		}
+		totalVotingPower = totalVotingPower.Add(votingPower)
+		totalStakeVoted = totalStakeVoted.Add(stake)
+		return false
+	})
+
+	tallyResults = NewTallyResultFromMap(results)
+
+	// If there is no staked coins, the proposal fails
+	totalBonded := keeper.sk.TotalBondedTokens(ctx)
+	if totalBonded.IsZero() {
+		return false, false, tallyResults
+	}
+
+	percentVoting := totalStakeVoted.Quo(totalBonded.ToDec())
+	passes, burnDeposits = tallyOutcome(getTallyParams(ctx, keeper, proposal), results, totalVotingPower, percentVoting)
+	return passes, burnDeposits, tallyResults
+}
+
+// sqrtInt returns the integer square root of a non-negative integer
+func sqrtInt(i sdk.Int) sdk.Int {
+	return sdk.NewIntFromBigInt(new(big.Int).Sqrt(i.BigInt()))
+}
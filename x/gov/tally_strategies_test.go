@@ -0,0 +1,209 @@
+package gov
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func TestTallyStrategyRecorded(t *testing.T) {
+	input := getMockApp(t, 10, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+	stakingHandler := staking.NewHandler(input.sk)
+
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(input.addrs[0]), sdk.ValAddress(input.addrs[1])}
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{5, 5})
+	staking.EndBlocker(ctx, input.sk)
+
+	proposal, err := input.keeper.SubmitProposal(ctx, testProposal())
+	require.NoError(t, err)
+	proposal.Status = StatusVotingPeriod
+	input.keeper.SetProposal(ctx, proposal)
+
+	require.Nil(t, input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], OptionYes))
+	require.Nil(t, input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[1], OptionYes))
+
+	passes, _, tallyResults := tally(ctx, input.keeper, proposal)
+	require.True(t, passes)
+	require.Equal(t, "stake_weighted", tallyResults.Strategy)
+}
+
+func TestTallyOneAccountOneVote(t *testing.T) {
+	input := getMockApp(t, 10, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+	stakingHandler := staking.NewHandler(input.sk)
+
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(input.addrs[0]), sdk.ValAddress(input.addrs[1])}
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{5, 5})
+	staking.EndBlocker(ctx, input.sk)
+
+	input.keeper.tallyStrategy = NewOneAccountOneVoteTally(input.addrs[2:5])
+
+	proposal, err := input.keeper.SubmitProposal(ctx, testProposal())
+	require.NoError(t, err)
+	proposal.Status = StatusVotingPeriod
+	input.keeper.SetProposal(ctx, proposal)
+
+	// the validators are not whitelisted, so their stake does not count
+	require.Nil(t, input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], OptionNoWithVeto))
+	require.Nil(t, input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[1], OptionNoWithVeto))
+	require.Nil(t, input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[2], OptionYes))
+	require.Nil(t, input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[3], OptionYes))
+
+	passes, burnDeposits, tallyResults := tally(ctx, input.keeper, proposal)
+	require.True(t, passes)
+	require.False(t, burnDeposits)
+	require.Equal(t, "one_account_one_vote", tallyResults.Strategy)
+	require.True(t, tallyResults.Equals(NewTallyResult(sdk.NewInt(2), sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt())))
+}
+
+func TestTallyOneAccountOneVoteSplitVotes(t *testing.T) {
+	input := getMockApp(t, 10, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	input.keeper.tallyStrategy = NewOneAccountOneVoteTally(input.addrs[:3])
+
+	proposal, err := input.keeper.SubmitProposal(ctx, testProposal())
+	require.NoError(t, err)
+	proposal.Status = StatusVotingPeriod
+	input.keeper.SetProposal(ctx, proposal)
+
+	// the split votes would sum up to 1.8 yes and 1.2 no
+	require.Nil(t, input.keeper.AddWeightedVote(ctx, proposal.ProposalID, input.addrs[0], WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(4, 1)),
+		NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(6, 1)),
+	}))
+	require.Nil(t, input.keeper.AddWeightedVote(ctx, proposal.ProposalID, input.addrs[1], WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(7, 1)),
+		NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(3, 1)),
+	}))
+	require.Nil(t, input.keeper.AddWeightedVote(ctx, proposal.ProposalID, input.addrs[2], WeightedVoteOptions{
+		NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(5, 1)),
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(5, 1)),
+	}))
+
+	// each account counts for its largest option, the tie going to the first
+	// one listed, and the outcome is decided on the stored result
+	passes, burnDeposits, tallyResults := tally(ctx, input.keeper, proposal)
+	require.False(t, passes)
+	require.False(t, burnDeposits)
+	require.True(t, tallyResults.Equals(NewTallyResult(sdk.NewInt(1), sdk.ZeroInt(), sdk.NewInt(2), sdk.ZeroInt())))
+}
+
+func TestTallyOneAccountOneVoteNoQuorum(t *testing.T) {
+	input := getMockApp(t, 10, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	input.keeper.tallyStrategy = NewOneAccountOneVoteTally(input.addrs[:4])
+
+	proposal, err := input.keeper.SubmitProposal(ctx, testProposal())
+	require.NoError(t, err)
+	proposal.Status = StatusVotingPeriod
+	input.keeper.SetProposal(ctx, proposal)
+
+	require.Nil(t, input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], OptionYes))
+
+	passes, burnDeposits, _ := tally(ctx, input.keeper, proposal)
+	require.False(t, passes)
+	require.True(t, burnDeposits)
+}
+
+func TestTallyQuadratic(t *testing.T) {
+	input := getMockApp(t, 10, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+	stakingHandler := staking.NewHandler(input.sk)
+
+	valAddrs := make([]sdk.ValAddress, len(input.addrs[:3]))
+	for i, addr := range input.addrs[:3] {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{9, 1, 1})
+
+	delTokens := sdk.TokensFromConsensusPower(1)
+	for i, addr := range input.addrs[3:5] {
+		res := stakingHandler(ctx, staking.NewMsgDelegate(addr, valAddrs[i+1], sdk.NewCoin(sdk.DefaultBondDenom, delTokens)))
+		require.True(t, res.IsOK())
+	}
+	staking.EndBlocker(ctx, input.sk)
+
+	proposal, err := input.keeper.SubmitProposal(ctx, testProposal())
+	require.NoError(t, err)
+	proposal.Status = StatusVotingPeriod
+	input.keeper.SetProposal(ctx, proposal)
+
+	// a single large holder votes yes against four small holders
+	require.Nil(t, input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], OptionYes))
+	for _, addr := range input.addrs[1:5] {
+		require.Nil(t, input.keeper.AddVote(ctx, proposal.ProposalID, addr, OptionNo))
+	}
+
+	passes, _, _ := NewStakeWeightedTally().Tally(ctx, input.keeper, proposal)
+	require.True(t, passes)
+
+	input.keeper.tallyStrategy = NewQuadraticTally()
+	passes, burnDeposits, tallyResults := tally(ctx, input.keeper, proposal)
+	require.False(t, passes)
+	require.False(t, burnDeposits)
+	require.Equal(t, "quadratic", tallyResults.Strategy)
+	require.True(t, tallyResults.Equals(NewTallyResult(sdk.NewInt(3000), sdk.ZeroInt(), sdk.NewInt(4000), sdk.ZeroInt())))
+}
+
+func TestTallyQuadraticNoInheritance(t *testing.T) {
+	input := getMockApp(t, 10, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+	stakingHandler := staking.NewHandler(input.sk)
+
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(input.addrs[0]), sdk.ValAddress(input.addrs[1])}
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{5, 5})
+
+	delTokens := sdk.TokensFromConsensusPower(30)
+	res := stakingHandler(ctx, staking.NewMsgDelegate(input.addrs[2], valAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, delTokens)))
+	require.True(t, res.IsOK())
+	staking.EndBlocker(ctx, input.sk)
+
+	input.keeper.tallyStrategy = NewQuadraticTally()
+
+	proposal, err := input.keeper.SubmitProposal(ctx, testProposal())
+	require.NoError(t, err)
+	proposal.Status = StatusVotingPeriod
+	input.keeper.SetProposal(ctx, proposal)
+
+	// the delegator does not inherit the validator vote, so only 5 of 40
+	// bonded tokens voted
+	require.Nil(t, input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], OptionYes))
+
+	passes, burnDeposits, tallyResults := tally(ctx, input.keeper, proposal)
+	require.False(t, passes)
+	require.True(t, burnDeposits)
+	require.True(t, tallyResults.Equals(NewTallyResult(sdk.NewInt(2236), sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt())))
+}
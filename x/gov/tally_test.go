@@ -1,6 +1,7 @@
 package gov
 
 import (
+	"math/rand"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -9,7 +10,9 @@ import (
 	"github.com/tendermint/tendermint/crypto/ed25519"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/exported"
 )
 
 func TestTallyNoOneVotes(t *testing.T) {
@@ -602,3 +605,530 @@ func TestTallyJailedValidator(t *testing.T) {
 	require.False(t, burnDeposits)
 	require.False(t, tallyResults.Equals(EmptyTallyResult()))
 }
+
+func TestTallyWeightedValidatorsDelegatorInherit(t *testing.T) {
+	input := getMockApp(t, 10, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+	stakingHandler := staking.NewHandler(input.sk)
+
+	valAddrs := make([]sdk.ValAddress, len(input.addrs[:3]))
+	for i, addr := range input.addrs[:3] {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{5, 6, 7})
+	staking.EndBlocker(ctx, input.sk)
+
+	delTokens := sdk.TokensFromConsensusPower(30)
+	delegator1Msg := staking.NewMsgDelegate(input.addrs[3], sdk.ValAddress(input.addrs[2]), sdk.NewCoin(sdk.DefaultBondDenom, delTokens))
+	stakingHandler(ctx, delegator1Msg)
+
+	tp := testProposal()
+	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = StatusVotingPeriod
+	input.keeper.SetProposal(ctx, proposal)
+
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], OptionNo)
+	require.Nil(t, err)
+	err = input.keeper.AddWeightedVote(ctx, proposalID, input.addrs[1], WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(5, 1)),
+		NewWeightedVoteOption(OptionAbstain, sdk.NewDecWithPrec(5, 1)),
+	})
+	require.Nil(t, err)
+	err = input.keeper.AddWeightedVote(ctx, proposalID, input.addrs[2], WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1)),
+		NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(4, 1)),
+	})
+	require.Nil(t, err)
+
+	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnDeposits, tallyResults := tally(ctx, input.keeper, proposal)
+
+	// the delegator inherits the split vote of the third validator
+	expected := NewTallyResult(
+		sdk.TokensFromConsensusPower(3).Add(sdk.NewInt(22200000)),
+		sdk.TokensFromConsensusPower(3),
+		sdk.TokensFromConsensusPower(5).Add(sdk.NewInt(14800000)),
+		sdk.ZeroInt(),
+	)
+	require.True(t, passes)
+	require.False(t, burnDeposits)
+	require.True(t, tallyResults.Equals(expected), "%s", tallyResults)
+}
+
+func TestTallyWeightedDelegatorOverride(t *testing.T) {
+	input := getMockApp(t, 10, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+	stakingHandler := staking.NewHandler(input.sk)
+
+	valAddrs := make([]sdk.ValAddress, len(input.addrs[:3]))
+	for i, addr := range input.addrs[:3] {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{5, 6, 7})
+	staking.EndBlocker(ctx, input.sk)
+
+	delTokens := sdk.TokensFromConsensusPower(28)
+	delegator1Msg := staking.NewMsgDelegate(input.addrs[3], sdk.ValAddress(input.addrs[2]), sdk.NewCoin(sdk.DefaultBondDenom, delTokens))
+	stakingHandler(ctx, delegator1Msg)
+
+	tp := testProposal()
+	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = StatusVotingPeriod
+	input.keeper.SetProposal(ctx, proposal)
+
+	for _, addr := range input.addrs[:3] {
+		err = input.keeper.AddVote(ctx, proposalID, addr, OptionYes)
+		require.Nil(t, err)
+	}
+	err = input.keeper.AddWeightedVote(ctx, proposalID, input.addrs[3], WeightedVoteOptions{
+		NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(7, 1)),
+		NewWeightedVoteOption(OptionNoWithVeto, sdk.NewDecWithPrec(3, 1)),
+	})
+	require.Nil(t, err)
+
+	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnDeposits, tallyResults := tally(ctx, input.keeper, proposal)
+
+	// the delegator's power is deducted from the third validator and split
+	expected := NewTallyResult(
+		sdk.TokensFromConsensusPower(18),
+		sdk.ZeroInt(),
+		sdk.NewInt(19600000),
+		sdk.NewInt(8400000),
+	)
+	require.False(t, passes)
+	require.False(t, burnDeposits)
+	require.True(t, tallyResults.Equals(expected), "%s", tallyResults)
+}
+
+// iterativeTally recomputes the vote shares of every validator by iterating
+// over the votes and the delegations of every voter, and applies the same
+// conversion to tokens as the tally. It checks that the vote shares accumulated
+// as votes are cast and delegations change are up to date; it is not an
+// independent tally, see baselineTally for that.
+func iterativeTally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, burnDeposits bool, tallyResults TallyResult) {
+	results := newTallyResultsMap()
+	totalVotingPower := sdk.ZeroDec()
+	currValidators := make(map[string]validatorGovInfo)
+
+	keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator exported.ValidatorI) (stop bool) {
+		currValidators[validator.GetOperator().String()] = newValidatorGovInfo(
+			validator.GetOperator(),
+			validator.GetBondedTokens(),
+			validator.GetDelegatorShares(),
+			NewValidatorVoteShares(proposal.ProposalID, validator.GetOperator()),
+			WeightedVoteOptions{},
+		)
+		return false
+	})
+
+	castVote := func(voter sdk.AccAddress, options WeightedVoteOptions) {
+		valAddrStr := sdk.ValAddress(voter).String()
+		if val, ok := currValidators[valAddrStr]; ok {
+			val.Vote = options
+			currValidators[valAddrStr] = val
+			return
+		}
+
+		keeper.sk.IterateDelegations(ctx, voter, func(index int64, delegation exported.DelegationI) (stop bool) {
+			valAddrStr := delegation.GetValidatorAddr().String()
+
+			if val, ok := currValidators[valAddrStr]; ok {
+				val.VoteShares = val.VoteShares.AddVote(delegation.GetShares(), options)
+				currValidators[valAddrStr] = val
+			}
+
+			return false
+		})
+	}
+
+	voted := make(map[string]bool)
+	keeper.IterateVotes(ctx, proposal.ProposalID, func(vote types.Vote) bool {
+		voted[vote.Voter.String()] = true
+		castVote(vote.Voter, vote.GetOptions())
+		return false
+	})
+
+	keeper.IterateAllVoteDelegations(ctx, func(voteDelegation types.VoteDelegation) bool {
+		delegator := voteDelegation.Delegator.String()
+		if voted[delegator] {
+			return false
+		}
+		voted[delegator] = true
+
+		if options, ok := keeper.resolveDelegatedVote(ctx, proposal, voteDelegation.Delegator); ok {
+			castVote(voteDelegation.Delegator, options)
+		}
+		return false
+	})
+
+	for _, val := range currValidators {
+		if !val.VoteShares.VotedShares.IsZero() {
+			for option := range results {
+				subPower := val.VoteShares.OptionShares(option).MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+				results[option] = results[option].Add(subPower)
+			}
+			votingPower := val.VoteShares.VotedShares.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+			totalVotingPower = totalVotingPower.Add(votingPower)
+		}
+
+		if len(val.Vote) == 0 {
+			continue
+		}
+
+		sharesAfterDeductions := val.DelegatorShares.Sub(val.VoteShares.VotedShares)
+		fractionAfterDeductions := sharesAfterDeductions.Quo(val.DelegatorShares)
+		votingPower := fractionAfterDeductions.MulInt(val.BondedTokens)
+
+		for _, option := range val.Vote {
+			subPower := votingPower.Mul(option.Weight)
+			results[option.Option] = results[option.Option].Add(subPower)
+		}
+		totalVotingPower = totalVotingPower.Add(votingPower)
+	}
+
+	tallyResults = NewTallyResultFromMap(results)
+
+	totalBonded := keeper.sk.TotalBondedTokens(ctx)
+	if totalBonded.IsZero() {
+		return false, false, tallyResults
+	}
+
+	percentVoting := totalVotingPower.Quo(totalBonded.ToDec())
+	passes, burnDeposits = tallyOutcome(getTallyParams(ctx, keeper, proposal), results, totalVotingPower, percentVoting)
+	return passes, burnDeposits, tallyResults
+}
+
+// randomWeightedVoteOptions returns a vote on one option or split between two
+func randomWeightedVoteOptions(r *rand.Rand) WeightedVoteOptions {
+	options := []VoteOption{OptionYes, OptionAbstain, OptionNo, OptionNoWithVeto}
+	perm := r.Perm(len(options))
+	if r.Intn(2) == 0 {
+		return NewNonSplitVoteOption(options[perm[0]])
+	}
+
+	weight := sdk.NewDecWithPrec(r.Int63n(9)+1, 1)
+	return WeightedVoteOptions{
+		NewWeightedVoteOption(options[perm[0]], weight),
+		NewWeightedVoteOption(options[perm[1]], sdk.OneDec().Sub(weight)),
+	}
+}
+
+func TestTallyRandomizedVoteShares(t *testing.T) {
+	for seed := int64(1); seed <= 10; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		input := getMockApp(t, 10, GenesisState{}, nil)
+
+		header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+		input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+		ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+		stakingHandler := staking.NewHandler(input.sk)
+
+		valAddrs := make([]sdk.ValAddress, 3)
+		powers := make([]int64, 3)
+		for i := range valAddrs {
+			valAddrs[i] = sdk.ValAddress(input.addrs[i])
+			powers[i] = r.Int63n(10) + 1
+		}
+		createValidators(t, stakingHandler, ctx, valAddrs, powers)
+		staking.EndBlocker(ctx, input.sk)
+
+		proposal, err := input.keeper.SubmitProposal(ctx, testProposal())
+		require.NoError(t, err)
+		input.keeper.activateVotingPeriod(ctx, proposal)
+		proposal, ok := input.keeper.GetProposal(ctx, proposal.ProposalID)
+		require.True(t, ok)
+
+		// messages are delivered on a cache context, as in a transaction, so
+		// that failed ones leave no state behind
+		deliver := func(msg sdk.Msg, handler sdk.Handler) {
+			cacheCtx, writeCache := ctx.CacheContext()
+			if handler(cacheCtx, msg).IsOK() {
+				writeCache()
+			}
+		}
+		govHandler := NewHandler(input.keeper)
+
+		for i := 0; i < 200; i++ {
+			addr := input.addrs[r.Intn(len(input.addrs))]
+			valAddr := valAddrs[r.Intn(len(valAddrs))]
+			amount := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(r.Int63n(3)+1))
+
+			switch r.Intn(8) {
+			case 0, 1:
+				deliver(staking.NewMsgDelegate(addr, valAddr, amount), stakingHandler)
+			case 2:
+				deliver(staking.NewMsgUndelegate(addr, valAddr, amount), stakingHandler)
+			case 3:
+				dstAddr := valAddrs[r.Intn(len(valAddrs))]
+				deliver(staking.NewMsgBeginRedelegate(addr, valAddr, dstAddr, amount), stakingHandler)
+			case 4, 5:
+				deliver(NewMsgVoteWeighted(addr, proposal.ProposalID, randomWeightedVoteOptions(r)), govHandler)
+			case 6:
+				delegate := input.addrs[r.Intn(len(input.addrs))]
+				proposalType := ""
+				if r.Intn(2) == 0 {
+					proposalType = proposal.ProposalType()
+				}
+				deliver(NewMsgDelegateVote(addr, delegate, proposalType), govHandler)
+			case 7:
+				if r.Intn(4) == 0 {
+					validator, found := input.sk.GetValidator(ctx, valAddr)
+					require.True(t, found)
+					input.sk.Slash(ctx, validator.GetConsAddr(), ctx.BlockHeight(), validator.GetConsensusPower(), sdk.NewDecWithPrec(1, 2))
+				} else {
+					deliver(NewMsgUndelegateVote(addr, ""), govHandler)
+				}
+			}
+
+			require.NoError(t, VoteSharesInvariant(input.keeper)(ctx), "seed %d, operation %d", seed, i)
+
+			expPasses, expBurnDeposits, expTallyResults := iterativeTally(ctx, input.keeper, proposal)
+			passes, burnDeposits, tallyResults := StakeWeightedTally{}.Tally(ctx, input.keeper, proposal)
+			require.Equal(t, expPasses, passes, "seed %d, operation %d", seed, i)
+			require.Equal(t, expBurnDeposits, burnDeposits, "seed %d, operation %d", seed, i)
+			require.Equal(t, expTallyResults, tallyResults, "seed %d, operation %d", seed, i)
+		}
+	}
+}
+
+// baselineValidatorGovInfo is validatorGovInfo as used by baselineTally
+type baselineValidatorGovInfo struct {
+	Address             sdk.ValAddress // address of the validator operator
+	BondedTokens        sdk.Int        // Power of a Validator
+	DelegatorShares     sdk.Dec        // Total outstanding delegator shares
+	DelegatorDeductions sdk.Dec        // Delegator deductions from validator's delegators voting independently
+	Vote                VoteOption     // Vote of the validator
+}
+
+func newBaselineValidatorGovInfo(address sdk.ValAddress, bondedTokens sdk.Int, delegatorShares,
+	delegatorDeductions sdk.Dec, vote VoteOption) baselineValidatorGovInfo {
+
+	return baselineValidatorGovInfo{
+		Address:             address,
+		BondedTokens:        bondedTokens,
+		DelegatorShares:     delegatorShares,
+		DelegatorDeductions: delegatorDeductions,
+		Vote:                vote,
+	}
+}
+
+// baselineTally is the tally as it was before the vote shares were
+// accumulated per validator, copied verbatim apart from the renamed validator
+// info type. It only supports votes for a single option and no governance
+// delegates, and it deletes the votes it counts, so it must be run on a cache
+// context.
+func baselineTally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, burnDeposits bool, tallyResults TallyResult) {
+	results := make(map[VoteOption]sdk.Dec)
+	results[OptionYes] = sdk.ZeroDec()
+	results[OptionAbstain] = sdk.ZeroDec()
+	results[OptionNo] = sdk.ZeroDec()
+	results[OptionNoWithVeto] = sdk.ZeroDec()
+
+	totalVotingPower := sdk.ZeroDec()
+	currValidators := make(map[string]baselineValidatorGovInfo)
+
+	// fetch all the bonded validators, insert them into currValidators
+	keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator exported.ValidatorI) (stop bool) {
+		currValidators[validator.GetOperator().String()] = newBaselineValidatorGovInfo(
+			validator.GetOperator(),
+			validator.GetBondedTokens(),
+			validator.GetDelegatorShares(),
+			sdk.ZeroDec(),
+			OptionEmpty,
+		)
+
+		return false
+	})
+
+	keeper.IterateVotes(ctx, proposal.ProposalID, func(vote types.Vote) bool {
+		// if validator, just record it in the map
+		// if delegator tally voting power
+		valAddrStr := sdk.ValAddress(vote.Voter).String()
+		if val, ok := currValidators[valAddrStr]; ok {
+			val.Vote = vote.Option
+			currValidators[valAddrStr] = val
+		} else {
+			// iterate over all delegations from voter, deduct from any delegated-to validators
+			keeper.sk.IterateDelegations(ctx, vote.Voter, func(index int64, delegation exported.DelegationI) (stop bool) {
+				valAddrStr := delegation.GetValidatorAddr().String()
+
+				if val, ok := currValidators[valAddrStr]; ok {
+					val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+					currValidators[valAddrStr] = val
+
+					delegatorShare := delegation.GetShares().Quo(val.DelegatorShares)
+					votingPower := delegatorShare.MulInt(val.BondedTokens)
+
+					results[vote.Option] = results[vote.Option].Add(votingPower)
+					totalVotingPower = totalVotingPower.Add(votingPower)
+				}
+
+				return false
+			})
+		}
+
+		keeper.deleteVote(ctx, vote.ProposalID, vote.Voter)
+		return false
+	})
+
+	// iterate over the validators again to tally their voting power
+	for _, val := range currValidators {
+		if val.Vote == OptionEmpty {
+			continue
+		}
+
+		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
+		fractionAfterDeductions := sharesAfterDeductions.Quo(val.DelegatorShares)
+		votingPower := fractionAfterDeductions.MulInt(val.BondedTokens)
+
+		results[val.Vote] = results[val.Vote].Add(votingPower)
+		totalVotingPower = totalVotingPower.Add(votingPower)
+	}
+
+	tallyParams := keeper.GetTallyParams(ctx)
+	tallyResults = NewTallyResultFromMap(results)
+
+	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
+	// If there is no staked coins, the proposal fails
+	if keeper.sk.TotalBondedTokens(ctx).IsZero() {
+		return false, false, tallyResults
+	}
+
+	// If there is not enough quorum of votes, the proposal fails
+	percentVoting := totalVotingPower.Quo(keeper.sk.TotalBondedTokens(ctx).ToDec())
+	if percentVoting.LT(tallyParams.Quorum) {
+		return false, true, tallyResults
+	}
+
+	// If no one votes (everyone abstains), proposal fails
+	if totalVotingPower.Sub(results[OptionAbstain]).Equal(sdk.ZeroDec()) {
+		return false, false, tallyResults
+	}
+
+	// If more than 1/3 of voters veto, proposal fails
+	if results[OptionNoWithVeto].Quo(totalVotingPower).GT(tallyParams.Veto) {
+		return false, true, tallyResults
+	}
+
+	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
+	if results[OptionYes].Quo(totalVotingPower.Sub(results[OptionAbstain])).GT(tallyParams.Threshold) {
+		return true, false, tallyResults
+	}
+
+	// If more than 1/2 of non-abstaining voters vote No, proposal fails
+	return false, false, tallyResults
+}
+
+// baselineTallyTolerance is the largest difference, in tokens, allowed between
+// the voting power of an option in the tally and in baselineTally. The baseline
+// converts the shares of every delegation to tokens and rounds each
+// conversion, while the tally converts the shares accumulated per validator
+// once, so the totals differ by a few units of sdk.Dec precision, which can
+// move the truncated token amount by one.
+var baselineTallyTolerance = sdk.OneInt()
+
+func requireTallyResultWithin(t *testing.T, expected, actual TallyResult, tolerance sdk.Int, msgAndArgs ...interface{}) {
+	for _, amounts := range [][2]sdk.Int{
+		{expected.Yes, actual.Yes},
+		{expected.Abstain, actual.Abstain},
+		{expected.No, actual.No},
+		{expected.NoWithVeto, actual.NoWithVeto},
+	} {
+		diff := amounts[0].Sub(amounts[1])
+		if diff.IsNegative() {
+			diff = diff.Neg()
+		}
+		require.True(t, diff.LTE(tolerance), "expected %s, got %s: %v", expected, actual, msgAndArgs)
+	}
+}
+
+// TestTallyRandomizedBaseline compares the tally with baselineTally over random
+// delegations, undelegations, redelegations, slashes and single option votes,
+// the operations supported by the baseline. The voting power of every option
+// must be within baselineTallyTolerance. The outcome could only differ if a
+// voting power ratio fell within rounding of a tally param, which none of the
+// seeds below produces, so it is compared exactly.
+func TestTallyRandomizedBaseline(t *testing.T) {
+	options := []VoteOption{OptionYes, OptionAbstain, OptionNo, OptionNoWithVeto}
+
+	for seed := int64(1); seed <= 10; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		input := getMockApp(t, 10, GenesisState{}, nil)
+
+		header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+		input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+		ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+		stakingHandler := staking.NewHandler(input.sk)
+
+		valAddrs := make([]sdk.ValAddress, 3)
+		powers := make([]int64, 3)
+		for i := range valAddrs {
+			valAddrs[i] = sdk.ValAddress(input.addrs[i])
+			powers[i] = r.Int63n(10) + 1
+		}
+		createValidators(t, stakingHandler, ctx, valAddrs, powers)
+		staking.EndBlocker(ctx, input.sk)
+
+		proposal, err := input.keeper.SubmitProposal(ctx, testProposal())
+		require.NoError(t, err)
+		input.keeper.activateVotingPeriod(ctx, proposal)
+		proposal, ok := input.keeper.GetProposal(ctx, proposal.ProposalID)
+		require.True(t, ok)
+
+		deliver := func(msg sdk.Msg, handler sdk.Handler) {
+			cacheCtx, writeCache := ctx.CacheContext()
+			if handler(cacheCtx, msg).IsOK() {
+				writeCache()
+			}
+		}
+		govHandler := NewHandler(input.keeper)
+
+		for i := 0; i < 200; i++ {
+			addr := input.addrs[r.Intn(len(input.addrs))]
+			valAddr := valAddrs[r.Intn(len(valAddrs))]
+			amount := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(r.Int63n(3)+1))
+
+			switch r.Intn(7) {
+			case 0, 1:
+				deliver(staking.NewMsgDelegate(addr, valAddr, amount), stakingHandler)
+			case 2:
+				deliver(staking.NewMsgUndelegate(addr, valAddr, amount), stakingHandler)
+			case 3:
+				dstAddr := valAddrs[r.Intn(len(valAddrs))]
+				deliver(staking.NewMsgBeginRedelegate(addr, valAddr, dstAddr, amount), stakingHandler)
+			case 4, 5:
+				deliver(NewMsgVote(addr, proposal.ProposalID, options[r.Intn(len(options))]), govHandler)
+			case 6:
+				validator, found := input.sk.GetValidator(ctx, valAddr)
+				require.True(t, found)
+				input.sk.Slash(ctx, validator.GetConsAddr(), ctx.BlockHeight(), validator.GetConsensusPower(), sdk.NewDecWithPrec(1, 2))
+			}
+
+			baselineCtx, _ := ctx.CacheContext()
+			expPasses, expBurnDeposits, expTallyResults := baselineTally(baselineCtx, input.keeper, proposal)
+			passes, burnDeposits, tallyResults := StakeWeightedTally{}.Tally(ctx, input.keeper, proposal)
+			require.Equal(t, expPasses, passes, "seed %d, operation %d", seed, i)
+			require.Equal(t, expBurnDeposits, burnDeposits, "seed %d, operation %d", seed, i)
+			requireTallyResultWithin(t, expTallyResults, tallyResults, baselineTallyTolerance, "seed %d, operation %d", seed, i)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // module codec
@@ -15,9 +16,14 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSubmitProposal{}, "cosmos-sdk/MsgSubmitProposal", nil)
 	cdc.RegisterConcrete(MsgDeposit{}, "cosmos-sdk/MsgDeposit", nil)
 	cdc.RegisterConcrete(MsgVote{}, "cosmos-sdk/MsgVote", nil)
+	cdc.RegisterConcrete(MsgVoteWeighted{}, "cosmos-sdk/MsgVoteWeighted", nil)
+	cdc.RegisterConcrete(MsgCancelProposal{}, "cosmos-sdk/MsgCancelProposal", nil)
+	cdc.RegisterConcrete(MsgDelegateVote{}, "cosmos-sdk/MsgDelegateVote", nil)
+	cdc.RegisterConcrete(MsgUndelegateVote{}, "cosmos-sdk/MsgUndelegateVote", nil)
 
 	cdc.RegisterConcrete(TextProposal{}, "cosmos-sdk/TextProposal", nil)
 	cdc.RegisterConcrete(SoftwareUpgradeProposal{}, "cosmos-sdk/SoftwareUpgradeProposal", nil)
+	cdc.RegisterConcrete(MsgsProposal{}, "cosmos-sdk/MsgsProposal", nil)
 }
 
 // RegisterProposalTypeCodec registers an external proposal content type defined
@@ -27,7 +33,16 @@ func RegisterProposalTypeCodec(o interface{}, name string) {
 	ModuleCdc.RegisterConcrete(o, name, nil)
 }
 
+// RegisterProposalMsgCodec registers an external message type defined in
+// another module for the internal ModuleCdc. This allows a MsgsProposal
+// carrying the message to be correctly Amino encoded and decoded.
+func RegisterProposalMsgCodec(o interface{}, name string) {
+	ModuleCdc.RegisterConcrete(o, name, nil)
+}
+
 // TODO determine a good place to seal this codec
 func init() {
+	// messages carried by a MsgsProposal are encoded as sdk.Msg interfaces
+	sdk.RegisterCodec(ModuleCdc)
 	RegisterCodec(ModuleCdc)
 }
@@ -30,6 +30,22 @@ type Content interface {
 // governance process.
 type Handler func(ctx sdk.Context, content Content) sdk.Error
 
+type contextKeyProposalID struct{}
+
+// WithProposalID returns a copy of the context holding the ID of the proposal
+// whose content is being handled.
+func WithProposalID(ctx sdk.Context, proposalID uint64) sdk.Context {
+	return ctx.WithValue(contextKeyProposalID{}, proposalID)
+}
+
+// ProposalIDFromContext returns the ID of the proposal whose content is being
+// handled. It returns false if the content is not handled as part of the
+// execution of a passed proposal.
+func ProposalIDFromContext(ctx sdk.Context) (uint64, bool) {
+	proposalID, ok := ctx.Value(contextKeyProposalID{}).(uint64)
+	return proposalID, ok
+}
+
 // ValidateAbstract validates a proposal's abstract contents returning an error
 // if invalid.
 func ValidateAbstract(codespace sdk.CodespaceType, c Content) sdk.Error {
@@ -21,6 +21,10 @@ const (
 	CodeInvalidGenesis           sdk.CodeType = 9
 	CodeInvalidProposalStatus    sdk.CodeType = 10
 	CodeProposalHandlerNotExists sdk.CodeType = 11
+	CodeInvalidProposalMsg       sdk.CodeType = 12
+	CodeInvalidProposer          sdk.CodeType = 13
+	CodeInvalidVoteDelegation    sdk.CodeType = 14
+	CodeUnknownVoteDelegation    sdk.CodeType = 15
 )
 
 func ErrUnknownProposal(codespace sdk.CodespaceType, proposalID uint64) sdk.Error {
@@ -55,6 +59,10 @@ func ErrInvalidVote(codespace sdk.CodespaceType, voteOption VoteOption) sdk.Erro
 	return sdk.NewError(codespace, CodeInvalidVote, fmt.Sprintf("'%v' is not a valid voting option", voteOption.String()))
 }
 
+func ErrInvalidWeightedVote(codespace sdk.CodespaceType, options WeightedVoteOptions) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVote, fmt.Sprintf("'%v' is not a valid weighted vote, the options must be distinct and their weights must sum up to 1", options.String()))
+}
+
 func ErrInvalidGenesis(codespace sdk.CodespaceType, msg string) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidVote, msg)
 }
@@ -62,3 +70,19 @@ func ErrInvalidGenesis(codespace sdk.CodespaceType, msg string) sdk.Error {
 func ErrNoProposalHandlerExists(codespace sdk.CodespaceType, content interface{}) sdk.Error {
 	return sdk.NewError(codespace, CodeProposalHandlerNotExists, fmt.Sprintf("'%T' does not have a corresponding handler", content))
 }
+
+func ErrInvalidProposalMsg(codespace sdk.CodespaceType, index int, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidProposalMsg, fmt.Sprintf("invalid proposal message %d: %s", index, msg))
+}
+
+func ErrInvalidProposer(codespace sdk.CodespaceType, proposalID uint64, proposer sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidProposer, fmt.Sprintf("%s is not the proposer of proposal %d", proposer, proposalID))
+}
+
+func ErrInvalidVoteDelegation(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVoteDelegation, fmt.Sprintf("invalid vote delegation: %s", msg))
+}
+
+func ErrUnknownVoteDelegation(codespace sdk.CodespaceType, delegator sdk.AccAddress, proposalType string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownVoteDelegation, fmt.Sprintf("%s has no vote delegation for proposal type '%s'", delegator, proposalType))
+}
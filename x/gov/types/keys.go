@@ -39,6 +39,14 @@ const (
 // - 0x10<proposalID_Bytes><depositorAddr_Bytes>: Deposit
 //
 // - 0x20<proposalID_Bytes><voterAddr_Bytes>: Voter
+//
+// - 0x21<proposalID_Bytes>: []byte{}
+//
+// - 0x30<delegatorAddr_Bytes><proposalType_Bytes>: VoteDelegation
+//
+// - 0x31<delegateAddr_Bytes><delegatorAddr_Bytes><proposalType_Bytes>: []byte{}
+//
+// - 0x40<proposalID_Bytes><validatorAddr_Bytes>: ValidatorVoteShares
 var (
 	ProposalsKeyPrefix          = []byte{0x00}
 	ActiveProposalQueuePrefix   = []byte{0x01}
@@ -47,7 +55,13 @@ var (
 
 	DepositsKeyPrefix = []byte{0x10}
 
-	VotesKeyPrefix = []byte{0x20}
+	VotesKeyPrefix         = []byte{0x20}
+	VotePruningQueuePrefix = []byte{0x21}
+
+	VoteDelegationsKeyPrefix = []byte{0x30}
+	VoteDelegatorsKeyPrefix  = []byte{0x31}
+
+	ValidatorVoteSharesKeyPrefix = []byte{0x40}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -109,6 +123,46 @@ func VoteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
 	return append(VotesKey(proposalID), voterAddr.Bytes()...)
 }
 
+// VotePruningQueueKey returns the key for a proposalID in the vote pruning queue
+func VotePruningQueueKey(proposalID uint64) []byte {
+	bz := make([]byte, 8)
+	binary.LittleEndian.PutUint64(bz, proposalID)
+	return append(VotePruningQueuePrefix, bz...)
+}
+
+// VoteDelegationsKey gets the first part of the vote delegations key based on the delegator address
+func VoteDelegationsKey(delegatorAddr sdk.AccAddress) []byte {
+	return append(VoteDelegationsKeyPrefix, delegatorAddr.Bytes()...)
+}
+
+// VoteDelegationKey key of the vote delegation of an address for a proposal type
+func VoteDelegationKey(delegatorAddr sdk.AccAddress, proposalType string) []byte {
+	return append(VoteDelegationsKey(delegatorAddr), []byte(proposalType)...)
+}
+
+// VoteDelegatorsKey gets the first part of the vote delegators key based on the delegate address
+func VoteDelegatorsKey(delegateAddr sdk.AccAddress) []byte {
+	return append(VoteDelegatorsKeyPrefix, delegateAddr.Bytes()...)
+}
+
+// VoteDelegatorKey key of the index entry of a vote delegation by its delegate
+func VoteDelegatorKey(delegateAddr, delegatorAddr sdk.AccAddress, proposalType string) []byte {
+	key := append(VoteDelegatorsKey(delegateAddr), delegatorAddr.Bytes()...)
+	return append(key, []byte(proposalType)...)
+}
+
+// ValidatorVoteSharesKey gets the first part of the validator vote shares key based on the proposalID
+func ValidatorVoteSharesKey(proposalID uint64) []byte {
+	bz := make([]byte, 8)
+	binary.LittleEndian.PutUint64(bz, proposalID)
+	return append(ValidatorVoteSharesKeyPrefix, bz...)
+}
+
+// ValidatorVoteShareKey key of the vote shares of a validator on a specific proposal
+func ValidatorVoteShareKey(proposalID uint64, valAddr sdk.ValAddress) []byte {
+	return append(ValidatorVoteSharesKey(proposalID), valAddr.Bytes()...)
+}
+
 // Split keys function; used for iterators
 
 // SplitProposalKey split the proposal key and returns the proposal id
@@ -140,6 +194,22 @@ func SplitKeyVote(key []byte) (proposalID uint64, voterAddr sdk.AccAddress) {
 	return splitKeyWithAddress(key)
 }
 
+// SplitVotePruningQueueKey split the vote pruning queue key and returns the proposal id
+func SplitVotePruningQueueKey(key []byte) (proposalID uint64) {
+	return SplitProposalKey(key)
+}
+
+// SplitKeyVoteDelegator split the vote delegators key and returns the delegator address and proposal type
+func SplitKeyVoteDelegator(key []byte) (delegatorAddr sdk.AccAddress, proposalType string) {
+	if len(key[1:]) < 2*sdk.AddrLen {
+		panic(fmt.Sprintf("unexpected key length (%d < %d)", len(key[1:]), 2*sdk.AddrLen))
+	}
+
+	delegatorAddr = sdk.AccAddress(key[1+sdk.AddrLen : 1+2*sdk.AddrLen])
+	proposalType = string(key[1+2*sdk.AddrLen:])
+	return
+}
+
 // private functions
 
 func splitKeyWithTime(key []byte) (proposalID uint64, endTime time.Time) {
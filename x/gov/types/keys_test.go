@@ -68,3 +68,20 @@ func TestVoteKeys(t *testing.T) {
 	key = VoteKey(5, addr2)
 	require.Panics(t, func() { SplitKeyVote(key) })
 }
+
+func TestVoteDelegatorKeys(t *testing.T) {
+	delegate := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	key := VoteDelegatorKey(delegate, addr, ProposalTypeText)
+	delegatorAddr, proposalType := SplitKeyVoteDelegator(key)
+	require.Equal(t, addr, delegatorAddr)
+	require.Equal(t, ProposalTypeText, proposalType)
+
+	key = VoteDelegatorKey(delegate, addr, "")
+	delegatorAddr, proposalType = SplitKeyVoteDelegator(key)
+	require.Equal(t, addr, delegatorAddr)
+	require.Equal(t, "", proposalType)
+
+	// invalid key
+	require.Panics(t, func() { SplitKeyVoteDelegator(VoteDelegatorsKey(delegate)) })
+}
@@ -10,20 +10,26 @@ import (
 const (
 	TypeMsgDeposit        = "deposit"
 	TypeMsgVote           = "vote"
+	TypeMsgVoteWeighted   = "weighted_vote"
 	TypeMsgSubmitProposal = "submit_proposal"
+	TypeMsgCancelProposal = "cancel_proposal"
+	TypeMsgDelegateVote   = "delegate_vote"
+	TypeMsgUndelegateVote = "undelegate_vote"
 )
 
-var _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgDeposit{}, MsgVote{}
+var _, _, _, _, _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgDeposit{}, MsgVote{}, MsgVoteWeighted{}, MsgCancelProposal{},
+	MsgDelegateVote{}, MsgUndelegateVote{}
 
 // MsgSubmitProposal
 type MsgSubmitProposal struct {
 	Content        Content        `json:"content"`
-	InitialDeposit sdk.Coins      `json:"initial_deposit"` //  Initial deposit paid by sender. Must be strictly positive
-	Proposer       sdk.AccAddress `json:"proposer"`        //  Address of the proposer
+	InitialDeposit sdk.Coins      `json:"initial_deposit"`     //  Initial deposit paid by sender. Must be strictly positive
+	Proposer       sdk.AccAddress `json:"proposer"`            //  Address of the proposer
+	Expedited      bool           `json:"expedited,omitempty"` //  Whether the proposal uses the expedited deposit, voting period and threshold
 }
 
 func NewMsgSubmitProposal(content Content, initialDeposit sdk.Coins, proposer sdk.AccAddress) MsgSubmitProposal {
-	return MsgSubmitProposal{content, initialDeposit, proposer}
+	return MsgSubmitProposal{Content: content, InitialDeposit: initialDeposit, Proposer: proposer}
 }
 
 //nolint
@@ -61,7 +67,8 @@ func (msg MsgSubmitProposal) String() string {
 	return fmt.Sprintf(`Submit Proposal Message:
   Content:         %s
   Initial Deposit: %s
-`, msg.Content.String(), msg.InitialDeposit)
+  Expedited:       %t
+`, msg.Content.String(), msg.InitialDeposit, msg.Expedited)
 }
 
 // Implements Msg.
@@ -170,3 +177,176 @@ func (msg MsgVote) GetSignBytes() []byte {
 func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Voter}
 }
+
+// MsgVoteWeighted
+type MsgVoteWeighted struct {
+	ProposalID uint64              `json:"proposal_id"` // ID of the proposal
+	Voter      sdk.AccAddress      `json:"voter"`       //  address of the voter
+	Options    WeightedVoteOptions `json:"options"`     //  weighted options chosen by the voter
+}
+
+func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions) MsgVoteWeighted {
+	return MsgVoteWeighted{proposalID, voter, options}
+}
+
+// Implements Msg.
+// nolint
+func (msg MsgVoteWeighted) Route() string { return RouterKey }
+func (msg MsgVoteWeighted) Type() string  { return TypeMsgVoteWeighted }
+
+// Implements Msg.
+func (msg MsgVoteWeighted) ValidateBasic() sdk.Error {
+	if msg.Voter.Empty() {
+		return sdk.ErrInvalidAddress(msg.Voter.String())
+	}
+	if !ValidWeightedVoteOptions(msg.Options) {
+		return ErrInvalidWeightedVote(DefaultCodespace, msg.Options)
+	}
+
+	return nil
+}
+
+func (msg MsgVoteWeighted) String() string {
+	return fmt.Sprintf(`Weighted Vote Message:
+  Proposal ID: %d
+  Options:     %s
+`, msg.ProposalID, msg.Options)
+}
+
+// Implements Msg.
+func (msg MsgVoteWeighted) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
+
+// MsgCancelProposal
+type MsgCancelProposal struct {
+	ProposalID uint64         `json:"proposal_id"` // ID of the proposal
+	Proposer   sdk.AccAddress `json:"proposer"`    //  address of the proposer
+}
+
+func NewMsgCancelProposal(proposer sdk.AccAddress, proposalID uint64) MsgCancelProposal {
+	return MsgCancelProposal{proposalID, proposer}
+}
+
+// Implements Msg.
+// nolint
+func (msg MsgCancelProposal) Route() string { return RouterKey }
+func (msg MsgCancelProposal) Type() string  { return TypeMsgCancelProposal }
+
+// Implements Msg.
+func (msg MsgCancelProposal) ValidateBasic() sdk.Error {
+	if msg.Proposer.Empty() {
+		return sdk.ErrInvalidAddress(msg.Proposer.String())
+	}
+
+	return nil
+}
+
+func (msg MsgCancelProposal) String() string {
+	return fmt.Sprintf(`Cancel Proposal Message:
+  Proposal ID: %d
+  Proposer:    %s
+`, msg.ProposalID, msg.Proposer)
+}
+
+// Implements Msg.
+func (msg MsgCancelProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgCancelProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Proposer}
+}
+
+// MsgDelegateVote
+type MsgDelegateVote struct {
+	Delegator    sdk.AccAddress `json:"delegator"`     //  address of the delegator
+	Delegate     sdk.AccAddress `json:"delegate"`      //  address of the delegate
+	ProposalType string         `json:"proposal_type"` //  proposal type the delegation applies to, empty for all types
+}
+
+func NewMsgDelegateVote(delegator, delegate sdk.AccAddress, proposalType string) MsgDelegateVote {
+	return MsgDelegateVote{delegator, delegate, proposalType}
+}
+
+// Implements Msg.
+// nolint
+func (msg MsgDelegateVote) Route() string { return RouterKey }
+func (msg MsgDelegateVote) Type() string  { return TypeMsgDelegateVote }
+
+// Implements Msg.
+func (msg MsgDelegateVote) ValidateBasic() sdk.Error {
+	return NewVoteDelegation(msg.Delegator, msg.Delegate, msg.ProposalType).Validate()
+}
+
+func (msg MsgDelegateVote) String() string {
+	return fmt.Sprintf(`Delegate Vote Message:
+  Delegator:     %s
+  Delegate:      %s
+  Proposal Type: %s
+`, msg.Delegator, msg.Delegate, msg.ProposalType)
+}
+
+// Implements Msg.
+func (msg MsgDelegateVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgDelegateVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Delegator}
+}
+
+// MsgUndelegateVote
+type MsgUndelegateVote struct {
+	Delegator    sdk.AccAddress `json:"delegator"`     //  address of the delegator
+	ProposalType string         `json:"proposal_type"` //  proposal type of the removed delegation, empty for all types
+}
+
+func NewMsgUndelegateVote(delegator sdk.AccAddress, proposalType string) MsgUndelegateVote {
+	return MsgUndelegateVote{delegator, proposalType}
+}
+
+// Implements Msg.
+// nolint
+func (msg MsgUndelegateVote) Route() string { return RouterKey }
+func (msg MsgUndelegateVote) Type() string  { return TypeMsgUndelegateVote }
+
+// Implements Msg.
+func (msg MsgUndelegateVote) ValidateBasic() sdk.Error {
+	if msg.Delegator.Empty() {
+		return sdk.ErrInvalidAddress(msg.Delegator.String())
+	}
+	if msg.ProposalType != "" && !IsValidProposalType(msg.ProposalType) {
+		return ErrInvalidProposalType(DefaultCodespace, msg.ProposalType)
+	}
+
+	return nil
+}
+
+func (msg MsgUndelegateVote) String() string {
+	return fmt.Sprintf(`Undelegate Vote Message:
+  Delegator:     %s
+  Proposal Type: %s
+`, msg.Delegator, msg.ProposalType)
+}
+
+// Implements Msg.
+func (msg MsgUndelegateVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgUndelegateVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Delegator}
+}
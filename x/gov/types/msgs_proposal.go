@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProposalTypeMsgs defines the type for a MsgsProposal
+const ProposalTypeMsgs string = "Msgs"
+
+// MsgsProposal is a proposal which, once passed, executes a list of messages
+// signed by the governance module account. The messages are executed in order
+// and their state changes are only committed if all of them succeed.
+type MsgsProposal struct {
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Msgs        []sdk.Msg `json:"msgs"`
+}
+
+func NewMsgsProposal(title, description string, msgs []sdk.Msg) Content {
+	return MsgsProposal{title, description, msgs}
+}
+
+// Implements Proposal Interface
+var _ Content = MsgsProposal{}
+
+// nolint
+func (mp MsgsProposal) GetTitle() string       { return mp.Title }
+func (mp MsgsProposal) GetDescription() string { return mp.Description }
+func (mp MsgsProposal) ProposalRoute() string  { return RouterKey }
+func (mp MsgsProposal) ProposalType() string   { return ProposalTypeMsgs }
+
+// ValidateBasic runs stateless checks on the proposal and each of its messages.
+// Signers are checked against the governance module account upon submission.
+func (mp MsgsProposal) ValidateBasic() sdk.Error {
+	if err := ValidateAbstract(DefaultCodespace, mp); err != nil {
+		return err
+	}
+	if len(mp.Msgs) == 0 {
+		return ErrInvalidProposalContent(DefaultCodespace, "proposal must contain at least one message")
+	}
+
+	for i, msg := range mp.Msgs {
+		if msg == nil {
+			return ErrInvalidProposalContent(DefaultCodespace, fmt.Sprintf("message %d is empty", i))
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return ErrInvalidProposalMsg(DefaultCodespace, i, err.Result().Log)
+		}
+	}
+
+	return nil
+}
+
+func (mp MsgsProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Msgs Proposal:
+  Title:       %s
+  Description: %s
+  Msgs:
+`, mp.Title, mp.Description))
+
+	for i, msg := range mp.Msgs {
+		b.WriteString(fmt.Sprintf("    %d: %s/%s\n", i, msg.Route(), msg.Type()))
+	}
+
+	return b.String()
+}
@@ -59,6 +59,41 @@ func TestMsgSubmitProposal(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgSubmitProposal carrying messages
+func TestMsgSubmitMsgsProposal(t *testing.T) {
+	tests := []struct {
+		msgs       []sdk.Msg
+		expectPass bool
+	}{
+		{[]sdk.Msg{NewMsgVote(addrs[0], 1, OptionYes)}, true},
+		{[]sdk.Msg{NewMsgVote(addrs[0], 1, OptionYes), NewMsgDeposit(addrs[0], 1, coinsPos)}, true},
+		{[]sdk.Msg{}, false},
+		{[]sdk.Msg{nil}, false},
+		{[]sdk.Msg{NewMsgVote(addrs[0], 1, OptionYes), NewMsgVote(sdk.AccAddress{}, 1, OptionYes)}, false},
+	}
+
+	for i, tc := range tests {
+		content := NewMsgsProposal("Test Proposal", "the purpose of this proposal is to test", tc.msgs)
+		msg := NewMsgSubmitProposal(content, coinsPos, addrs[0])
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgSubmitMsgsProposalGetSignBytes(t *testing.T) {
+	addr := sdk.AccAddress("addr1")
+	content := NewMsgsProposal("Test", "description", []sdk.Msg{NewMsgVote(addr, 1, OptionYes)})
+	msg := NewMsgSubmitProposal(content, coinsPos, addr)
+	res := msg.GetSignBytes()
+
+	expected := `{"type":"cosmos-sdk/MsgSubmitProposal","value":{"content":{"type":"cosmos-sdk/MsgsProposal","value":{"description":"description","msgs":[{"type":"cosmos-sdk/MsgVote","value":{"option":"Yes","proposal_id":"1","voter":"cosmos1v9jxgu33kfsgr5"}}],"title":"Test"}},"initial_deposit":[{"amount":"1000","denom":"stake"}],"proposer":"cosmos1v9jxgu33kfsgr5"}}`
+	require.Equal(t, expected, string(res))
+}
+
 func TestMsgDepositGetSignBytes(t *testing.T) {
 	addr := sdk.AccAddress("addr1")
 	msg := NewMsgDeposit(addr, 0, coinsPos)
@@ -117,3 +152,110 @@ func TestMsgVote(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgVoteWeighted
+func TestMsgVoteWeighted(t *testing.T) {
+	half := sdk.NewDecWithPrec(5, 1)
+	tests := []struct {
+		proposalID uint64
+		voterAddr  sdk.AccAddress
+		options    WeightedVoteOptions
+		expectPass bool
+	}{
+		{0, addrs[0], NewNonSplitVoteOption(OptionYes), true},
+		{0, sdk.AccAddress{}, NewNonSplitVoteOption(OptionYes), false},
+		{0, addrs[0], WeightedVoteOptions{}, false},
+		{0, addrs[0], WeightedVoteOptions{{OptionYes, half}, {OptionNo, half}}, true},
+		{0, addrs[0], WeightedVoteOptions{{OptionYes, sdk.NewDecWithPrec(3, 1)}, {OptionAbstain, sdk.NewDecWithPrec(3, 1)},
+			{OptionNo, sdk.NewDecWithPrec(2, 1)}, {OptionNoWithVeto, sdk.NewDecWithPrec(2, 1)}}, true},
+		// weights must sum up to 1
+		{0, addrs[0], WeightedVoteOptions{{OptionYes, half}, {OptionNo, sdk.NewDecWithPrec(4, 1)}}, false},
+		{0, addrs[0], WeightedVoteOptions{{OptionYes, half}, {OptionNo, sdk.NewDecWithPrec(6, 1)}}, false},
+		// weights must be positive
+		{0, addrs[0], WeightedVoteOptions{{OptionYes, sdk.NewDec(2)}, {OptionNo, sdk.NewDec(-1)}}, false},
+		{0, addrs[0], WeightedVoteOptions{{OptionYes, sdk.OneDec()}, {OptionNo, sdk.ZeroDec()}}, false},
+		// options must be valid and distinct
+		{0, addrs[0], WeightedVoteOptions{{OptionYes, half}, {OptionYes, half}}, false},
+		{0, addrs[0], WeightedVoteOptions{{OptionYes, half}, {VoteOption(0x13), half}}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgVoteWeighted(tc.voterAddr, tc.proposalID, tc.options)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgCancelProposal
+func TestMsgCancelProposal(t *testing.T) {
+	tests := []struct {
+		proposalID   uint64
+		proposerAddr sdk.AccAddress
+		expectPass   bool
+	}{
+		{0, addrs[0], true},
+		{1, addrs[1], true},
+		{0, sdk.AccAddress{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgCancelProposal(tc.proposerAddr, tc.proposalID)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgDelegateVote
+func TestMsgDelegateVote(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		delegateAddr  sdk.AccAddress
+		proposalType  string
+		expectPass    bool
+	}{
+		{addrs[0], addrs[1], "", true},
+		{addrs[0], addrs[1], ProposalTypeText, true},
+		{addrs[0], addrs[1], "unknown", false},
+		{addrs[0], addrs[0], "", false},
+		{sdk.AccAddress{}, addrs[1], "", false},
+		{addrs[0], sdk.AccAddress{}, "", false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgDelegateVote(tc.delegatorAddr, tc.delegateAddr, tc.proposalType)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgUndelegateVote
+func TestMsgUndelegateVote(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		proposalType  string
+		expectPass    bool
+	}{
+		{addrs[0], "", true},
+		{addrs[0], ProposalTypeSoftwareUpgrade, true},
+		{addrs[0], "unknown", false},
+		{sdk.AccAddress{}, "", false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgUndelegateVote(tc.delegatorAddr, tc.proposalType)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
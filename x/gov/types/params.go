@@ -18,76 +18,181 @@ var (
 // Key declaration for parameters
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable(
-		ParamStoreKeyDepositParams, DepositParams{},
-		ParamStoreKeyVotingParams, VotingParams{},
-		ParamStoreKeyTallyParams, TallyParams{},
+		params.NewParamSetPair(ParamStoreKeyDepositParams, DepositParams{}, validateDepositParams),
+		params.NewParamSetPair(ParamStoreKeyVotingParams, VotingParams{}, validateVotingParams),
+		params.NewParamSetPair(ParamStoreKeyTallyParams, TallyParams{}, validateTallyParams),
 	)
 }
 
 // Param around deposits for governance
 type DepositParams struct {
-	MinDeposit       sdk.Coins     `json:"min_deposit,omitempty"`        //  Minimum deposit for a proposal to enter voting period.
-	MaxDepositPeriod time.Duration `json:"max_deposit_period,omitempty"` //  Maximum period for Atom holders to deposit on a proposal. Initial value: 2 months
+	MinDeposit          sdk.Coins     `json:"min_deposit,omitempty"`           //  Minimum deposit for a proposal to enter voting period.
+	MaxDepositPeriod    time.Duration `json:"max_deposit_period,omitempty"`    //  Maximum period for Atom holders to deposit on a proposal. Initial value: 2 months
+	ExpeditedMinDeposit sdk.Coins     `json:"expedited_min_deposit,omitempty"` //  Minimum deposit for an expedited proposal to enter voting period.
+	ProposalCancelRatio sdk.Dec       `json:"proposal_cancel_ratio,omitempty"` //  Fraction of the deposits burned when a proposal is cancelled. Initial value: 0.5
 }
 
 // NewDepositParams creates a new DepositParams object
-func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration) DepositParams {
+func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration, expeditedMinDeposit sdk.Coins,
+	proposalCancelRatio sdk.Dec) DepositParams {
+
 	return DepositParams{
-		MinDeposit:       minDeposit,
-		MaxDepositPeriod: maxDepositPeriod,
+		MinDeposit:          minDeposit,
+		MaxDepositPeriod:    maxDepositPeriod,
+		ExpeditedMinDeposit: expeditedMinDeposit,
+		ProposalCancelRatio: proposalCancelRatio,
 	}
 }
 
 func (dp DepositParams) String() string {
 	return fmt.Sprintf(`Deposit Params:
-  Min Deposit:        %s
-  Max Deposit Period: %s`, dp.MinDeposit, dp.MaxDepositPeriod)
+  Min Deposit:           %s
+  Max Deposit Period:    %s
+  Expedited Min Deposit: %s
+  Proposal Cancel Ratio: %s`, dp.MinDeposit, dp.MaxDepositPeriod, dp.ExpeditedMinDeposit, dp.ProposalCancelRatio)
 }
 
 // Checks equality of DepositParams
 func (dp DepositParams) Equal(dp2 DepositParams) bool {
-	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod
+	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod &&
+		dp.ExpeditedMinDeposit.IsEqual(dp2.ExpeditedMinDeposit) && dp.ProposalCancelRatio.Equal(dp2.ProposalCancelRatio)
+}
+
+// Validate performs basic validation on deposit parameters
+func (dp DepositParams) Validate() error {
+	if !dp.MinDeposit.IsValid() {
+		return fmt.Errorf("Governance deposit amount must be a valid sdk.Coins amount, is %s",
+			dp.MinDeposit.String())
+	}
+
+	if !dp.ExpeditedMinDeposit.IsValid() || !dp.ExpeditedMinDeposit.IsAllGTE(dp.MinDeposit) {
+		return fmt.Errorf("Governance expedited deposit amount must be a valid sdk.Coins amount greater or equal to the deposit amount, is %s",
+			dp.ExpeditedMinDeposit.String())
+	}
+
+	if dp.MaxDepositPeriod <= 0 {
+		return fmt.Errorf("Governance maximum deposit period must be positive, is %s", dp.MaxDepositPeriod.String())
+	}
+
+	if dp.ProposalCancelRatio.IsNil() || dp.ProposalCancelRatio.IsNegative() || dp.ProposalCancelRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("Governance proposal cancel ratio should be positive and less or equal to one, is %s",
+			dp.ProposalCancelRatio.String())
+	}
+
+	return nil
+}
+
+func validateDepositParams(i interface{}) error {
+	dp, ok := i.(DepositParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return dp.Validate()
 }
 
 // Param around Tallying votes in governance
 type TallyParams struct {
-	Quorum    sdk.Dec `json:"quorum,omitempty"`    //  Minimum percentage of total stake needed to vote for a result to be considered valid
-	Threshold sdk.Dec `json:"threshold,omitempty"` //  Minimum proportion of Yes votes for proposal to pass. Initial value: 0.5
-	Veto      sdk.Dec `json:"veto,omitempty"`      //  Minimum value of Veto votes to Total votes ratio for proposal to be vetoed. Initial value: 1/3
+	Quorum             sdk.Dec `json:"quorum,omitempty"`              //  Minimum percentage of total stake needed to vote for a result to be considered valid
+	Threshold          sdk.Dec `json:"threshold,omitempty"`           //  Minimum proportion of Yes votes for proposal to pass. Initial value: 0.5
+	Veto               sdk.Dec `json:"veto,omitempty"`                //  Minimum value of Veto votes to Total votes ratio for proposal to be vetoed. Initial value: 1/3
+	ExpeditedThreshold sdk.Dec `json:"expedited_threshold,omitempty"` //  Minimum proportion of Yes votes for an expedited proposal to pass. Initial value: 0.667
 }
 
 // NewTallyParams creates a new TallyParams object
-func NewTallyParams(quorum, threshold, veto sdk.Dec) TallyParams {
+func NewTallyParams(quorum, threshold, veto, expeditedThreshold sdk.Dec) TallyParams {
 	return TallyParams{
-		Quorum:    quorum,
-		Threshold: threshold,
-		Veto:      veto,
+		Quorum:             quorum,
+		Threshold:          threshold,
+		Veto:               veto,
+		ExpeditedThreshold: expeditedThreshold,
 	}
 }
 
 func (tp TallyParams) String() string {
 	return fmt.Sprintf(`Tally Params:
-  Quorum:             %s
-  Threshold:          %s
-  Veto:               %s`,
-		tp.Quorum, tp.Threshold, tp.Veto)
+  Quorum:              %s
+  Threshold:           %s
+  Veto:                %s
+  Expedited Threshold: %s`,
+		tp.Quorum, tp.Threshold, tp.Veto, tp.ExpeditedThreshold)
+}
+
+// Validate performs basic validation on tally parameters
+func (tp TallyParams) Validate() error {
+	if tp.Quorum.IsNil() || tp.Quorum.IsNegative() || tp.Quorum.GT(sdk.OneDec()) {
+		return fmt.Errorf("Governance vote quorum should be positive and less or equal to one, is %s",
+			tp.Quorum.String())
+	}
+
+	if tp.Threshold.IsNil() || tp.Threshold.IsNegative() || tp.Threshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("Governance vote threshold should be positive and less or equal to one, is %s",
+			tp.Threshold.String())
+	}
+
+	if tp.Veto.IsNil() || tp.Veto.IsNegative() || tp.Veto.GT(sdk.OneDec()) {
+		return fmt.Errorf("Governance vote veto threshold should be positive and less or equal to one, is %s",
+			tp.Veto.String())
+	}
+
+	if tp.ExpeditedThreshold.IsNil() || tp.ExpeditedThreshold.LT(tp.Threshold) || tp.ExpeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("Governance expedited vote threshold should be greater or equal to the vote threshold and less or equal to one, is %s",
+			tp.ExpeditedThreshold.String())
+	}
+
+	return nil
+}
+
+func validateTallyParams(i interface{}) error {
+	tp, ok := i.(TallyParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return tp.Validate()
 }
 
 // Param around Voting in governance
 type VotingParams struct {
-	VotingPeriod time.Duration `json:"voting_period,omitempty"` //  Length of the voting period.
+	VotingPeriod          time.Duration `json:"voting_period,omitempty"`           //  Length of the voting period.
+	ExpeditedVotingPeriod time.Duration `json:"expedited_voting_period,omitempty"` //  Length of the voting period of expedited proposals.
 }
 
 // NewVotingParams creates a new VotingParams object
-func NewVotingParams(votingPeriod time.Duration) VotingParams {
+func NewVotingParams(votingPeriod, expeditedVotingPeriod time.Duration) VotingParams {
 	return VotingParams{
-		VotingPeriod: votingPeriod,
+		VotingPeriod:          votingPeriod,
+		ExpeditedVotingPeriod: expeditedVotingPeriod,
 	}
 }
 
 func (vp VotingParams) String() string {
 	return fmt.Sprintf(`Voting Params:
-  Voting Period:      %s`, vp.VotingPeriod)
+  Voting Period:           %s
+  Expedited Voting Period: %s`, vp.VotingPeriod, vp.ExpeditedVotingPeriod)
+}
+
+// Validate performs basic validation on voting parameters
+func (vp VotingParams) Validate() error {
+	if vp.VotingPeriod <= 0 {
+		return fmt.Errorf("Governance voting period must be positive, is %s", vp.VotingPeriod.String())
+	}
+
+	if vp.ExpeditedVotingPeriod <= 0 || vp.ExpeditedVotingPeriod >= vp.VotingPeriod {
+		return fmt.Errorf("Governance expedited voting period should be positive and less than the voting period, is %s",
+			vp.ExpeditedVotingPeriod.String())
+	}
+
+	return nil
+}
+
+func validateVotingParams(i interface{}) error {
+	vp, ok := i.(VotingParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return vp.Validate()
 }
 
 // Params returns all of the governance params
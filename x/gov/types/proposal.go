@@ -24,6 +24,12 @@ type Proposal struct {
 
 	VotingStartTime time.Time `json:"voting_start_time"` // Time of the block where MinDeposit was reached. -1 if MinDeposit is not reached
 	VotingEndTime   time.Time `json:"voting_end_time"`   // Time that the VotingPeriod for this proposal will end and votes will be tallied
+
+	FailureLog string `json:"failure_log"` // Log of the error if the proposal passed but failed on execution
+
+	Expedited bool `json:"expedited"` // Whether the proposal uses the expedited deposit, voting period and threshold
+
+	Proposer sdk.AccAddress `json:"proposer"` // Address of the proposer, allowed to cancel the proposal
 }
 
 func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Time) Proposal {
@@ -40,7 +46,7 @@ func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Tim
 
 // nolint
 func (p Proposal) String() string {
-	return fmt.Sprintf(`Proposal %d:
+	out := fmt.Sprintf(`Proposal %d:
   Title:              %s
   Type:               %s
   Status:             %s
@@ -49,11 +55,16 @@ func (p Proposal) String() string {
   Total Deposit:      %s
   Voting Start Time:  %s
   Voting End Time:    %s
+  Expedited:          %t
   Description:        %s`,
 		p.ProposalID, p.GetTitle(), p.ProposalType(),
 		p.Status, p.SubmitTime, p.DepositEndTime,
-		p.TotalDeposit, p.VotingStartTime, p.VotingEndTime, p.GetDescription(),
+		p.TotalDeposit, p.VotingStartTime, p.VotingEndTime, p.Expedited, p.GetDescription(),
 	)
+	if p.FailureLog != "" {
+		out += fmt.Sprintf("\n  Failure Log:        %s", p.FailureLog)
+	}
+	return out
 }
 
 // Proposals is an array of proposal
@@ -86,6 +97,7 @@ const (
 	StatusPassed        ProposalStatus = 0x03
 	StatusRejected      ProposalStatus = 0x04
 	StatusFailed        ProposalStatus = 0x05
+	StatusCancelled     ProposalStatus = 0x06
 )
 
 // ProposalStatusToString turns a string into a ProposalStatus
@@ -106,6 +118,9 @@ func ProposalStatusFromString(str string) (ProposalStatus, error) {
 	case "Failed":
 		return StatusFailed, nil
 
+	case "Cancelled":
+		return StatusCancelled, nil
+
 	case "":
 		return StatusNil, nil
 
@@ -121,7 +136,8 @@ func ValidProposalStatus(status ProposalStatus) bool {
 		status == StatusVotingPeriod ||
 		status == StatusPassed ||
 		status == StatusRejected ||
-		status == StatusFailed {
+		status == StatusFailed ||
+		status == StatusCancelled {
 		return true
 	}
 	return false
@@ -178,6 +194,9 @@ func (status ProposalStatus) String() string {
 	case StatusFailed:
 		return "Failed"
 
+	case StatusCancelled:
+		return "Cancelled"
+
 	default:
 		return ""
 	}
@@ -201,6 +220,7 @@ type TallyResult struct {
 	Abstain    sdk.Int `json:"abstain"`
 	No         sdk.Int `json:"no"`
 	NoWithVeto sdk.Int `json:"no_with_veto"`
+	Strategy   string  `json:"strategy"` // Name of the tally strategy that produced the result
 }
 
 func NewTallyResult(yes, abstain, no, noWithVeto sdk.Int) TallyResult {
@@ -231,7 +251,8 @@ func EmptyTallyResult() TallyResult {
 	}
 }
 
-// Equals returns if two proposals are equal.
+// Equals returns if two tally results hold the same vote counts. The strategy
+// that produced the results is not compared.
 func (tr TallyResult) Equals(comp TallyResult) bool {
 	return tr.Yes.Equal(comp.Yes) &&
 		tr.Abstain.Equal(comp.Abstain) &&
@@ -240,11 +261,15 @@ func (tr TallyResult) Equals(comp TallyResult) bool {
 }
 
 func (tr TallyResult) String() string {
-	return fmt.Sprintf(`Tally Result:
+	out := fmt.Sprintf(`Tally Result:
   Yes:        %s
   Abstain:    %s
   No:         %s
   NoWithVeto: %s`, tr.Yes, tr.Abstain, tr.No, tr.NoWithVeto)
+	if tr.Strategy != "" {
+		out += fmt.Sprintf("\n  Strategy:   %s", tr.Strategy)
+	}
+	return out
 }
 
 // Proposal types
@@ -314,6 +339,7 @@ func (sup SoftwareUpgradeProposal) String() string {
 var validProposalTypes = map[string]struct{}{
 	ProposalTypeText:            {},
 	ProposalTypeSoftwareUpgrade: {},
+	ProposalTypeMsgs:            {},
 }
 
 // RegisterProposalType registers a proposal type. It will panic if the type is
@@ -15,6 +15,9 @@ const (
 	QueryVote      = "vote"
 	QueryTally     = "tally"
 
+	QueryVoteDelegations = "vote_delegations"
+	QueryVoteDelegators  = "vote_delegators"
+
 	ParamDeposit  = "deposit"
 	ParamVoting   = "voting"
 	ParamTallying = "tallying"
@@ -81,3 +84,17 @@ func NewQueryProposalsParams(status ProposalStatus, limit uint64, voter, deposit
 		Limit:          limit,
 	}
 }
+
+// Params for queries:
+// - 'custom/gov/vote_delegations'
+// - 'custom/gov/vote_delegators'
+type QueryVoteDelegationsParams struct {
+	Address sdk.AccAddress
+}
+
+// creates a new instance of QueryVoteDelegationsParams
+func NewQueryVoteDelegationsParams(address sdk.AccAddress) QueryVoteDelegationsParams {
+	return QueryVoteDelegationsParams{
+		Address: address,
+	}
+}
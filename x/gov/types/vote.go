@@ -3,24 +3,45 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Vote
 type Vote struct {
-	ProposalID uint64         `json:"proposal_id"` //  proposalID of the proposal
-	Voter      sdk.AccAddress `json:"voter"`       //  address of the voter
-	Option     VoteOption     `json:"option"`      //  option from OptionSet chosen by the voter
+	ProposalID uint64              `json:"proposal_id"` //  proposalID of the proposal
+	Voter      sdk.AccAddress      `json:"voter"`       //  address of the voter
+	Option     VoteOption          `json:"option"`      //  option chosen by the voter, empty for split votes
+	Options    WeightedVoteOptions `json:"options"`     //  weighted options chosen by the voter
 }
 
-// NewVote creates a new Vote instance
+// NewVote creates a new Vote instance for a single option
 func NewVote(proposalID uint64, voter sdk.AccAddress, option VoteOption) Vote {
-	return Vote{proposalID, voter, option}
+	return NewWeightedVote(proposalID, voter, NewNonSplitVoteOption(option))
+}
+
+// NewWeightedVote creates a new Vote instance splitting the voting power
+// between the given options
+func NewWeightedVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
+	vote := Vote{ProposalID: proposalID, Voter: voter, Option: OptionEmpty, Options: options}
+	if len(options) == 1 && options[0].Weight.Equal(sdk.OneDec()) {
+		vote.Option = options[0].Option
+	}
+	return vote
+}
+
+// GetOptions returns the weighted options of the vote. Votes without weighted
+// options count fully towards their single option.
+func (v Vote) GetOptions() WeightedVoteOptions {
+	if len(v.Options) == 0 && v.Option != OptionEmpty {
+		return NewNonSplitVoteOption(v.Option)
+	}
+	return v.Options
 }
 
 func (v Vote) String() string {
-	return fmt.Sprintf("voter %s voted with option %s on proposal %d", v.Voter, v.Option, v.ProposalID)
+	return fmt.Sprintf("voter %s voted with options %s on proposal %d", v.Voter, v.GetOptions(), v.ProposalID)
 }
 
 // Votes is a collection of Vote objects
@@ -32,7 +53,7 @@ func (v Votes) String() string {
 	}
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalID)
 	for _, vot := range v {
-		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
+		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.GetOptions())
 	}
 	return out
 }
@@ -41,7 +62,8 @@ func (v Votes) String() string {
 func (v Vote) Equals(comp Vote) bool {
 	return v.Voter.Equals(comp.Voter) &&
 		v.ProposalID == comp.ProposalID &&
-		v.Option == comp.Option
+		v.Option == comp.Option &&
+		v.GetOptions().Equals(comp.GetOptions())
 }
 
 // Empty returns whether a vote is empty.
@@ -49,6 +71,75 @@ func (v Vote) Empty() bool {
 	return v.Equals(Vote{})
 }
 
+// WeightedVoteOption defines a vote option with the share of the voting power
+// it receives
+type WeightedVoteOption struct {
+	Option VoteOption `json:"option"`
+	Weight sdk.Dec    `json:"weight"`
+}
+
+// NewWeightedVoteOption creates a new WeightedVoteOption instance
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{Option: option, Weight: weight}
+}
+
+func (o WeightedVoteOption) String() string {
+	return fmt.Sprintf("%s=%s", o.Option, o.Weight)
+}
+
+// WeightedVoteOptions is a collection of WeightedVoteOption objects
+type WeightedVoteOptions []WeightedVoteOption
+
+// NewNonSplitVoteOption creates weighted options giving all of the voting
+// power to a single option
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{NewWeightedVoteOption(option, sdk.OneDec())}
+}
+
+func (options WeightedVoteOptions) String() string {
+	out := make([]string, len(options))
+	for i, option := range options {
+		out[i] = option.String()
+	}
+	return strings.Join(out, ",")
+}
+
+// Equals returns whether two collections of weighted options are equal.
+func (options WeightedVoteOptions) Equals(comp WeightedVoteOptions) bool {
+	if len(options) != len(comp) {
+		return false
+	}
+	for i, option := range options {
+		if option.Option != comp[i].Option || !option.Weight.Equal(comp[i].Weight) {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidWeightedVoteOptions returns true if the options are valid and distinct,
+// their weights are positive and they sum up to 1, and false otherwise.
+func ValidWeightedVoteOptions(options WeightedVoteOptions) bool {
+	if len(options) == 0 {
+		return false
+	}
+
+	totalWeight := sdk.ZeroDec()
+	usedOptions := make(map[VoteOption]bool)
+	for _, option := range options {
+		if !ValidVoteOption(option.Option) || usedOptions[option.Option] {
+			return false
+		}
+		if option.Weight.IsNil() || !option.Weight.IsPositive() || option.Weight.GT(sdk.OneDec()) {
+			return false
+		}
+		usedOptions[option.Option] = true
+		totalWeight = totalWeight.Add(option.Weight)
+	}
+
+	return totalWeight.Equal(sdk.OneDec())
+}
+
 // VoteOption defines a vote option
 type VoteOption byte
 
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxVoteDelegationDepth is the maximum number of delegates followed when
+// resolving the vote of an account that did not vote itself
+const MaxVoteDelegationDepth = 5
+
+// VoteDelegation designates the delegate whose vote is counted with the
+// delegator's staked power on proposals the delegator does not vote on
+type VoteDelegation struct {
+	Delegator    sdk.AccAddress `json:"delegator"`     //  address of the delegator
+	Delegate     sdk.AccAddress `json:"delegate"`      //  address of the delegate
+	ProposalType string         `json:"proposal_type"` //  proposal type the delegation applies to, empty for all types
+}
+
+// NewVoteDelegation creates a new VoteDelegation instance
+func NewVoteDelegation(delegator, delegate sdk.AccAddress, proposalType string) VoteDelegation {
+	return VoteDelegation{delegator, delegate, proposalType}
+}
+
+func (vd VoteDelegation) String() string {
+	proposalType := vd.ProposalType
+	if proposalType == "" {
+		proposalType = "all"
+	}
+	return fmt.Sprintf("%s delegates its vote on %s proposals to %s", vd.Delegator, proposalType, vd.Delegate)
+}
+
+// Validate performs a stateless validation of the vote delegation
+func (vd VoteDelegation) Validate() sdk.Error {
+	if vd.Delegator.Empty() {
+		return sdk.ErrInvalidAddress(vd.Delegator.String())
+	}
+	if vd.Delegate.Empty() {
+		return sdk.ErrInvalidAddress(vd.Delegate.String())
+	}
+	if vd.Delegator.Equals(vd.Delegate) {
+		return ErrInvalidVoteDelegation(DefaultCodespace, "an account cannot delegate its vote to itself")
+	}
+	if vd.ProposalType != "" && !IsValidProposalType(vd.ProposalType) {
+		return ErrInvalidProposalType(DefaultCodespace, vd.ProposalType)
+	}
+	return nil
+}
+
+// VoteDelegations is a collection of VoteDelegation objects
+type VoteDelegations []VoteDelegation
+
+func (v VoteDelegations) String() string {
+	if len(v) == 0 {
+		return "[]"
+	}
+	out := "Vote Delegations:"
+	for _, vd := range v {
+		out += fmt.Sprintf("\n  %s", vd)
+	}
+	return out
+}
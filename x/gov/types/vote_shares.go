@@ -0,0 +1,105 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidatorVoteShares accumulates the delegator shares of a validator held by
+// accounts that voted on a proposal, directly or through their governance
+// delegate. It is kept up to date while the proposal is in its voting period
+// so that the tally does not need to iterate over the votes.
+type ValidatorVoteShares struct {
+	ProposalID  uint64         `json:"proposal_id"`  //  proposalID of the proposal
+	Validator   sdk.ValAddress `json:"validator"`    //  address of the validator
+	VotedShares sdk.Dec        `json:"voted_shares"` //  delegator shares held by accounts that voted
+	Yes         sdk.Dec        `json:"yes"`          //  shares voted per option, split by the vote weights
+	Abstain     sdk.Dec        `json:"abstain"`
+	No          sdk.Dec        `json:"no"`
+	NoWithVeto  sdk.Dec        `json:"no_with_veto"`
+}
+
+// NewValidatorVoteShares creates a new ValidatorVoteShares instance holding no shares
+func NewValidatorVoteShares(proposalID uint64, validator sdk.ValAddress) ValidatorVoteShares {
+	return ValidatorVoteShares{
+		ProposalID:  proposalID,
+		Validator:   validator,
+		VotedShares: sdk.ZeroDec(),
+		Yes:         sdk.ZeroDec(),
+		Abstain:     sdk.ZeroDec(),
+		No:          sdk.ZeroDec(),
+		NoWithVeto:  sdk.ZeroDec(),
+	}
+}
+
+func (vs ValidatorVoteShares) String() string {
+	return fmt.Sprintf(`Validator %s vote shares on proposal %d:
+  Voted:        %s
+  Yes:          %s
+  Abstain:      %s
+  No:           %s
+  NoWithVeto:   %s`, vs.Validator, vs.ProposalID, vs.VotedShares, vs.Yes, vs.Abstain, vs.No, vs.NoWithVeto)
+}
+
+// OptionShares returns the shares voted on an option
+func (vs ValidatorVoteShares) OptionShares(option VoteOption) sdk.Dec {
+	switch option {
+	case OptionYes:
+		return vs.Yes
+	case OptionAbstain:
+		return vs.Abstain
+	case OptionNo:
+		return vs.No
+	case OptionNoWithVeto:
+		return vs.NoWithVeto
+	default:
+		return sdk.ZeroDec()
+	}
+}
+
+// AddVote adds shares voted with the given options
+func (vs ValidatorVoteShares) AddVote(shares sdk.Dec, options WeightedVoteOptions) ValidatorVoteShares {
+	vs.VotedShares = vs.VotedShares.Add(shares)
+	for _, option := range options {
+		vs = vs.addOptionShares(option.Option, shares.Mul(option.Weight))
+	}
+	return vs
+}
+
+// SubVote removes shares previously added with the same options. The shares of
+// each option are computed as when they were added, so the removal is exact.
+func (vs ValidatorVoteShares) SubVote(shares sdk.Dec, options WeightedVoteOptions) ValidatorVoteShares {
+	vs.VotedShares = vs.VotedShares.Sub(shares)
+	for _, option := range options {
+		vs = vs.addOptionShares(option.Option, shares.Mul(option.Weight).Neg())
+	}
+	return vs
+}
+
+// IsEmpty returns true if no voted shares are held
+func (vs ValidatorVoteShares) IsEmpty() bool {
+	return vs.VotedShares.IsZero() && vs.Yes.IsZero() && vs.Abstain.IsZero() &&
+		vs.No.IsZero() && vs.NoWithVeto.IsZero()
+}
+
+// Equal returns true if both hold the same shares for the same proposal and validator
+func (vs ValidatorVoteShares) Equal(other ValidatorVoteShares) bool {
+	return vs.ProposalID == other.ProposalID && vs.Validator.Equals(other.Validator) &&
+		vs.VotedShares.Equal(other.VotedShares) && vs.Yes.Equal(other.Yes) &&
+		vs.Abstain.Equal(other.Abstain) && vs.No.Equal(other.No) && vs.NoWithVeto.Equal(other.NoWithVeto)
+}
+
+func (vs ValidatorVoteShares) addOptionShares(option VoteOption, shares sdk.Dec) ValidatorVoteShares {
+	switch option {
+	case OptionYes:
+		vs.Yes = vs.Yes.Add(shares)
+	case OptionAbstain:
+		vs.Abstain = vs.Abstain.Add(shares)
+	case OptionNo:
+		vs.No = vs.No.Add(shares)
+	case OptionNoWithVeto:
+		vs.NoWithVeto = vs.NoWithVeto.Add(shares)
+	}
+	return vs
+}
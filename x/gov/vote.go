@@ -9,6 +9,18 @@ import (
 
 // AddVote Adds a vote on a specific proposal
 func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, option VoteOption) sdk.Error {
+	if !ValidVoteOption(option) {
+		return ErrInvalidVote(keeper.codespace, option)
+	}
+
+	return keeper.AddWeightedVote(ctx, proposalID, voterAddr, NewNonSplitVoteOption(option))
+}
+
+// AddWeightedVote Adds a vote on a specific proposal splitting the voter's
+// voting power between the given options
+func (keeper Keeper) AddWeightedVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress,
+	options WeightedVoteOptions) sdk.Error {
+
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	if !ok {
 		return ErrUnknownProposal(keeper.codespace, proposalID)
@@ -17,17 +29,25 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 		return ErrInactiveProposal(keeper.codespace, proposalID)
 	}
 
-	if !ValidVoteOption(option) {
-		return ErrInvalidVote(keeper.codespace, option)
+	if !ValidWeightedVoteOptions(options) {
+		return ErrInvalidWeightedVote(keeper.codespace, options)
 	}
 
-	vote := NewVote(proposalID, voterAddr, option)
-	keeper.setVote(ctx, proposalID, voterAddr, vote)
+	vote := NewWeightedVote(proposalID, voterAddr, options)
+	keeper.updateVoteShares(ctx, Proposals{proposal}, voterAddr, func() {
+		keeper.setVote(ctx, proposalID, voterAddr, vote)
+	})
+
+	// single option votes keep the plain option as the attribute value
+	option := options.String()
+	if vote.Option != OptionEmpty {
+		option = vote.Option.String()
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeProposalVote,
-			sdk.NewAttribute(types.AttributeKeyOption, option.String()),
+			sdk.NewAttribute(types.AttributeKeyOption, option),
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
 		),
 	)
@@ -81,3 +101,43 @@ func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID uint64, voterAddr sd
 	store := ctx.KVStore(keeper.storeKey)
 	store.Delete(types.VoteKey(proposalID, voterAddr))
 }
+
+// insertVotePruningQueue schedules the votes of a proposal that is no longer
+// in its voting period to be deleted by pruneVotes
+func (keeper Keeper) insertVotePruningQueue(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.VotePruningQueueKey(proposalID), []byte{})
+}
+
+// pruneVotes deletes at most limit votes of the proposals in the vote pruning
+// queue. A proposal is removed from the queue once all of its votes are
+// deleted, so the votes of a large proposal are pruned over several blocks.
+func (keeper Keeper) pruneVotes(ctx sdk.Context, limit int) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	queueIterator := sdk.KVStorePrefixIterator(store, types.VotePruningQueuePrefix)
+	defer queueIterator.Close()
+
+	for ; queueIterator.Valid() && limit > 0; queueIterator.Next() {
+		proposalID := types.SplitVotePruningQueueKey(queueIterator.Key())
+
+		var keys [][]byte
+		votesIterator := keeper.GetVotesIterator(ctx, proposalID)
+		for ; votesIterator.Valid() && len(keys) <= limit; votesIterator.Next() {
+			keys = append(keys, votesIterator.Key())
+		}
+		votesIterator.Close()
+
+		// one key past the limit tells whether votes remain after this batch
+		if len(keys) <= limit {
+			store.Delete(queueIterator.Key())
+		} else {
+			keys = keys[:limit]
+		}
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+		limit -= len(keys)
+	}
+}
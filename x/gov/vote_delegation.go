@@ -0,0 +1,186 @@
+package gov
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// DelegateVote designates the governance delegate of an address for a
+// proposal type, or for all proposal types when the type is empty. It
+// replaces any previous delegation of the address for that type.
+func (keeper Keeper) DelegateVote(ctx sdk.Context, delegator, delegate sdk.AccAddress, proposalType string) sdk.Error {
+	voteDelegation := NewVoteDelegation(delegator, delegate, proposalType)
+	if err := voteDelegation.Validate(); err != nil {
+		return err
+	}
+
+	keeper.updateVoteShares(ctx, keeper.getVotingProposals(ctx), delegator, func() {
+		keeper.setVoteDelegation(ctx, voteDelegation)
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDelegateVote,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegate, delegate.String()),
+			sdk.NewAttribute(types.AttributeKeyProposalType, proposalType),
+		),
+	)
+
+	return nil
+}
+
+// UndelegateVote removes the governance delegate of an address for a proposal type
+func (keeper Keeper) UndelegateVote(ctx sdk.Context, delegator sdk.AccAddress, proposalType string) sdk.Error {
+	if _, found := keeper.GetVoteDelegation(ctx, delegator, proposalType); !found {
+		return ErrUnknownVoteDelegation(keeper.codespace, delegator, proposalType)
+	}
+
+	keeper.updateVoteShares(ctx, keeper.getVotingProposals(ctx), delegator, func() {
+		keeper.deleteVoteDelegation(ctx, delegator, proposalType)
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUndelegateVote,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyProposalType, proposalType),
+		),
+	)
+
+	return nil
+}
+
+// GetVoteDelegation gets the vote delegation of an address for a proposal type
+func (keeper Keeper) GetVoteDelegation(ctx sdk.Context, delegator sdk.AccAddress, proposalType string) (voteDelegation VoteDelegation, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.VoteDelegationKey(delegator, proposalType))
+	if bz == nil {
+		return voteDelegation, false
+	}
+
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &voteDelegation)
+	return voteDelegation, true
+}
+
+// GetAllVoteDelegations returns all the vote delegations from the store
+func (keeper Keeper) GetAllVoteDelegations(ctx sdk.Context) (voteDelegations VoteDelegations) {
+	keeper.IterateAllVoteDelegations(ctx, func(voteDelegation VoteDelegation) bool {
+		voteDelegations = append(voteDelegations, voteDelegation)
+		return false
+	})
+	return
+}
+
+// GetVoteDelegations returns the vote delegations from an address
+func (keeper Keeper) GetVoteDelegations(ctx sdk.Context, delegator sdk.AccAddress) (voteDelegations VoteDelegations) {
+	keeper.IterateVoteDelegations(ctx, delegator, func(voteDelegation VoteDelegation) bool {
+		voteDelegations = append(voteDelegations, voteDelegation)
+		return false
+	})
+	return
+}
+
+// GetVoteDelegators returns the vote delegations made to an address
+func (keeper Keeper) GetVoteDelegators(ctx sdk.Context, delegate sdk.AccAddress) (voteDelegations VoteDelegations) {
+	keeper.IterateVoteDelegators(ctx, delegate, func(voteDelegation VoteDelegation) bool {
+		voteDelegations = append(voteDelegations, voteDelegation)
+		return false
+	})
+	return
+}
+
+// setVoteDelegation stores a vote delegation and indexes it by its delegate
+func (keeper Keeper) setVoteDelegation(ctx sdk.Context, voteDelegation VoteDelegation) {
+	keeper.deleteVoteDelegation(ctx, voteDelegation.Delegator, voteDelegation.ProposalType)
+
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(voteDelegation)
+	store.Set(types.VoteDelegationKey(voteDelegation.Delegator, voteDelegation.ProposalType), bz)
+	store.Set(types.VoteDelegatorKey(voteDelegation.Delegate, voteDelegation.Delegator, voteDelegation.ProposalType), []byte{})
+}
+
+func (keeper Keeper) deleteVoteDelegation(ctx sdk.Context, delegator sdk.AccAddress, proposalType string) {
+	voteDelegation, found := keeper.GetVoteDelegation(ctx, delegator, proposalType)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.VoteDelegationKey(delegator, proposalType))
+	store.Delete(types.VoteDelegatorKey(voteDelegation.Delegate, delegator, proposalType))
+}
+
+// getVoteDelegate returns the delegate of an address on proposals of the
+// given type. A delegation for the type takes precedence over a delegation
+// for all types.
+func (keeper Keeper) getVoteDelegate(ctx sdk.Context, delegator sdk.AccAddress, proposalType string) (sdk.AccAddress, bool) {
+	if voteDelegation, found := keeper.GetVoteDelegation(ctx, delegator, proposalType); found {
+		return voteDelegation.Delegate, true
+	}
+	if voteDelegation, found := keeper.GetVoteDelegation(ctx, delegator, ""); found {
+		return voteDelegation.Delegate, true
+	}
+	return nil, false
+}
+
+// resolveDelegatedVote follows the chain of delegates of an address that did
+// not vote until it reaches a delegate that voted. Chains longer than
+// MaxVoteDelegationDepth or that loop back on an address already visited
+// resolve to no vote.
+func (keeper Keeper) resolveDelegatedVote(ctx sdk.Context, proposal Proposal, delegator sdk.AccAddress) (WeightedVoteOptions, bool) {
+	proposalType := proposal.ProposalType()
+	visited := map[string]bool{delegator.String(): true}
+	current := delegator
+	for depth := 0; depth < MaxVoteDelegationDepth; depth++ {
+		delegate, found := keeper.getVoteDelegate(ctx, current, proposalType)
+		if !found || visited[delegate.String()] {
+			return nil, false
+		}
+
+		if vote, voted := keeper.GetVote(ctx, proposal.ProposalID, delegate); voted {
+			return vote.GetOptions(), true
+		}
+
+		visited[delegate.String()] = true
+		current = delegate
+	}
+
+	return nil, false
+}
+
+// getEffectiveVote returns the vote an account casts on a proposal: its own
+// vote or, if it did not vote, the vote of its governance delegate
+func (keeper Keeper) getEffectiveVote(ctx sdk.Context, proposal Proposal, voter sdk.AccAddress) (WeightedVoteOptions, bool) {
+	if vote, voted := keeper.GetVote(ctx, proposal.ProposalID, voter); voted {
+		return vote.GetOptions(), true
+	}
+	return keeper.resolveDelegatedVote(ctx, proposal, voter)
+}
+
+// getVoteDelegatorTree returns an address and the addresses delegating their
+// vote to it, directly or through a chain of at most MaxVoteDelegationDepth
+// delegates, whatever the proposal type of the delegations.
+func (keeper Keeper) getVoteDelegatorTree(ctx sdk.Context, delegate sdk.AccAddress) []sdk.AccAddress {
+	tree := []sdk.AccAddress{delegate}
+	visited := map[string]bool{delegate.String(): true}
+
+	level := tree
+	for depth := 0; depth < MaxVoteDelegationDepth && len(level) > 0; depth++ {
+		var next []sdk.AccAddress
+		for _, addr := range level {
+			keeper.IterateVoteDelegators(ctx, addr, func(voteDelegation VoteDelegation) bool {
+				if !visited[voteDelegation.Delegator.String()] {
+					visited[voteDelegation.Delegator.String()] = true
+					next = append(next, voteDelegation.Delegator)
+				}
+				return false
+			})
+		}
+
+		tree = append(tree, next...)
+		level = next
+	}
+
+	return tree
+}
@@ -0,0 +1,181 @@
+package gov
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func TestVoteDelegations(t *testing.T) {
+	input := getMockApp(t, 3, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	// an account cannot delegate its vote to itself or for an unknown proposal type
+	err := input.keeper.DelegateVote(ctx, input.addrs[0], input.addrs[0], "")
+	require.Error(t, err)
+	require.Equal(t, CodeInvalidVoteDelegation, err.Code())
+	err = input.keeper.DelegateVote(ctx, input.addrs[0], input.addrs[1], "unknown")
+	require.Error(t, err)
+	require.Equal(t, CodeInvalidProposalType, err.Code())
+
+	require.Nil(t, input.keeper.DelegateVote(ctx, input.addrs[0], input.addrs[1], ""))
+	require.Nil(t, input.keeper.DelegateVote(ctx, input.addrs[0], input.addrs[2], ProposalTypeText))
+	require.Nil(t, input.keeper.DelegateVote(ctx, input.addrs[2], input.addrs[1], ""))
+
+	voteDelegation, found := input.keeper.GetVoteDelegation(ctx, input.addrs[0], ProposalTypeText)
+	require.True(t, found)
+	require.Equal(t, NewVoteDelegation(input.addrs[0], input.addrs[2], ProposalTypeText), voteDelegation)
+
+	require.Len(t, input.keeper.GetVoteDelegations(ctx, input.addrs[0]), 2)
+	require.Len(t, input.keeper.GetVoteDelegators(ctx, input.addrs[1]), 2)
+	require.Len(t, input.keeper.GetVoteDelegators(ctx, input.addrs[2]), 1)
+	require.Len(t, input.keeper.GetAllVoteDelegations(ctx), 3)
+
+	// a new delegation for the same type replaces the previous one
+	require.Nil(t, input.keeper.DelegateVote(ctx, input.addrs[0], input.addrs[1], ProposalTypeText))
+	require.Len(t, input.keeper.GetVoteDelegations(ctx, input.addrs[0]), 2)
+	require.Empty(t, input.keeper.GetVoteDelegators(ctx, input.addrs[2]))
+
+	require.Nil(t, input.keeper.UndelegateVote(ctx, input.addrs[0], ""))
+	_, found = input.keeper.GetVoteDelegation(ctx, input.addrs[0], "")
+	require.False(t, found)
+	require.Len(t, input.keeper.GetVoteDelegations(ctx, input.addrs[0]), 1)
+
+	err = input.keeper.UndelegateVote(ctx, input.addrs[0], "")
+	require.Error(t, err)
+	require.Equal(t, CodeUnknownVoteDelegation, err.Code())
+}
+
+// setupVoteDelegationTally creates two validators of 5 bonded tokens each and
+// a delegator, addrs[2], bonding 15 tokens to the first validator. It returns
+// a proposal in its voting period on which both validators voted yes.
+func setupVoteDelegationTally(t *testing.T, input testInput, ctx sdk.Context) Proposal {
+	stakingHandler := staking.NewHandler(input.sk)
+
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(input.addrs[0]), sdk.ValAddress(input.addrs[1])}
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{5, 5})
+
+	delTokens := sdk.TokensFromConsensusPower(15)
+	res := stakingHandler(ctx, staking.NewMsgDelegate(input.addrs[2], valAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, delTokens)))
+	require.True(t, res.IsOK())
+	staking.EndBlocker(ctx, input.sk)
+
+	proposal, err := input.keeper.SubmitProposal(ctx, testProposal())
+	require.NoError(t, err)
+	proposal.Status = StatusVotingPeriod
+	input.keeper.SetProposal(ctx, proposal)
+
+	require.Nil(t, input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], OptionYes))
+	require.Nil(t, input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[1], OptionYes))
+	return proposal
+}
+
+func TestTallyVoteDelegation(t *testing.T) {
+	input := getMockApp(t, 10, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+	proposal := setupVoteDelegationTally(t, input, ctx)
+
+	// the delegate has no stake of its own, its vote is counted with the
+	// delegator's stake instead of the validator's vote
+	require.Nil(t, input.keeper.DelegateVote(ctx, input.addrs[2], input.addrs[3], ""))
+	require.Nil(t, input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[3], OptionNo))
+
+	passes, burnDeposits, tallyResults := tally(ctx, input.keeper, proposal)
+	require.False(t, passes)
+	require.False(t, burnDeposits)
+	ten, fifteen := sdk.TokensFromConsensusPower(10), sdk.TokensFromConsensusPower(15)
+	require.True(t, tallyResults.Equals(NewTallyResult(ten, sdk.ZeroInt(), fifteen, sdk.ZeroInt())))
+
+	// the delegator's own vote overrides the delegate's
+	require.Nil(t, input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[2], OptionYes))
+
+	passes, _, tallyResults = tally(ctx, input.keeper, proposal)
+	require.True(t, passes)
+	require.True(t, tallyResults.Equals(NewTallyResult(ten.Add(fifteen), sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt())))
+}
+
+func TestTallyVoteDelegationProposalType(t *testing.T) {
+	input := getMockApp(t, 10, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+	proposal := setupVoteDelegationTally(t, input, ctx)
+
+	// the delegation for text proposals takes precedence and is followed
+	// through the chain of delegates
+	require.Nil(t, input.keeper.DelegateVote(ctx, input.addrs[2], input.addrs[3], ""))
+	require.Nil(t, input.keeper.DelegateVote(ctx, input.addrs[2], input.addrs[4], ProposalTypeText))
+	require.Nil(t, input.keeper.DelegateVote(ctx, input.addrs[4], input.addrs[5], ""))
+	require.Nil(t, input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[3], OptionYes))
+	require.Nil(t, input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[5], OptionNoWithVeto))
+
+	passes, burnDeposits, tallyResults := tally(ctx, input.keeper, proposal)
+	require.False(t, passes)
+	require.True(t, burnDeposits)
+	ten, fifteen := sdk.TokensFromConsensusPower(10), sdk.TokensFromConsensusPower(15)
+	require.True(t, tallyResults.Equals(NewTallyResult(ten, sdk.ZeroInt(), sdk.ZeroInt(), fifteen)))
+}
+
+func TestTallyVoteDelegationCycle(t *testing.T) {
+	input := getMockApp(t, 10, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+	proposal := setupVoteDelegationTally(t, input, ctx)
+
+	// the delegates never vote, so the delegator inherits the validator's vote
+	require.Nil(t, input.keeper.DelegateVote(ctx, input.addrs[2], input.addrs[3], ""))
+	require.Nil(t, input.keeper.DelegateVote(ctx, input.addrs[3], input.addrs[4], ""))
+	require.Nil(t, input.keeper.DelegateVote(ctx, input.addrs[4], input.addrs[2], ""))
+
+	passes, _, tallyResults := tally(ctx, input.keeper, proposal)
+	require.True(t, passes)
+	require.True(t, tallyResults.Equals(NewTallyResult(sdk.TokensFromConsensusPower(25), sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt())))
+}
+
+func TestTallyVoteDelegationDepthLimit(t *testing.T) {
+	input := getMockApp(t, 10, GenesisState{}, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+	proposal := setupVoteDelegationTally(t, input, ctx)
+
+	// addrs[2] -> addrs[3] -> ... -> addrs[8]
+	for i := 2; i < 2+MaxVoteDelegationDepth+1; i++ {
+		require.Nil(t, input.keeper.DelegateVote(ctx, input.addrs[i], input.addrs[i+1], ""))
+	}
+
+	// the voting delegate is one hop too far
+	require.Nil(t, input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[2+MaxVoteDelegationDepth+1], OptionNo))
+
+	passes, _, tallyResults := tally(ctx, input.keeper, proposal)
+	require.True(t, passes)
+	ten, fifteen := sdk.TokensFromConsensusPower(10), sdk.TokensFromConsensusPower(15)
+	require.True(t, tallyResults.Equals(NewTallyResult(ten.Add(fifteen), sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt())))
+
+	// the last delegate within the limit votes
+	require.Nil(t, input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[2+MaxVoteDelegationDepth], OptionNo))
+
+	passes, _, tallyResults = tally(ctx, input.keeper, proposal)
+	require.False(t, passes)
+	require.True(t, tallyResults.Equals(NewTallyResult(ten, sdk.ZeroInt(), fifteen, sdk.ZeroInt())))
+}
@@ -0,0 +1,188 @@
+package gov
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/exported"
+)
+
+// The tally of a proposal weighs each vote with the delegator shares of the
+// voter. Instead of iterating over the votes and the delegations of every
+// voter once the voting period ends, the shares held by accounts that voted
+// are accumulated per validator while the proposal is in its voting period:
+// when a vote is cast, when a vote delegation changes and, through the staking
+// hooks, when a delegation changes.
+
+// GetValidatorVoteShares gets the vote shares of a validator on a proposal
+func (keeper Keeper) GetValidatorVoteShares(ctx sdk.Context, proposalID uint64, valAddr sdk.ValAddress) ValidatorVoteShares {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.ValidatorVoteShareKey(proposalID, valAddr))
+	if bz == nil {
+		return NewValidatorVoteShares(proposalID, valAddr)
+	}
+
+	var voteShares ValidatorVoteShares
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &voteShares)
+	return voteShares
+}
+
+// GetAllValidatorVoteShares returns the vote shares of every validator on a proposal
+func (keeper Keeper) GetAllValidatorVoteShares(ctx sdk.Context, proposalID uint64) (voteShares []ValidatorVoteShares) {
+	keeper.IterateValidatorVoteShares(ctx, proposalID, func(vs ValidatorVoteShares) bool {
+		voteShares = append(voteShares, vs)
+		return false
+	})
+	return
+}
+
+// setValidatorVoteShares stores the vote shares of a validator, empty vote
+// shares are removed from the store
+func (keeper Keeper) setValidatorVoteShares(ctx sdk.Context, voteShares ValidatorVoteShares) {
+	store := ctx.KVStore(keeper.storeKey)
+	key := types.ValidatorVoteShareKey(voteShares.ProposalID, voteShares.Validator)
+	if voteShares.IsEmpty() {
+		store.Delete(key)
+		return
+	}
+
+	store.Set(key, keeper.cdc.MustMarshalBinaryLengthPrefixed(voteShares))
+}
+
+// deleteValidatorVoteShares deletes the vote shares of all validators on a proposal
+func (keeper Keeper) deleteValidatorVoteShares(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	for _, voteShares := range keeper.GetAllValidatorVoteShares(ctx, proposalID) {
+		store.Delete(types.ValidatorVoteShareKey(proposalID, voteShares.Validator))
+	}
+}
+
+// getVotingProposals returns the proposals in their voting period
+func (keeper Keeper) getVotingProposals(ctx sdk.Context) (proposals Proposals) {
+	keeper.IterateVotingProposals(ctx, func(proposal Proposal) bool {
+		proposals = append(proposals, proposal)
+		return false
+	})
+	return
+}
+
+// addDelegationVoteShares adds or removes the shares of a delegation voted
+// with the given options
+func (keeper Keeper) addDelegationVoteShares(ctx sdk.Context, proposalID uint64, valAddr sdk.ValAddress,
+	shares sdk.Dec, options WeightedVoteOptions, add bool) {
+
+	voteShares := keeper.GetValidatorVoteShares(ctx, proposalID, valAddr)
+	if add {
+		voteShares = voteShares.AddVote(shares, options)
+	} else {
+		voteShares = voteShares.SubVote(shares, options)
+	}
+	keeper.setValidatorVoteShares(ctx, voteShares)
+}
+
+// addAccountVoteShares adds or removes the shares of all the delegations of an
+// account with the vote it casts on a proposal, if any
+func (keeper Keeper) addAccountVoteShares(ctx sdk.Context, proposal Proposal, account sdk.AccAddress, add bool) {
+	options, ok := keeper.getEffectiveVote(ctx, proposal, account)
+	if !ok {
+		return
+	}
+
+	keeper.sk.IterateDelegations(ctx, account, func(_ int64, delegation exported.DelegationI) (stop bool) {
+		keeper.addDelegationVoteShares(ctx, proposal.ProposalID, delegation.GetValidatorAddr(), delegation.GetShares(), options, add)
+		return false
+	})
+}
+
+// updateVoteShares applies an update that may change the vote an account casts
+// on the given proposals. The vote shares of the account and of the accounts
+// delegating their vote to it are removed before the update and added back
+// with their new vote afterwards.
+func (keeper Keeper) updateVoteShares(ctx sdk.Context, proposals Proposals, account sdk.AccAddress, update func()) {
+	if len(proposals) == 0 {
+		update()
+		return
+	}
+
+	accounts := keeper.getVoteDelegatorTree(ctx, account)
+	for _, proposal := range proposals {
+		for _, addr := range accounts {
+			keeper.addAccountVoteShares(ctx, proposal, addr, false)
+		}
+	}
+
+	update()
+
+	for _, proposal := range proposals {
+		for _, addr := range accounts {
+			keeper.addAccountVoteShares(ctx, proposal, addr, true)
+		}
+	}
+}
+
+// updateDelegationVoteShares adds or removes the shares of a delegation on all
+// the proposals its delegator votes on
+func (keeper Keeper) updateDelegationVoteShares(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, add bool) {
+	delegation := keeper.sk.Delegation(ctx, delAddr, valAddr)
+	if delegation == nil {
+		return
+	}
+
+	for _, proposal := range keeper.getVotingProposals(ctx) {
+		if options, ok := keeper.getEffectiveVote(ctx, proposal, delAddr); ok {
+			keeper.addDelegationVoteShares(ctx, proposal.ProposalID, valAddr, delegation.GetShares(), options, add)
+		}
+	}
+}
+
+// computeValidatorVoteShares computes the vote shares of a proposal from its
+// votes and the vote delegations, without relying on the accumulated values
+func (keeper Keeper) computeValidatorVoteShares(ctx sdk.Context, proposal Proposal) map[string]ValidatorVoteShares {
+	voteShares := make(map[string]ValidatorVoteShares)
+
+	addVote := func(voter sdk.AccAddress, options WeightedVoteOptions) {
+		keeper.sk.IterateDelegations(ctx, voter, func(_ int64, delegation exported.DelegationI) (stop bool) {
+			valAddr := delegation.GetValidatorAddr()
+			vs, ok := voteShares[valAddr.String()]
+			if !ok {
+				vs = NewValidatorVoteShares(proposal.ProposalID, valAddr)
+			}
+			voteShares[valAddr.String()] = vs.AddVote(delegation.GetShares(), options)
+			return false
+		})
+	}
+
+	voted := make(map[string]bool)
+	keeper.IterateVotes(ctx, proposal.ProposalID, func(vote types.Vote) bool {
+		voted[vote.Voter.String()] = true
+		addVote(vote.Voter, vote.GetOptions())
+		return false
+	})
+
+	keeper.IterateAllVoteDelegations(ctx, func(voteDelegation types.VoteDelegation) bool {
+		delegator := voteDelegation.Delegator.String()
+		if voted[delegator] {
+			return false
+		}
+		voted[delegator] = true
+
+		if options, ok := keeper.resolveDelegatedVote(ctx, proposal, voteDelegation.Delegator); ok {
+			addVote(voteDelegation.Delegator, options)
+		}
+		return false
+	})
+
+	for key, vs := range voteShares {
+		if vs.IsEmpty() {
+			delete(voteShares, key)
+		}
+	}
+	return voteShares
+}
+
+// initValidatorVoteShares stores the vote shares of a proposal computed from
+// its votes, used when the votes are imported from genesis
+func (keeper Keeper) initValidatorVoteShares(ctx sdk.Context, proposal Proposal) {
+	for _, vs := range keeper.computeValidatorVoteShares(ctx, proposal) {
+		keeper.setValidatorVoteShares(ctx, vs)
+	}
+}
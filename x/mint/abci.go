@@ -14,8 +14,7 @@ func BeginBlocker(ctx sdk.Context, k Keeper) {
 	// recalculate inflation rate
 	totalStakingSupply := k.StakingTokenSupply(ctx)
 	bondedRatio := k.BondedRatio(ctx)
-	minter.Inflation = minter.NextInflationRate(params, bondedRatio)
-	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
+	minter = k.NextMinter(ctx, minter, params, bondedRatio, totalStakingSupply)
 	k.SetMinter(ctx, minter)
 
 	// mint coins, update supply
@@ -27,8 +26,8 @@ func BeginBlocker(ctx sdk.Context, k Keeper) {
 		panic(err)
 	}
 
-	// send the minted coins to the fee collector account
-	err = k.AddCollectedFees(ctx, mintedCoins)
+	// send the minted coins to the destinations, by default the fee collector
+	err = k.DistributeMintedCoins(ctx, mintedCoins)
 	if err != nil {
 		panic(err)
 	}
@@ -11,27 +11,45 @@ import (
 )
 
 const (
-	ModuleName            = types.ModuleName
-	DefaultParamspace     = types.DefaultParamspace
-	StoreKey              = types.StoreKey
-	QuerierRoute          = types.QuerierRoute
-	QueryParameters       = types.QueryParameters
-	QueryInflation        = types.QueryInflation
-	QueryAnnualProvisions = types.QueryAnnualProvisions
+	ModuleName                   = types.ModuleName
+	DefaultParamspace            = types.DefaultParamspace
+	StoreKey                     = types.StoreKey
+	QuerierRoute                 = types.QuerierRoute
+	QueryParameters              = types.QueryParameters
+	QueryInflation               = types.QueryInflation
+	QueryAnnualProvisions        = types.QueryAnnualProvisions
+	EventTypeMint                = types.EventTypeMint
+	EventTypeMintDistribution    = types.EventTypeMintDistribution
+	AttributeKeyBondedRatio      = types.AttributeKeyBondedRatio
+	AttributeKeyInflation        = types.AttributeKeyInflation
+	AttributeKeyAnnualProvisions = types.AttributeKeyAnnualProvisions
+	AttributeKeyAmount           = types.AttributeKeyAmount
+	AttributeKeyRecipient        = types.AttributeKeyRecipient
 )
 
 var (
 	// functions aliases
-	NewKeeper            = keeper.NewKeeper
-	NewQuerier           = keeper.NewQuerier
-	NewMinter            = types.NewMinter
-	InitialMinter        = types.InitialMinter
-	DefaultInitialMinter = types.DefaultInitialMinter
-	ValidateMinter       = types.ValidateMinter
-	ParamKeyTable        = types.ParamKeyTable
-	NewParams            = types.NewParams
-	DefaultParams        = types.DefaultParams
-	ValidateParams       = types.ValidateParams
+	NewKeeper                     = keeper.NewKeeper
+	NewQuerier                    = keeper.NewQuerier
+	RegisterInvariants            = keeper.RegisterInvariants
+	AllInvariants                 = keeper.AllInvariants
+	NonNegativeMinterInvariant    = keeper.NonNegativeMinterInvariant
+	ModuleAccountInvariant        = keeper.ModuleAccountInvariant
+	DefaultInflationCalculationFn = types.DefaultInflationCalculationFn
+	DefaultAnnualProvisionsFn     = types.DefaultAnnualProvisionsFn
+	HalvingInflationCalculationFn = types.HalvingInflationCalculationFn
+	HalvingAnnualProvisionsFn     = types.HalvingAnnualProvisionsFn
+	NewAddressDestination         = types.NewAddressDestination
+	NewModuleDestination          = types.NewModuleDestination
+	NewCommunityPoolDestination   = types.NewCommunityPoolDestination
+	NewMinter                     = types.NewMinter
+	InitialMinter                 = types.InitialMinter
+	DefaultInitialMinter          = types.DefaultInitialMinter
+	ValidateMinter                = types.ValidateMinter
+	ParamKeyTable                 = types.ParamKeyTable
+	NewParams                     = types.NewParams
+	DefaultParams                 = types.DefaultParams
+	ValidateParams                = types.ValidateParams
 
 	// variable aliases
 	ModuleCdc              = types.ModuleCdc
@@ -42,10 +60,16 @@ var (
 	KeyInflationMin        = types.KeyInflationMin
 	KeyGoalBonded          = types.KeyGoalBonded
 	KeyBlocksPerYear       = types.KeyBlocksPerYear
+	KeyBlocksPerHalving    = types.KeyBlocksPerHalving
+	KeyDestinations        = types.KeyDestinations
 )
 
 type (
-	Keeper = keeper.Keeper
-	Minter = types.Minter
-	Params = types.Params
+	Keeper                 = keeper.Keeper
+	InflationCalculationFn = types.InflationCalculationFn
+	AnnualProvisionsFn     = types.AnnualProvisionsFn
+	Destination            = types.Destination
+	Destinations           = types.Destinations
+	Minter                 = types.Minter
+	Params                 = types.Params
 )
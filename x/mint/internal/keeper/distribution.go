@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/internal/types"
+)
+
+// DistributeMintedCoins sends the coins minted by the mint module account to
+// the destinations of the minting params, according to their weights. The
+// rounding dust, the shares of destinations that cannot receive coins and all
+// the coins if no destination is set are sent to the fee collector.
+func (k Keeper) DistributeMintedCoins(ctx sdk.Context, coins sdk.Coins) sdk.Error {
+	remaining := coins
+	for _, destination := range k.GetParams(ctx).Destinations {
+		var share sdk.Coins
+		for _, coin := range coins {
+			amount := coin.Amount.ToDec().Mul(destination.Weight).TruncateInt()
+			if amount.IsPositive() {
+				share = append(share, sdk.NewCoin(coin.Denom, amount))
+			}
+		}
+		if share.IsZero() {
+			continue
+		}
+
+		sent, err := k.sendToDestination(ctx, destination, share)
+		if err != nil {
+			return err
+		}
+		if !sent {
+			continue
+		}
+
+		remaining = remaining.Sub(share)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMintDistribution,
+				sdk.NewAttribute(types.AttributeKeyRecipient, destination.Recipient()),
+				sdk.NewAttribute(types.AttributeKeyAmount, share.String()),
+			),
+		)
+	}
+
+	if remaining.IsZero() {
+		return nil
+	}
+
+	if err := k.AddCollectedFees(ctx, remaining); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMintDistribution,
+			sdk.NewAttribute(types.AttributeKeyRecipient, k.feeCollectorName),
+			sdk.NewAttribute(types.AttributeKeyAmount, remaining.String()),
+		),
+	)
+
+	return nil
+}
+
+// sendToDestination sends coins from the mint module account to a destination.
+// It returns false if the destination cannot receive coins, as no community
+// pool keeper is set. Module account destinations are checked when the params
+// are set.
+func (k Keeper) sendToDestination(ctx sdk.Context, destination types.Destination, coins sdk.Coins) (bool, sdk.Error) {
+	switch {
+	case !destination.Address.Empty():
+		return true, k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, destination.Address, coins)
+
+	case destination.ModuleName != "":
+		return true, k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, destination.ModuleName, coins)
+
+	default:
+		if k.communityPoolKeeper == nil {
+			k.Logger(ctx).Error("no community pool keeper set to fund the community pool mint destination")
+			return false, nil
+		}
+		return true, k.communityPoolKeeper.FundCommunityPool(ctx, coins, k.supplyKeeper.GetModuleAddress(types.ModuleName))
+	}
+}
+
+// FeeCollectorShare returns the share of the minted provisions sent to the
+// fee collector, and thus distributed to the validators and delegators
+func (k Keeper) FeeCollectorShare(ctx sdk.Context) sdk.Dec {
+	destinations := k.GetParams(ctx).Destinations
+	if len(destinations) == 0 {
+		return sdk.OneDec()
+	}
+
+	for _, destination := range destinations {
+		if destination.ModuleName == k.feeCollectorName {
+			return destination.Weight
+		}
+	}
+	return sdk.ZeroDec()
+}
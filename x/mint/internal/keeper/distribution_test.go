@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/mint/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+)
+
+// mockCommunityPoolKeeper credits the community pool funds to a module account
+type mockCommunityPoolKeeper struct {
+	supplyKeeper supply.Keeper
+	moduleName   string
+}
+
+func (cpk mockCommunityPoolKeeper) FundCommunityPool(ctx sdk.Context, amount sdk.Coins, depositor sdk.AccAddress) sdk.Error {
+	return cpk.supplyKeeper.SendCoinsFromAccountToModule(ctx, depositor, cpk.moduleName, amount)
+}
+
+func setDestinations(input testInput, destinations types.Destinations) {
+	params := input.mintKeeper.GetParams(input.ctx)
+	params.Destinations = destinations
+	input.mintKeeper.SetParams(input.ctx, params)
+}
+
+func mintCoins(t *testing.T, input testInput, amount int64) sdk.Coins {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	require.NoError(t, input.mintKeeper.MintCoins(input.ctx, coins))
+	return coins
+}
+
+func moduleBalance(input testInput, moduleName string) sdk.Int {
+	return input.mintKeeper.supplyKeeper.GetModuleAccount(input.ctx, moduleName).
+		GetCoins().AmountOf(sdk.DefaultBondDenom)
+}
+
+func TestDistributeMintedCoinsToFeeCollector(t *testing.T) {
+	input := newTestInput(t)
+
+	coins := mintCoins(t, input, 1000)
+	require.NoError(t, input.mintKeeper.DistributeMintedCoins(input.ctx, coins))
+
+	require.Equal(t, sdk.NewInt(1000), moduleBalance(input, auth.FeeCollectorName))
+	require.NoError(t, AllInvariants(input.mintKeeper)(input.ctx))
+	require.Equal(t, sdk.OneDec(), input.mintKeeper.FeeCollectorShare(input.ctx))
+}
+
+func TestDistributeMintedCoinsToDestinations(t *testing.T) {
+	input := newTestInput(t)
+	input.mintKeeper.SetCommunityPoolKeeper(mockCommunityPoolKeeper{
+		input.mintKeeper.supplyKeeper.(supply.Keeper), staking.NotBondedPoolName,
+	})
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+
+	setDestinations(input, types.Destinations{
+		types.NewAddressDestination(addr, sdk.NewDecWithPrec(333, 3)),
+		types.NewModuleDestination(auth.FeeCollectorName, sdk.NewDecWithPrec(333, 3)),
+		types.NewCommunityPoolDestination(sdk.NewDecWithPrec(334, 3)),
+	})
+
+	coins := mintCoins(t, input, 1001)
+	input.ctx = input.ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, input.mintKeeper.DistributeMintedCoins(input.ctx, coins))
+
+	// 1001 * 0.333 = 333.333 and 1001 * 0.334 = 334.334, the dust goes to the fee collector
+	require.True(t, moduleBalance(input, types.ModuleName).IsZero())
+	require.Equal(t, sdk.NewInt(334), moduleBalance(input, staking.NotBondedPoolName))
+	require.Equal(t, sdk.NewInt(333+1), moduleBalance(input, auth.FeeCollectorName))
+	require.NoError(t, AllInvariants(input.mintKeeper)(input.ctx))
+
+	var recipients []string
+	for _, event := range input.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeMintDistribution {
+			recipients = append(recipients, string(event.Attributes[0].Value))
+		}
+	}
+	require.Equal(t, []string{addr.String(), auth.FeeCollectorName, "community_pool", auth.FeeCollectorName}, recipients)
+
+	require.Equal(t, sdk.NewDecWithPrec(333, 3), input.mintKeeper.FeeCollectorShare(input.ctx))
+}
+
+func TestDistributeMintedCoinsUnreachableDestinations(t *testing.T) {
+	input := newTestInput(t)
+
+	// no community pool keeper is set
+	setDestinations(input, types.Destinations{
+		types.NewModuleDestination(auth.FeeCollectorName, sdk.NewDecWithPrec(5, 1)),
+		types.NewCommunityPoolDestination(sdk.NewDecWithPrec(5, 1)),
+	})
+
+	coins := mintCoins(t, input, 1000)
+	require.NoError(t, input.mintKeeper.DistributeMintedCoins(input.ctx, coins))
+
+	require.Equal(t, sdk.NewInt(1000), moduleBalance(input, auth.FeeCollectorName))
+	require.NoError(t, AllInvariants(input.mintKeeper)(input.ctx))
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), input.mintKeeper.FeeCollectorShare(input.ctx))
+}
+
+func TestInvalidModuleDestinations(t *testing.T) {
+	input := newTestInput(t)
+
+	for _, moduleName := range []string{"unknown", types.ModuleName, staking.BondedPoolName, staking.NotBondedPoolName} {
+		destinations := types.Destinations{
+			types.NewModuleDestination(moduleName, sdk.NewDecWithPrec(5, 1)),
+			types.NewModuleDestination(auth.FeeCollectorName, sdk.NewDecWithPrec(5, 1)),
+		}
+
+		// the destinations are valid on their own
+		require.NoError(t, destinations.Validate(), moduleName)
+
+		require.Panics(t, func() { setDestinations(input, destinations) }, moduleName)
+
+		bz, err := input.cdc.MarshalJSON(destinations)
+		require.NoError(t, err)
+		require.Error(t, input.mintKeeper.paramSpace.Update(input.ctx, types.KeyDestinations, bz), moduleName)
+	}
+
+	require.Empty(t, input.mintKeeper.GetParams(input.ctx).Destinations)
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/internal/types"
+)
+
+// RegisterInvariants registers all mint invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "nonnegative-minter",
+		NonNegativeMinterInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "module-account",
+		ModuleAccountInvariant(k))
+}
+
+// AllInvariants runs all invariants of the mint module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		err := NonNegativeMinterInvariant(k)(ctx)
+		if err != nil {
+			return err
+		}
+		return ModuleAccountInvariant(k)(ctx)
+	}
+}
+
+// NonNegativeMinterInvariant checks that the inflation rate and the annual
+// provisions computed by the inflation functions are never negative
+func NonNegativeMinterInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		minter := k.GetMinter(ctx)
+
+		if minter.Inflation.IsNegative() {
+			return fmt.Errorf("negative inflation rate: %s", minter.Inflation)
+		}
+		if minter.AnnualProvisions.IsNegative() {
+			return fmt.Errorf("negative annual provisions: %s", minter.AnnualProvisions)
+		}
+
+		return nil
+	}
+}
+
+// ModuleAccountInvariant checks that the mint module account holds no coins,
+// as the minted coins are distributed in the block they are minted
+func ModuleAccountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		coins := k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins()
+		if !coins.IsZero() {
+			return fmt.Errorf("mint module account holds undistributed coins: %s", coins)
+		}
+
+		return nil
+	}
+}
@@ -13,32 +13,60 @@ import (
 
 // Keeper of the mint store
 type Keeper struct {
-	cdc              *codec.Codec
-	storeKey         sdk.StoreKey
-	paramSpace       params.Subspace
-	sk               types.StakingKeeper
-	supplyKeeper     types.SupplyKeeper
-	feeCollectorName string
+	cdc                    *codec.Codec
+	storeKey               sdk.StoreKey
+	paramSpace             params.Subspace
+	sk                     types.StakingKeeper
+	supplyKeeper           types.SupplyKeeper
+	feeCollectorName       string
+	inflationCalculationFn types.InflationCalculationFn
+	annualProvisionsFn     types.AnnualProvisionsFn
+	communityPoolKeeper    types.CommunityPoolKeeper
 }
 
-// NewKeeper creates a new mint Keeper instance
+// NewKeeper creates a new mint Keeper instance. The inflation rate and annual
+// provisions of each block are computed with the given functions, nil
+// functions default to the bonded ratio based inflation of the Cosmos Hub.
+// The minted provisions can't be sent to the module accounts of
+// blockedDestinations, whose balances are tracked by their modules.
 func NewKeeper(
 	cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace,
-	sk types.StakingKeeper, supplyKeeper types.SupplyKeeper, feeCollectorName string) Keeper {
+	sk types.StakingKeeper, supplyKeeper types.SupplyKeeper, feeCollectorName string,
+	blockedDestinations map[string]bool, inflationCalculationFn types.InflationCalculationFn,
+	annualProvisionsFn types.AnnualProvisionsFn) Keeper {
 
 	// ensure mint module account is set
 	if addr := supplyKeeper.GetModuleAddress(types.ModuleName); addr == nil {
 		panic("the mint module account has not been set")
 	}
 
+	if inflationCalculationFn == nil {
+		inflationCalculationFn = types.DefaultInflationCalculationFn
+	}
+	if annualProvisionsFn == nil {
+		annualProvisionsFn = types.DefaultAnnualProvisionsFn
+	}
+
 	return Keeper{
-		cdc:              cdc,
-		storeKey:         key,
-		paramSpace:       paramSpace.WithKeyTable(types.ParamKeyTable()),
-		sk:               sk,
-		supplyKeeper:     supplyKeeper,
-		feeCollectorName: feeCollectorName,
+		cdc:                    cdc,
+		storeKey:               key,
+		paramSpace:             paramSpace.WithKeyTable(paramKeyTable(supplyKeeper, blockedDestinations)),
+		sk:                     sk,
+		supplyKeeper:           supplyKeeper,
+		feeCollectorName:       feeCollectorName,
+		inflationCalculationFn: inflationCalculationFn,
+		annualProvisionsFn:     annualProvisionsFn,
+	}
+}
+
+// SetCommunityPoolKeeper sets the keeper funding the community pool, required
+// to send minted provisions to the community pool
+func (k *Keeper) SetCommunityPoolKeeper(cpk types.CommunityPoolKeeper) *Keeper {
+	if k.communityPoolKeeper != nil {
+		panic("cannot set community pool keeper twice")
 	}
+	k.communityPoolKeeper = cpk
+	return k
 }
 
 //______________________________________________________________________
@@ -82,6 +110,32 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 
 //______________________________________________________________________
 
+// NextMinter returns the minter of the next block: its halving schedule is
+// advanced by a block, then its inflation rate and annual provisions are
+// computed with the keeper's functions.
+func (k Keeper) NextMinter(ctx sdk.Context, minter types.Minter, params types.Params,
+	bondedRatio sdk.Dec, totalSupply sdk.Int) types.Minter {
+
+	minter = minter.NextHalvingSchedule(params)
+	minter.Inflation = k.NextInflationRate(ctx, minter, params, bondedRatio)
+	minter.AnnualProvisions = k.NextAnnualProvisions(ctx, minter, params, totalSupply)
+	return minter
+}
+
+// NextInflationRate returns the inflation rate of the next block computed with
+// the keeper's inflation calculation function.
+func (k Keeper) NextInflationRate(ctx sdk.Context, minter types.Minter, params types.Params, bondedRatio sdk.Dec) sdk.Dec {
+	return k.inflationCalculationFn(ctx, minter, params, bondedRatio)
+}
+
+// NextAnnualProvisions returns the annual provisions of the next block computed
+// with the keeper's annual provisions function.
+func (k Keeper) NextAnnualProvisions(ctx sdk.Context, minter types.Minter, params types.Params, totalSupply sdk.Int) sdk.Dec {
+	return k.annualProvisionsFn(ctx, minter, params, totalSupply)
+}
+
+//______________________________________________________________________
+
 // StakingTokenSupply implements an alias call to the underlying staking keeper's
 // StakingTokenSupply to be used in BeginBlocker.
 func (k Keeper) StakingTokenSupply(ctx sdk.Context) sdk.Int {
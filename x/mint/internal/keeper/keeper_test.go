@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/internal/types"
+)
+
+func TestDefaultInflationFns(t *testing.T) {
+	input := newTestInput(t)
+	minter := input.mintKeeper.GetMinter(input.ctx)
+	params := input.mintKeeper.GetParams(input.ctx)
+	bondedRatio := sdk.NewDecWithPrec(5, 1)
+	totalSupply := sdk.NewInt(1000000)
+
+	require.Equal(t, minter.NextInflationRate(params, bondedRatio),
+		input.mintKeeper.NextInflationRate(input.ctx, minter, params, bondedRatio))
+	require.Equal(t, minter.NextAnnualProvisions(params, totalSupply),
+		input.mintKeeper.NextAnnualProvisions(input.ctx, minter, params, totalSupply))
+}
+
+func TestCustomInflationFns(t *testing.T) {
+	fixedProvisions := func(_ sdk.Context, _ types.Minter, _ types.Params, _ sdk.Int) sdk.Dec {
+		return sdk.NewDec(5000)
+	}
+	input := newTestInputWithInflationFns(t, types.HalvingInflationCalculationFn, fixedProvisions)
+
+	params := input.mintKeeper.GetParams(input.ctx)
+	params.BlocksPerHalving = 10
+	input.mintKeeper.SetParams(input.ctx, params)
+
+	minter := input.mintKeeper.GetMinter(input.ctx)
+	minter.Halvings = 1
+
+	require.Equal(t, params.InflationMax.QuoInt64(2),
+		input.mintKeeper.NextInflationRate(input.ctx, minter, params, sdk.OneDec()))
+	require.Equal(t, sdk.NewDec(5000),
+		input.mintKeeper.NextAnnualProvisions(input.ctx, minter, params, sdk.NewInt(1000000)))
+}
+
+// mintBlocks advances the minter by the given number of blocks from the
+// height of the context and returns the annual provisions of every block
+func mintBlocks(input testInput, ctx sdk.Context, blocks int) []sdk.Dec {
+	var provisions []sdk.Dec
+	for i := 0; i < blocks; i++ {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		minter := input.mintKeeper.NextMinter(ctx, input.mintKeeper.GetMinter(ctx),
+			input.mintKeeper.GetParams(ctx), sdk.OneDec(), sdk.NewInt(1000000))
+		input.mintKeeper.SetMinter(ctx, minter)
+		provisions = append(provisions, minter.AnnualProvisions)
+	}
+	return provisions
+}
+
+func TestHalvingScheduleExportImport(t *testing.T) {
+	newInput := func() testInput {
+		input := newTestInputWithInflationFns(t, types.HalvingInflationCalculationFn, types.HalvingAnnualProvisionsFn)
+		params := input.mintKeeper.GetParams(input.ctx)
+		params.BlocksPerHalving = 10
+		params.InflationMin = sdk.NewDecWithPrec(1, 2)
+		input.mintKeeper.SetParams(input.ctx, params)
+		return input
+	}
+
+	// an uninterrupted chain halves in its 10th and 20th blocks
+	input := newInput()
+	expProvisions := mintBlocks(input, input.ctx.WithBlockHeight(100), 25)
+	initial := expProvisions[0]
+	require.Equal(t, initial, expProvisions[8])
+	require.Equal(t, initial.QuoInt64(2), expProvisions[9])
+	require.Equal(t, initial.QuoInt64(4), expProvisions[19])
+	expMinter := input.mintKeeper.GetMinter(input.ctx)
+	require.Equal(t, uint64(2), expMinter.Halvings)
+
+	// a chain exported in the middle of a halving period and restarted from
+	// height zero keeps the same schedule
+	input = newInput()
+	provisions := mintBlocks(input, input.ctx.WithBlockHeight(100), 15)
+	minter, params := input.mintKeeper.GetMinter(input.ctx), input.mintKeeper.GetParams(input.ctx)
+
+	imported := newInput()
+	imported.mintKeeper.SetMinter(imported.ctx, minter)
+	imported.mintKeeper.SetParams(imported.ctx, params)
+	provisions = append(provisions, mintBlocks(imported, imported.ctx.WithBlockHeight(0), 10)...)
+	require.Equal(t, expProvisions, provisions)
+	require.Equal(t, expMinter, imported.mintKeeper.GetMinter(imported.ctx))
+}
+
+func TestInvariants(t *testing.T) {
+	input := newTestInput(t)
+	require.NoError(t, AllInvariants(input.mintKeeper)(input.ctx))
+
+	minter := types.NewMinter(sdk.NewDecWithPrec(-1, 2), sdk.ZeroDec())
+	input.mintKeeper.SetMinter(input.ctx, minter)
+	require.Error(t, NonNegativeMinterInvariant(input.mintKeeper)(input.ctx))
+
+	minter = types.NewMinter(sdk.NewDecWithPrec(1, 2), sdk.NewDec(-1))
+	input.mintKeeper.SetMinter(input.ctx, minter)
+	require.Error(t, NonNegativeMinterInvariant(input.mintKeeper)(input.ctx))
+
+	input.mintKeeper.SetMinter(input.ctx, types.DefaultInitialMinter())
+	require.NoError(t, ModuleAccountInvariant(input.mintKeeper)(input.ctx))
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	require.NoError(t, input.mintKeeper.MintCoins(input.ctx, coins))
+	require.Error(t, ModuleAccountInvariant(input.mintKeeper)(input.ctx))
+
+	require.NoError(t, input.mintKeeper.AddCollectedFees(input.ctx, coins))
+	require.NoError(t, AllInvariants(input.mintKeeper)(input.ctx))
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/x/mint/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// paramSet are the minting parameters as registered by the keeper. Their
+// destinations can only send to module accounts the supply keeper knows and
+// that aren't blocked, which types.Params can't check on their own.
+type paramSet struct {
+	types.Params
+
+	supplyKeeper        types.SupplyKeeper
+	blockedDestinations map[string]bool
+}
+
+var _ params.ParamSetValidator = (*paramSet)(nil)
+
+// paramKeyTable returns the key table of the minting parameters checking the
+// module account destinations
+func paramKeyTable(supplyKeeper types.SupplyKeeper, blockedDestinations map[string]bool) params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&paramSet{
+		supplyKeeper:        supplyKeeper,
+		blockedDestinations: blockedDestinations,
+	})
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *paramSet) ParamSetPairs() params.ParamSetPairs {
+	pairs := p.Params.ParamSetPairs()
+	for i, pair := range pairs {
+		if bytes.Equal(pair.Key, types.KeyDestinations) {
+			pairs[i].ValidatorFn = p.validateDestinations
+		}
+	}
+	return pairs
+}
+
+func (p *paramSet) validateDestinations(i interface{}) error {
+	v, ok := i.(types.Destinations)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return err
+	}
+
+	// the parameter set instantiated to be validated as a whole has no keeper
+	if p.supplyKeeper == nil {
+		return nil
+	}
+
+	for _, destination := range v {
+		if destination.ModuleName == "" {
+			continue
+		}
+		if destination.ModuleName == types.ModuleName || p.blockedDestinations[destination.ModuleName] {
+			return fmt.Errorf("mint destination module account %s can't receive minted provisions", destination.ModuleName)
+		}
+		if p.supplyKeeper.GetModuleAddress(destination.ModuleName) == nil {
+			return fmt.Errorf("unknown mint destination module account %s", destination.ModuleName)
+		}
+	}
+
+	return nil
+}
@@ -74,3 +74,25 @@ func TestQueryAnnualProvisions(t *testing.T) {
 
 	require.Equal(t, input.mintKeeper.GetMinter(input.ctx).AnnualProvisions, annualProvisions)
 }
+
+func TestQueryInflationHalving(t *testing.T) {
+	input := newTestInputWithInflationFns(t, types.HalvingInflationCalculationFn, types.HalvingAnnualProvisionsFn)
+
+	params := input.mintKeeper.GetParams(input.ctx)
+	params.BlocksPerHalving = 10
+	input.mintKeeper.SetParams(input.ctx, params)
+
+	var inflation, annualProvisions sdk.Dec
+	mintBlocks(input, input.ctx, 10)
+
+	res, sdkErr := queryInflation(input.ctx, input.mintKeeper)
+	require.NoError(t, sdkErr)
+	require.NoError(t, input.cdc.UnmarshalJSON(res, &inflation))
+	require.Equal(t, params.InflationMax.QuoInt64(2), inflation)
+
+	// the provisions started from the max inflation and halved in the 10th block
+	res, sdkErr = queryAnnualProvisions(input.ctx, input.mintKeeper)
+	require.NoError(t, sdkErr)
+	require.NoError(t, input.cdc.UnmarshalJSON(res, &annualProvisions))
+	require.Equal(t, params.InflationMax.MulInt64(1000000).QuoInt64(2), annualProvisions)
+}
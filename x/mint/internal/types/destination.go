@@ -0,0 +1,120 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Destination defines a recipient of a share of the minted provisions. Exactly
+// one of the account address, the module account name or the community pool
+// must be set.
+type Destination struct {
+	Address       sdk.AccAddress `json:"address,omitempty" yaml:"address,omitempty"`               // account receiving the share
+	ModuleName    string         `json:"module_name,omitempty" yaml:"module_name,omitempty"`       // module account receiving the share
+	CommunityPool bool           `json:"community_pool,omitempty" yaml:"community_pool,omitempty"` // whether the share funds the community pool
+	Weight        sdk.Dec        `json:"weight" yaml:"weight"`                                     // share of the minted provisions
+}
+
+// NewAddressDestination creates a new Destination sending a share of the
+// minted provisions to an account
+func NewAddressDestination(address sdk.AccAddress, weight sdk.Dec) Destination {
+	return Destination{Address: address, Weight: weight}
+}
+
+// NewModuleDestination creates a new Destination sending a share of the minted
+// provisions to a module account
+func NewModuleDestination(moduleName string, weight sdk.Dec) Destination {
+	return Destination{ModuleName: moduleName, Weight: weight}
+}
+
+// NewCommunityPoolDestination creates a new Destination funding the community
+// pool with a share of the minted provisions
+func NewCommunityPoolDestination(weight sdk.Dec) Destination {
+	return Destination{CommunityPool: true, Weight: weight}
+}
+
+// Recipient returns a description of the recipient of the destination
+func (d Destination) Recipient() string {
+	switch {
+	case !d.Address.Empty():
+		return d.Address.String()
+	case d.ModuleName != "":
+		return d.ModuleName
+	default:
+		return "community_pool"
+	}
+}
+
+// Validate performs basic validation of a Destination
+func (d Destination) Validate() error {
+	targets := 0
+	if !d.Address.Empty() {
+		targets++
+	}
+	if d.ModuleName != "" {
+		targets++
+	}
+	if d.CommunityPool {
+		targets++
+	}
+	if targets != 1 {
+		return fmt.Errorf("mint destination must set exactly one of address, module name or community pool")
+	}
+
+	if d.Weight.IsNil() || !d.Weight.IsPositive() || d.Weight.GT(sdk.OneDec()) {
+		return fmt.Errorf("mint destination %s weight must be positive and <= 1, is %s", d.Recipient(), d.Weight)
+	}
+
+	return nil
+}
+
+func (d Destination) String() string {
+	return fmt.Sprintf("%s: %s", d.Recipient(), d.Weight)
+}
+
+// Destinations is a collection of Destination
+type Destinations []Destination
+
+// Validate checks that the destinations are valid, distinct and that their
+// weights sum to 1. No destinations are valid.
+func (ds Destinations) Validate() error {
+	if len(ds) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	total := sdk.ZeroDec()
+	for _, d := range ds {
+		if err := d.Validate(); err != nil {
+			return err
+		}
+
+		recipient := d.Recipient()
+		if seen[recipient] {
+			return fmt.Errorf("duplicate mint destination %s", recipient)
+		}
+		seen[recipient] = true
+
+		total = total.Add(d.Weight)
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("mint destination weights must sum to 1, sum to %s", total)
+	}
+
+	return nil
+}
+
+func (ds Destinations) String() string {
+	if len(ds) == 0 {
+		return "[]"
+	}
+
+	out := make([]string, len(ds))
+	for i, d := range ds {
+		out[i] = d.String()
+	}
+	return strings.Join(out, ", ")
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDestinationsValidate(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	half := sdk.NewDecWithPrec(5, 1)
+
+	tests := []struct {
+		name         string
+		destinations Destinations
+		expPass      bool
+	}{
+		{"no destinations", Destinations{}, true},
+		{"single destination", Destinations{NewModuleDestination("fee_collector", sdk.OneDec())}, true},
+		{"all kinds of destinations", Destinations{
+			NewAddressDestination(addr, sdk.NewDecWithPrec(2, 1)),
+			NewModuleDestination("fee_collector", sdk.NewDecWithPrec(7, 1)),
+			NewCommunityPoolDestination(sdk.NewDecWithPrec(1, 1)),
+		}, true},
+		{"weights below 1", Destinations{NewModuleDestination("fee_collector", half)}, false},
+		{"weights above 1", Destinations{
+			NewModuleDestination("fee_collector", sdk.OneDec()),
+			NewCommunityPoolDestination(half),
+		}, false},
+		{"zero weight", Destinations{
+			NewModuleDestination("fee_collector", sdk.OneDec()),
+			NewCommunityPoolDestination(sdk.ZeroDec()),
+		}, false},
+		{"negative weight", Destinations{
+			NewModuleDestination("fee_collector", sdk.NewDec(2)),
+			NewCommunityPoolDestination(sdk.NewDec(-1)),
+		}, false},
+		{"duplicate destination", Destinations{
+			NewCommunityPoolDestination(half),
+			NewCommunityPoolDestination(half),
+		}, false},
+		{"no target", Destinations{{Weight: sdk.OneDec()}}, false},
+		{"several targets", Destinations{{Address: addr, ModuleName: "fee_collector", Weight: sdk.OneDec()}}, false},
+	}
+	for _, tc := range tests {
+		err := tc.destinations.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
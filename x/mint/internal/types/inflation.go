@@ -0,0 +1,66 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InflationCalculationFn defines the function used to compute the annual
+// inflation rate of the next block.
+type InflationCalculationFn func(ctx sdk.Context, minter Minter, params Params, bondedRatio sdk.Dec) sdk.Dec
+
+// AnnualProvisionsFn defines the function used to compute the annual
+// provisions of the next block, given the inflation rate of the minter.
+type AnnualProvisionsFn func(ctx sdk.Context, minter Minter, params Params, totalSupply sdk.Int) sdk.Dec
+
+// DefaultInflationCalculationFn adjusts the inflation rate depending on the
+// distance from the bonded ratio goal, see Minter.NextInflationRate.
+func DefaultInflationCalculationFn(_ sdk.Context, minter Minter, params Params, bondedRatio sdk.Dec) sdk.Dec {
+	return minter.NextInflationRate(params, bondedRatio)
+}
+
+// DefaultAnnualProvisionsFn applies the inflation rate to the total supply,
+// see Minter.NextAnnualProvisions.
+func DefaultAnnualProvisionsFn(_ sdk.Context, minter Minter, params Params, totalSupply sdk.Int) sdk.Dec {
+	return minter.NextAnnualProvisions(params, totalSupply)
+}
+
+// HalvingInflationCalculationFn follows a fixed emission schedule: the
+// inflation rate starts at the maximum inflation and halves with every halving
+// of the minter's halving schedule, without going below the minimum inflation.
+// The bonded ratio is not taken into account.
+func HalvingInflationCalculationFn(_ sdk.Context, minter Minter, params Params, _ sdk.Dec) sdk.Dec {
+	inflation := params.InflationMax
+	for i := uint64(0); i < minter.Halvings && inflation.GT(params.InflationMin); i++ {
+		inflation = inflation.QuoInt64(2)
+	}
+
+	if inflation.LT(params.InflationMin) {
+		inflation = params.InflationMin
+	}
+
+	return inflation
+}
+
+// HalvingAnnualProvisionsFn follows a fixed emission schedule: the annual
+// provisions don't depend on the total supply but stay the same during a
+// period of the minter's halving schedule and halve in the block of every
+// halving, without going below the minimum inflation applied to the total
+// supply. A minter without annual provisions starts the schedule by applying
+// its inflation rate to the total supply.
+func HalvingAnnualProvisionsFn(_ sdk.Context, minter Minter, params Params, totalSupply sdk.Int) sdk.Dec {
+	provisions := minter.AnnualProvisions
+
+	switch {
+	case provisions.IsZero():
+		provisions = minter.Inflation.MulInt(totalSupply)
+	case minter.Halvings > 0 && minter.BlocksSinceHalving == 0:
+		provisions = provisions.QuoInt64(2)
+	}
+
+	minProvisions := params.InflationMin.MulInt(totalSupply)
+	if provisions.LT(minProvisions) {
+		provisions = minProvisions
+	}
+
+	return provisions
+}
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestHalvingInflationCalculationFn(t *testing.T) {
+	params := DefaultParams()
+	params.InflationMax = sdk.NewDecWithPrec(20, 2)
+	params.InflationMin = sdk.NewDecWithPrec(2, 2)
+
+	tests := []struct {
+		halvings     uint64
+		expInflation sdk.Dec
+	}{
+		{0, sdk.NewDecWithPrec(20, 2)},
+		{1, sdk.NewDecWithPrec(10, 2)},
+		{2, sdk.NewDecWithPrec(5, 2)},
+		{3, sdk.NewDecWithPrec(25, 3)},
+		// the inflation does not go below the minimum inflation
+		{4, sdk.NewDecWithPrec(2, 2)},
+		{1 << 40, sdk.NewDecWithPrec(2, 2)},
+	}
+	for i, tc := range tests {
+		// neither the block height nor the bonded ratio are taken into account
+		ctx := sdk.NewContext(nil, abci.Header{Height: int64(i)}, false, log.NewNopLogger())
+		minter := DefaultInitialMinter()
+		minter.Halvings = tc.halvings
+
+		for _, bondedRatio := range []sdk.Dec{sdk.ZeroDec(), sdk.OneDec()} {
+			inflation := HalvingInflationCalculationFn(ctx, minter, params, bondedRatio)
+			require.True(t, tc.expInflation.Equal(inflation),
+				"Test Index: %v\nExpected: %v\nGot: %v\n", i, tc.expInflation, inflation)
+		}
+	}
+}
+
+func TestHalvingAnnualProvisionsFn(t *testing.T) {
+	params := DefaultParams()
+	params.InflationMin = sdk.NewDecWithPrec(1, 2)
+	params.BlocksPerHalving = 100
+	minter := NewMinter(sdk.NewDecWithPrec(20, 2), sdk.ZeroDec())
+	ctx := sdk.NewContext(nil, abci.Header{}, false, log.NewNopLogger())
+
+	tests := []struct {
+		block         int64
+		totalSupply   sdk.Int
+		expProvisions sdk.Dec
+	}{
+		// the schedule starts from the inflation rate applied to the supply
+		{1, sdk.NewInt(1000000), sdk.NewDec(200000)},
+		// the provisions don't depend on the supply within a halving period
+		{2, sdk.NewInt(2000000), sdk.NewDec(200000)},
+		{99, sdk.NewInt(3000000), sdk.NewDec(200000)},
+		{100, sdk.NewInt(3000000), sdk.NewDec(100000)},
+		{101, sdk.NewInt(3000000), sdk.NewDec(100000)},
+		{200, sdk.NewInt(4000000), sdk.NewDec(50000)},
+		// the provisions don't go below the minimum inflation
+		{300, sdk.NewInt(4000000), sdk.NewDec(40000)},
+		{301, sdk.NewInt(5000000), sdk.NewDec(50000)},
+	}
+	block := int64(0)
+	for i, tc := range tests {
+		for ; block < tc.block; block++ {
+			minter = minter.NextHalvingSchedule(params)
+			minter.AnnualProvisions = HalvingAnnualProvisionsFn(ctx, minter, params, tc.totalSupply)
+		}
+		require.True(t, tc.expProvisions.Equal(minter.AnnualProvisions),
+			"Test Index: %v\nExpected: %v\nGot: %v\n", i, tc.expProvisions, minter.AnnualProvisions)
+	}
+}
+
+func TestDefaultInflationFns(t *testing.T) {
+	minter := DefaultInitialMinter()
+	params := DefaultParams()
+	bondedRatio := sdk.NewDecWithPrec(5, 1)
+	totalSupply := sdk.NewInt(100000000000000)
+
+	require.Equal(t, minter.NextInflationRate(params, bondedRatio),
+		DefaultInflationCalculationFn(sdk.Context{}, minter, params, bondedRatio))
+	require.Equal(t, minter.NextAnnualProvisions(params, totalSupply),
+		DefaultAnnualProvisionsFn(sdk.Context{}, minter, params, totalSupply))
+}
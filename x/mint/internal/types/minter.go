@@ -8,8 +8,10 @@ import (
 
 // Minter represents the minting state.
 type Minter struct {
-	Inflation        sdk.Dec `json:"inflation"`         // current annual inflation rate
-	AnnualProvisions sdk.Dec `json:"annual_provisions"` // current annual expected provisions
+	Inflation          sdk.Dec `json:"inflation"`            // current annual inflation rate
+	AnnualProvisions   sdk.Dec `json:"annual_provisions"`    // current annual expected provisions
+	Halvings           uint64  `json:"halvings"`             // halvings of the halving schedule so far
+	BlocksSinceHalving uint64  `json:"blocks_since_halving"` // blocks minted since the last halving
 }
 
 // NewMinter returns a new Minter object with the given inflation and annual
@@ -78,6 +80,19 @@ func (m Minter) NextAnnualProvisions(_ Params, totalSupply sdk.Int) sdk.Dec {
 	return m.Inflation.MulInt(totalSupply)
 }
 
+// NextHalvingSchedule returns the minter with its halving schedule advanced by
+// a block. A halving happens once BlocksPerHalving blocks have been minted
+// since the last one, so the schedule doesn't depend on the block height and
+// a change of BlocksPerHalving applies from the last halving.
+func (m Minter) NextHalvingSchedule(params Params) Minter {
+	m.BlocksSinceHalving++
+	if m.BlocksSinceHalving >= params.BlocksPerHalving {
+		m.Halvings++
+		m.BlocksSinceHalving = 0
+	}
+	return m
+}
+
 // BlockProvision returns the provisions for a block based on the annual
 // provisions rate.
 func (m Minter) BlockProvision(params Params) sdk.Coin {
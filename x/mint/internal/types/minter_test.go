@@ -54,6 +54,42 @@ func TestNextInflation(t *testing.T) {
 	}
 }
 
+func TestNextHalvingSchedule(t *testing.T) {
+	params := DefaultParams()
+	params.BlocksPerHalving = 10
+	minter := DefaultInitialMinter()
+
+	for i := 0; i < 9; i++ {
+		minter = minter.NextHalvingSchedule(params)
+	}
+	require.Equal(t, uint64(0), minter.Halvings)
+	require.Equal(t, uint64(9), minter.BlocksSinceHalving)
+
+	minter = minter.NextHalvingSchedule(params)
+	require.Equal(t, uint64(1), minter.Halvings)
+	require.Equal(t, uint64(0), minter.BlocksSinceHalving)
+
+	// lowering BlocksPerHalving below the blocks since the last halving
+	// halves once in the next block
+	for i := 0; i < 5; i++ {
+		minter = minter.NextHalvingSchedule(params)
+	}
+	params.BlocksPerHalving = 3
+	minter = minter.NextHalvingSchedule(params)
+	require.Equal(t, uint64(2), minter.Halvings)
+	require.Equal(t, uint64(0), minter.BlocksSinceHalving)
+
+	// raising it extends the current period
+	minter = minter.NextHalvingSchedule(params)
+	params.BlocksPerHalving = 20
+	for i := 0; i < 18; i++ {
+		minter = minter.NextHalvingSchedule(params)
+	}
+	require.Equal(t, uint64(2), minter.Halvings)
+	minter = minter.NextHalvingSchedule(params)
+	require.Equal(t, uint64(3), minter.Halvings)
+}
+
 func TestBlockProvision(t *testing.T) {
 	minter := InitialMinter(sdk.NewDecWithPrec(1, 1))
 	params := DefaultParams()
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
@@ -15,25 +16,31 @@ var (
 	KeyInflationMin        = []byte("InflationMin")
 	KeyGoalBonded          = []byte("GoalBonded")
 	KeyBlocksPerYear       = []byte("BlocksPerYear")
+	KeyBlocksPerHalving    = []byte("BlocksPerHalving")
+	KeyDestinations        = []byte("Destinations")
 )
 
 // mint parameters
 type Params struct {
-	MintDenom           string  `json:"mint_denom"`            // type of coin to mint
-	InflationRateChange sdk.Dec `json:"inflation_rate_change"` // maximum annual change in inflation rate
-	InflationMax        sdk.Dec `json:"inflation_max"`         // maximum inflation rate
-	InflationMin        sdk.Dec `json:"inflation_min"`         // minimum inflation rate
-	GoalBonded          sdk.Dec `json:"goal_bonded"`           // goal of percent bonded atoms
-	BlocksPerYear       uint64  `json:"blocks_per_year"`       // expected blocks per year
+	MintDenom           string       `json:"mint_denom"`            // type of coin to mint
+	InflationRateChange sdk.Dec      `json:"inflation_rate_change"` // maximum annual change in inflation rate
+	InflationMax        sdk.Dec      `json:"inflation_max"`         // maximum inflation rate
+	InflationMin        sdk.Dec      `json:"inflation_min"`         // minimum inflation rate
+	GoalBonded          sdk.Dec      `json:"goal_bonded"`           // goal of percent bonded atoms
+	BlocksPerYear       uint64       `json:"blocks_per_year"`       // expected blocks per year
+	BlocksPerHalving    uint64       `json:"blocks_per_halving"`    // blocks between halvings of the inflation rate, used by the halving schedule
+	Destinations        Destinations `json:"destinations"`          // recipients of the minted provisions, all go to the fee collector if empty
 }
 
+var _ params.ParamSetValidator = (*Params)(nil)
+
 // ParamTable for minting module.
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 func NewParams(mintDenom string, inflationRateChange, inflationMax,
-	inflationMin, goalBonded sdk.Dec, blocksPerYear uint64) Params {
+	inflationMin, goalBonded sdk.Dec, blocksPerYear, blocksPerHalving uint64, destinations Destinations) Params {
 
 	return Params{
 		MintDenom:           mintDenom,
@@ -42,6 +49,8 @@ func NewParams(mintDenom string, inflationRateChange, inflationMax,
 		InflationMin:        inflationMin,
 		GoalBonded:          goalBonded,
 		BlocksPerYear:       blocksPerYear,
+		BlocksPerHalving:    blocksPerHalving,
+		Destinations:        destinations,
 	}
 }
 
@@ -53,27 +62,51 @@ func DefaultParams() Params {
 		InflationMax:        sdk.NewDecWithPrec(20, 2),
 		InflationMin:        sdk.NewDecWithPrec(7, 2),
 		GoalBonded:          sdk.NewDecWithPrec(67, 2),
-		BlocksPerYear:       uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+		BlocksPerYear:       uint64(60 * 60 * 8766 / 5),     // assuming 5 second block times
+		BlocksPerHalving:    uint64(4 * 60 * 60 * 8766 / 5), // every 4 years, assuming 5 second block times
+		Destinations:        Destinations{},
 	}
 }
 
 // validate params
 func ValidateParams(params Params) error {
-	if params.GoalBonded.LT(sdk.ZeroDec()) {
-		return fmt.Errorf("mint parameter GoalBonded should be positive, is %s ", params.GoalBonded.String())
+	if err := validateMintDenom(params.MintDenom); err != nil {
+		return err
+	}
+	if err := validateInflationRateChange(params.InflationRateChange); err != nil {
+		return err
+	}
+	if err := validateInflationMax(params.InflationMax); err != nil {
+		return err
+	}
+	if err := validateInflationMin(params.InflationMin); err != nil {
+		return err
+	}
+	if err := validateGoalBonded(params.GoalBonded); err != nil {
+		return err
 	}
-	if params.GoalBonded.GT(sdk.OneDec()) {
-		return fmt.Errorf("mint parameter GoalBonded must be <= 1, is %s", params.GoalBonded.String())
+	if err := validateBlocksPerYear(params.BlocksPerYear); err != nil {
+		return err
+	}
+	if err := validateBlocksPerHalving(params.BlocksPerHalving); err != nil {
+		return err
+	}
+	if err := validateDestinations(params.Destinations); err != nil {
+		return err
 	}
 	if params.InflationMax.LT(params.InflationMin) {
 		return fmt.Errorf("mint parameter Max inflation must be greater than or equal to min inflation")
 	}
-	if params.MintDenom == "" {
-		return fmt.Errorf("mint parameter MintDenom can't be an empty string")
-	}
 	return nil
 }
 
+// Validate validates the parameters as a whole, which implements
+// params.ParamSetValidator so that parameter changes can't set a max
+// inflation lower than the min inflation
+func (p Params) Validate() error {
+	return ValidateParams(p)
+}
+
 func (p Params) String() string {
 	return fmt.Sprintf(`Minting Params:
   Mint Denom:             %s
@@ -82,20 +115,127 @@ func (p Params) String() string {
   Inflation Min:          %s
   Goal Bonded:            %s
   Blocks Per Year:        %d
+  Blocks Per Halving:     %d
+  Destinations:           %s
 `,
 		p.MintDenom, p.InflationRateChange, p.InflationMax,
-		p.InflationMin, p.GoalBonded, p.BlocksPerYear,
+		p.InflationMin, p.GoalBonded, p.BlocksPerYear, p.BlocksPerHalving, p.Destinations,
 	)
 }
 
 // Implements params.ParamSet
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
-		{KeyMintDenom, &p.MintDenom},
-		{KeyInflationRateChange, &p.InflationRateChange},
-		{KeyInflationMax, &p.InflationMax},
-		{KeyInflationMin, &p.InflationMin},
-		{KeyGoalBonded, &p.GoalBonded},
-		{KeyBlocksPerYear, &p.BlocksPerYear},
+		params.NewParamSetPair(KeyMintDenom, &p.MintDenom, validateMintDenom),
+		params.NewParamSetPair(KeyInflationRateChange, &p.InflationRateChange, validateInflationRateChange),
+		params.NewParamSetPair(KeyInflationMax, &p.InflationMax, validateInflationMax),
+		params.NewParamSetPair(KeyInflationMin, &p.InflationMin, validateInflationMin),
+		params.NewParamSetPair(KeyGoalBonded, &p.GoalBonded, validateGoalBonded),
+		params.NewParamSetPair(KeyBlocksPerYear, &p.BlocksPerYear, validateBlocksPerYear),
+		params.NewParamSetPair(KeyBlocksPerHalving, &p.BlocksPerHalving, validateBlocksPerHalving),
+		params.NewParamSetPair(KeyDestinations, &p.Destinations, validateDestinations),
+	}
+}
+
+func validateMintDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if strings.TrimSpace(v) == "" {
+		return fmt.Errorf("mint parameter MintDenom can't be an empty string")
+	}
+	if err := sdk.ValidateDenom(v); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateInflationRateChange(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("mint parameter InflationRateChange must be between 0 and 1, is %s", v)
+	}
+
+	return nil
+}
+
+func validateInflationMax(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("mint parameter InflationMax must be between 0 and 1, is %s", v)
+	}
+
+	return nil
+}
+
+func validateInflationMin(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
 	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("mint parameter InflationMin must be between 0 and 1, is %s", v)
+	}
+
+	return nil
+}
+
+func validateGoalBonded(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("mint parameter GoalBonded must be positive and <= 1, is %s", v)
+	}
+
+	return nil
+}
+
+func validateBlocksPerYear(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("mint parameter BlocksPerYear must be positive")
+	}
+
+	return nil
+}
+
+func validateBlocksPerHalving(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("mint parameter BlocksPerHalving must be positive")
+	}
+
+	return nil
+}
+
+func validateDestinations(i interface{}) error {
+	v, ok := i.(Destinations)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
 }
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+func TestParamsValidateInflationRange(t *testing.T) {
+	ctx, ss, _ := params.DefaultTestComponents(t)
+	ss = ss.WithKeyTable(ParamKeyTable())
+
+	defaultParams := DefaultParams()
+	ss.SetParamSet(ctx, &defaultParams)
+	require.NoError(t, ss.ValidateParamSet(ctx))
+
+	// a max inflation lower than the min inflation is only rejected as a whole
+	require.NoError(t, ss.Update(ctx, KeyInflationMax, []byte(`"0.050000000000000000"`)))
+	require.Error(t, ss.ValidateParamSet(ctx))
+
+	require.NoError(t, ss.Update(ctx, KeyInflationMin, []byte(`"0.010000000000000000"`)))
+	require.NoError(t, ss.ValidateParamSet(ctx))
+}
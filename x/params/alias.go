@@ -18,8 +18,11 @@ const (
 	CodeUnknownSubspace  = types.CodeUnknownSubspace
 	CodeSettingParameter = types.CodeSettingParameter
 	CodeEmptyData        = types.CodeEmptyData
+	CodeInvalidParamSet  = types.CodeInvalidParamSet
 	ModuleName           = types.ModuleName
 	RouterKey            = types.RouterKey
+	QuerierRoute         = types.QuerierRoute
+	QueryHistory         = types.QueryHistory
 	ProposalTypeChange   = types.ProposalTypeChange
 )
 
@@ -27,10 +30,12 @@ var (
 	// functions aliases
 	NewSubspace                = subspace.NewSubspace
 	NewKeyTable                = subspace.NewKeyTable
+	NewParamSetPair            = subspace.NewParamSetPair
 	DefaultTestComponents      = subspace.DefaultTestComponents
 	RegisterCodec              = types.RegisterCodec
 	ErrUnknownSubspace         = types.ErrUnknownSubspace
 	ErrSettingParameter        = types.ErrSettingParameter
+	ErrInvalidParamSet         = types.ErrInvalidParamSet
 	ErrEmptyChanges            = types.ErrEmptyChanges
 	ErrEmptySubspace           = types.ErrEmptySubspace
 	ErrEmptyKey                = types.ErrEmptyKey
@@ -39,15 +44,32 @@ var (
 	NewParamChange             = types.NewParamChange
 	NewParamChangeWithSubkey   = types.NewParamChangeWithSubkey
 	ValidateChanges            = types.ValidateChanges
+	NewGenesisState            = types.NewGenesisState
+	DefaultGenesisState        = types.DefaultGenesisState
+	ValidateGenesis            = types.ValidateGenesis
+	ParamChangesKey            = types.ParamChangesKey
+	ParamChangeKey             = types.ParamChangeKey
+	ParamChangeIndexFromKey    = types.ParamChangeIndexFromKey
+	NewParamChangeRecord       = types.NewParamChangeRecord
+	NewQueryHistoryParams      = types.NewQueryHistoryParams
+
+	// variable aliases
+	ParamChangeKeyPrefix = types.ParamChangeKeyPrefix
 )
 
 type (
 	ParamSetPair            = subspace.ParamSetPair
+	ValueValidatorFn        = subspace.ValueValidatorFn
 	ParamSetPairs           = subspace.ParamSetPairs
 	ParamSet                = subspace.ParamSet
+	ParamSetValidator       = subspace.ParamSetValidator
 	Subspace                = subspace.Subspace
 	ReadOnlySubspace        = subspace.ReadOnlySubspace
 	KeyTable                = subspace.KeyTable
 	ParameterChangeProposal = types.ParameterChangeProposal
 	ParamChange             = types.ParamChange
+	GenesisState            = types.GenesisState
+	ParamChangeRecord       = types.ParamChangeRecord
+	ParamChangeRecords      = types.ParamChangeRecords
+	QueryHistoryParams      = types.QueryHistoryParams
 )
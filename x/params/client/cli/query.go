@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	paramsQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the params module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	paramsQueryCmd.AddCommand(client.GetCommands(
+		GetCmdQueryHistory(cdc),
+	)...)
+
+	return paramsQueryCmd
+}
+
+// GetCmdQueryHistory implements the query parameter change history command.
+func GetCmdQueryHistory(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "history [subspace] [key]",
+		Short: "Query the changes made to a parameter by governance proposals",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the changes made to a parameter by governance proposals, in the order
+they were made. Each change lists the height and the proposal it was made at
+along with the old and new values of the parameter.
+
+Example:
+$ %s query %s history staking MaxValidators
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(types.NewQueryHistoryParams(args[0], args[1]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryHistory)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var records types.ParamChangeRecords
+			if err := cdc.UnmarshalJSON(res, &records); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(records)
+		},
+	}
+}
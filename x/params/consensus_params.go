@@ -0,0 +1,17 @@
+package params
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+)
+
+// ConsensusParamsKeyTable returns the key table of the BaseApp's params store,
+// registering the Tendermint consensus params with their validation functions
+func ConsensusParamsKeyTable() KeyTable {
+	return NewKeyTable(
+		NewParamSetPair(baseapp.ParamStoreKeyBlockParams, abci.BlockParams{}, baseapp.ValidateBlockParams),
+		NewParamSetPair(baseapp.ParamStoreKeyEvidenceParams, abci.EvidenceParams{}, baseapp.ValidateEvidenceParams),
+		NewParamSetPair(baseapp.ParamStoreKeyValidatorParams, abci.ValidatorParams{}, baseapp.ValidateValidatorParams),
+	)
+}
@@ -35,11 +35,21 @@ recommended to use the same name with the module's.
 
 	func ParamKeyTable() params.KeyTable {
 		return params.NewKeyTable(
-			KeyParameter1, MyStruct{},
-			KeyParameter2, MyStruct{},
+			params.NewParamSetPair(KeyParameter1, MyStruct{}, validateMyStruct),
+			params.NewParamSetPair(KeyParameter2, MyStruct{}, validateMyStruct),
 		)
 	}
 
+	func validateMyStruct(i interface{}) error {
+		_, ok := i.(MyStruct)
+		if !ok {
+			return fmt.Errorf("invalid parameter type: %T", i)
+		}
+
+		// validate the value
+		return nil
+	}
+
 	func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, ps params.Subspace) Keeper {
 		return Keeper {
 			cdc: cdc,
@@ -56,7 +66,9 @@ Now we can access to the paramstore using Paramstore Keys
 
 	var param MyStruct
 	k.ps.Get(ctx, KeyParameter1, &param)
-	k.ps.Set(ctx, KeyParameter2, param)
+	if err := k.ps.Set(ctx, KeyParameter2, param); err != nil {
+		// the validator function of KeyParameter2 rejected param
+	}
 
 If you want to store an unknown number of parameters, or want to store a mapping,
 you can use subkeys. Subkeys can be used with a main key, where the subkeys are
@@ -64,7 +76,7 @@ inheriting the key properties.
 
 	func ParamKeyTable() params.KeyTable {
 		return params.NewKeyTable(
-			KeyParamMain, MyStruct{},
+			params.NewParamSetPair(KeyParamMain, MyStruct{}, validateMyStruct),
 		)
 	}
 
@@ -84,11 +96,11 @@ be able to be passed to SetParamSet.
 	}
 
 	// Implements params.ParamSet
-	// KeyValuePairs must return the list of (ParamKey, PointerToTheField)
-	func (p *MyParams) KeyValuePairs() params.KeyValuePairs {
-		return params.KeyFieldPairs {
-			{KeyParameter1, &p.Parameter1},
-			{KeyParameter2, &p.Parameter2},
+	// ParamSetPairs must return the list of (ParamKey, PointerToTheField, ValidatorFn)
+	func (p *MyParams) ParamSetPairs() params.ParamSetPairs {
+		return params.ParamSetPairs{
+			params.NewParamSetPair(KeyParameter1, &p.Parameter1, validateParameter1),
+			params.NewParamSetPair(KeyParameter2, &p.Parameter2, validateParameter2),
 		}
 	}
 
@@ -108,11 +120,11 @@ params.Keeper itself to access all subspace(using GetSubspace)
 		pk params.Keeper
 	}
 
-	func (k MasterKeeper) SetParam(ctx sdk.Context, space string, key string, param interface{}) {
+	func (k MasterKeeper) SetParam(ctx sdk.Context, space string, key string, param interface{}) error {
 		space, ok := k.pk.GetSubspace(space)
 		if !ok {
-			return
+			return nil
 		}
-		space.Set(ctx, key, param)
+		return space.Set(ctx, key, param)
 	}
 */
@@ -0,0 +1,28 @@
+package params
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis stores the parameter changes provided at genesis.
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	if err := ValidateGenesis(data); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", ModuleName, err))
+	}
+
+	for _, record := range data.ParamChanges {
+		k.appendParamChange(ctx, record)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	records := k.GetAllParamChanges(ctx)
+	if records == nil {
+		records = ParamChangeRecords{}
+	}
+
+	return NewGenesisState(records)
+}
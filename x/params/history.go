@@ -0,0 +1,63 @@
+package params
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// The changes of the parameters made by governance proposals are recorded in
+// an append-only log per subspace and key, so that the value of a parameter
+// at any height can be traced back.
+
+// GetParamChanges returns the changes of a parameter in the order they were made
+func (k Keeper) GetParamChanges(ctx sdk.Context, subspace, key string) (records ParamChangeRecords) {
+	store := ctx.KVStore(k.key)
+	iterator := sdk.KVStorePrefixIterator(store, types.ParamChangesKey(subspace, key))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record ParamChangeRecord
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return
+}
+
+// IterateParamChanges iterates over the changes of all the parameters, grouped
+// by parameter and in the order they were made
+func (k Keeper) IterateParamChanges(ctx sdk.Context, cb func(record ParamChangeRecord) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iterator := sdk.KVStorePrefixIterator(store, types.ParamChangeKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record ParamChangeRecord
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetAllParamChanges returns the changes of all the parameters
+func (k Keeper) GetAllParamChanges(ctx sdk.Context) (records ParamChangeRecords) {
+	k.IterateParamChanges(ctx, func(record ParamChangeRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	return
+}
+
+// appendParamChange appends a change to the log of its parameter
+func (k Keeper) appendParamChange(ctx sdk.Context, record ParamChangeRecord) {
+	store := ctx.KVStore(k.key)
+
+	var index uint64
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.ParamChangesKey(record.Subspace, record.Key))
+	if iterator.Valid() {
+		index = types.ParamChangeIndexFromKey(iterator.Key()) + 1
+	}
+	iterator.Close()
+
+	store.Set(types.ParamChangeKey(record.Subspace, record.Key, index), k.cdc.MustMarshalBinaryLengthPrefixed(record))
+}
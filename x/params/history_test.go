@@ -0,0 +1,113 @@
+package params_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+func TestProposalHandlerRecordsChanges(t *testing.T) {
+	input := newTestInput(t)
+	input.keeper.Subspace(testSubspace).WithKeyTable(
+		params.NewKeyTable().RegisterParamSet(&testParams{}),
+	)
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+
+	ctx := input.ctx.WithBlockHeight(10)
+	require.NoError(t, hdlr(ctx, testProposal(params.NewParamChange(testSubspace, keyMaxValidators, "1"))))
+
+	ctx = gov.WithProposalID(input.ctx.WithBlockHeight(20), 3)
+	require.NoError(t, hdlr(ctx, testProposal(params.NewParamChange(testSubspace, keyMaxValidators, "2"))))
+
+	// failed changes are not recorded
+	require.Error(t, hdlr(ctx, testProposal(params.NewParamChange(testSubspace, keyMaxValidators, "0"))))
+
+	require.NoError(t, hdlr(ctx, testProposal(params.NewParamChange(testSubspace, keySlashingRate, `{"downtime": 7}`))))
+
+	records := input.keeper.GetParamChanges(input.ctx, testSubspace, keyMaxValidators)
+	require.Equal(t, params.ParamChangeRecords{
+		params.NewParamChangeRecord(testSubspace, keyMaxValidators, "", 10, "", "1", 0),
+		params.NewParamChangeRecord(testSubspace, keyMaxValidators, "", 20, "1", "2", 3),
+	}, records)
+
+	records = input.keeper.GetParamChanges(input.ctx, testSubspace, keySlashingRate)
+	require.Equal(t, params.ParamChangeRecords{
+		params.NewParamChangeRecord(testSubspace, keySlashingRate, "", 20, "", `{"downtime":7}`, 3),
+	}, records)
+
+	require.Empty(t, input.keeper.GetParamChanges(input.ctx, testSubspace, "unknown"))
+	require.Len(t, input.keeper.GetAllParamChanges(input.ctx), 3)
+}
+
+func TestQueryHistory(t *testing.T) {
+	input := newTestInput(t)
+	input.keeper.Subspace(testSubspace).WithKeyTable(
+		params.NewKeyTable().RegisterParamSet(&testParams{}),
+	)
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+	require.NoError(t, hdlr(input.ctx, testProposal(params.NewParamChange(testSubspace, keyMaxValidators, "1"))))
+
+	querier := params.NewQuerier(input.keeper)
+	query := func(key string) params.ParamChangeRecords {
+		req := abci.RequestQuery{
+			Data: input.cdc.MustMarshalJSON(params.NewQueryHistoryParams(testSubspace, key)),
+		}
+		bz, err := querier(input.ctx, []string{params.QueryHistory}, req)
+		require.NoError(t, err)
+
+		var records params.ParamChangeRecords
+		require.NoError(t, input.cdc.UnmarshalJSON(bz, &records))
+		return records
+	}
+
+	require.Equal(t, input.keeper.GetParamChanges(input.ctx, testSubspace, keyMaxValidators), query(keyMaxValidators))
+	require.Empty(t, query(keySlashingRate))
+
+	_, err := querier(input.ctx, []string{"unknown"}, abci.RequestQuery{})
+	require.Error(t, err)
+}
+
+func TestExportImportParamChanges(t *testing.T) {
+	input := newTestInput(t)
+	input.keeper.Subspace(testSubspace).WithKeyTable(
+		params.NewKeyTable().RegisterParamSet(&testParams{}),
+	)
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+	for i, value := range []string{"1", "2", "3"} {
+		ctx := gov.WithProposalID(input.ctx.WithBlockHeight(int64(i)), uint64(i))
+		require.NoError(t, hdlr(ctx, testProposal(params.NewParamChange(testSubspace, keyMaxValidators, value))))
+	}
+
+	genesis := params.ExportGenesis(input.ctx, input.keeper)
+	require.Len(t, genesis.ParamChanges, 3)
+
+	input2 := newTestInput(t)
+	params.InitGenesis(input2.ctx, input2.keeper, genesis)
+	require.Equal(t, genesis, params.ExportGenesis(input2.ctx, input2.keeper))
+
+	input3 := newTestInput(t)
+	require.Equal(t, params.DefaultGenesisState(), params.ExportGenesis(input3.ctx, input3.keeper))
+}
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, params.ValidateGenesis(params.DefaultGenesisState()))
+
+	valid := params.NewParamChangeRecord(testSubspace, keyMaxValidators, "", 10, "", `"1"`, 1)
+	require.NoError(t, params.ValidateGenesis(params.NewGenesisState(params.ParamChangeRecords{valid})))
+
+	for _, record := range []params.ParamChangeRecord{
+		params.NewParamChangeRecord("", keyMaxValidators, "", 10, "", `"1"`, 1),
+		params.NewParamChangeRecord(testSubspace, "", "", 10, "", `"1"`, 1),
+		params.NewParamChangeRecord(testSubspace, keyMaxValidators, "", 10, "", "", 1),
+		params.NewParamChangeRecord(testSubspace, keyMaxValidators, "", -1, "", `"1"`, 1),
+	} {
+		require.Error(t, params.ValidateGenesis(params.NewGenesisState(params.ParamChangeRecords{record})))
+	}
+
+	unordered := params.NewParamChangeRecord(testSubspace, keyMaxValidators, "", 5, "1", "2", 2)
+	require.Error(t, params.ValidateGenesis(params.NewGenesisState(params.ParamChangeRecords{valid, unordered})))
+}
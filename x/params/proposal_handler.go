@@ -21,30 +21,66 @@ func NewParamChangeProposalHandler(k Keeper) govtypes.Handler {
 }
 
 func handleParameterChangeProposal(ctx sdk.Context, k Keeper, p ParameterChangeProposal) sdk.Error {
+	var changed []Subspace
 	for _, c := range p.Changes {
 		ss, ok := k.GetSubspace(c.Subspace)
 		if !ok {
 			return ErrUnknownSubspace(k.codespace, c.Subspace)
 		}
 
-		var err error
+		if !containsSubspace(changed, ss) {
+			changed = append(changed, ss)
+		}
+
+		var (
+			err                error
+			oldValue, newValue []byte
+		)
 		if len(c.Subkey) == 0 {
 			k.Logger(ctx).Info(
 				fmt.Sprintf("setting new parameter; key: %s, value: %s", c.Key, c.Value),
 			)
 
+			oldValue = ss.GetRaw(ctx, []byte(c.Key))
 			err = ss.Update(ctx, []byte(c.Key), []byte(c.Value))
+			newValue = ss.GetRaw(ctx, []byte(c.Key))
 		} else {
 			k.Logger(ctx).Info(
 				fmt.Sprintf("setting new parameter; key: %s, subkey: %s, value: %s", c.Key, c.Subspace, c.Value),
 			)
+			oldValue = ss.GetRawWithSubkey(ctx, []byte(c.Key), []byte(c.Subkey))
 			err = ss.UpdateWithSubkey(ctx, []byte(c.Key), []byte(c.Subkey), []byte(c.Value))
+			newValue = ss.GetRawWithSubkey(ctx, []byte(c.Key), []byte(c.Subkey))
 		}
 
 		if err != nil {
 			return ErrSettingParameter(k.codespace, c.Key, c.Subkey, c.Value, err.Error())
 		}
+
+		// the proposal ID is only known when the proposal is executed, not
+		// when its content is checked at submission
+		proposalID, _ := govtypes.ProposalIDFromContext(ctx)
+		k.appendParamChange(ctx, NewParamChangeRecord(
+			c.Subspace, c.Key, c.Subkey, ctx.BlockHeight(), string(oldValue), string(newValue), proposalID,
+		))
+	}
+
+	// the parameters of a subspace that depend on each other are validated
+	// once all the changes are applied, as they may be changed together
+	for _, ss := range changed {
+		if err := ss.ValidateParamSet(ctx); err != nil {
+			return ErrInvalidParamSet(k.codespace, ss.Name(), err.Error())
+		}
 	}
 
 	return nil
 }
+
+func containsSubspace(spaces []Subspace, ss Subspace) bool {
+	for _, space := range spaces {
+		if space.Name() == ss.Name() {
+			return true
+		}
+	}
+	return false
+}
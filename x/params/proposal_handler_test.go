@@ -1,6 +1,7 @@
 package params_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -9,9 +10,11 @@ import (
 
 	dbm "github.com/tendermint/tendermint/libs/db"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/params/subspace"
 	"github.com/cosmos/cosmos-sdk/x/params/types"
@@ -24,10 +27,13 @@ type testInput struct {
 }
 
 var (
-	_ subspace.ParamSet = (*testParams)(nil)
+	_ subspace.ParamSet          = (*testParams)(nil)
+	_ subspace.ParamSetValidator = (*testRangeParams)(nil)
 
 	keyMaxValidators = "MaxValidators"
 	keySlashingRate  = "SlashingRate"
+	keyMin           = "Min"
+	keyMax           = "Max"
 	testSubspace     = "TestSubspace"
 )
 
@@ -43,11 +49,49 @@ type testParams struct {
 
 func (tp *testParams) ParamSetPairs() subspace.ParamSetPairs {
 	return subspace.ParamSetPairs{
-		{[]byte(keyMaxValidators), &tp.MaxValidators},
-		{[]byte(keySlashingRate), &tp.SlashingRate},
+		params.NewParamSetPair([]byte(keyMaxValidators), &tp.MaxValidators, validateMaxValidators),
+		params.NewParamSetPair([]byte(keySlashingRate), &tp.SlashingRate, validateSlashingRate),
 	}
 }
 
+func validateMaxValidators(i interface{}) error {
+	v, ok := i.(uint16)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("max validators must be positive")
+	}
+	return nil
+}
+
+func validateSlashingRate(i interface{}) error {
+	if _, ok := i.(testParamsSlashingRate); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+// testRangeParams are validated as a whole, the min can't exceed the max
+type testRangeParams struct {
+	Min uint16 `json:"min"`
+	Max uint16 `json:"max"`
+}
+
+func (tp *testRangeParams) ParamSetPairs() subspace.ParamSetPairs {
+	return subspace.ParamSetPairs{
+		params.NewParamSetPair([]byte(keyMin), &tp.Min, validateMaxValidators),
+		params.NewParamSetPair([]byte(keyMax), &tp.Max, validateMaxValidators),
+	}
+}
+
+func (tp testRangeParams) Validate() error {
+	if tp.Max < tp.Min {
+		return fmt.Errorf("max %d is lower than min %d", tp.Max, tp.Min)
+	}
+	return nil
+}
+
 func testProposal(changes ...params.ParamChange) params.ParameterChangeProposal {
 	return params.NewParameterChangeProposal(
 		"Test",
@@ -106,6 +150,79 @@ func TestProposalHandlerFailed(t *testing.T) {
 	require.False(t, ss.Has(input.ctx, []byte(keyMaxValidators)))
 }
 
+func TestProposalHandlerInvalidValue(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
+		params.NewKeyTable().RegisterParamSet(&testParams{}),
+	)
+
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+	require.NoError(t, hdlr(input.ctx, testProposal(params.NewParamChange(testSubspace, keyMaxValidators, "1"))))
+
+	tp := testProposal(params.NewParamChange(testSubspace, keyMaxValidators, "0"))
+	require.Error(t, hdlr(input.ctx, tp))
+
+	var param uint16
+	ss.Get(input.ctx, []byte(keyMaxValidators), &param)
+	require.Equal(t, uint16(1), param)
+}
+
+func TestProposalHandlerInvalidParamSet(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
+		params.NewKeyTable().RegisterParamSet(&testRangeParams{}),
+	)
+	ss.SetParamSet(input.ctx, &testRangeParams{Min: 5, Max: 10})
+
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+
+	// each value is valid on its own but the max is lower than the min
+	tp := testProposal(params.NewParamChange(testSubspace, keyMax, "4"))
+	err := hdlr(input.ctx, tp)
+	require.Error(t, err)
+	require.Equal(t, params.CodeInvalidParamSet, err.Code())
+
+	// the parameters are validated once all the changes are applied
+	tp = testProposal(
+		params.NewParamChange(testSubspace, keyMin, "20"),
+		params.NewParamChange(testSubspace, keyMax, "30"),
+	)
+	require.NoError(t, hdlr(input.ctx, tp))
+
+	var rp testRangeParams
+	ss.GetParamSet(input.ctx, &rp)
+	require.Equal(t, testRangeParams{Min: 20, Max: 30}, rp)
+}
+
+func TestProposalHandlerDistributionParams(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(distribution.DefaultParamspace).WithKeyTable(distribution.ParamKeyTable())
+	ss.Set(input.ctx, distribution.ParamStoreKeyCommunityTax, sdk.NewDecWithPrec(3, 1))
+	ss.Set(input.ctx, distribution.ParamStoreKeyBaseProposerReward, sdk.NewDecWithPrec(3, 1))
+	ss.Set(input.ctx, distribution.ParamStoreKeyBonusProposerReward, sdk.NewDecWithPrec(3, 1))
+
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+
+	// the community tax and the proposer rewards can't exceed the fees
+	tp := testProposal(params.NewParamChange(distribution.DefaultParamspace,
+		string(distribution.ParamStoreKeyCommunityTax), `"0.500000000000000000"`))
+	err := hdlr(input.ctx, tp)
+	require.Error(t, err)
+	require.Equal(t, params.CodeInvalidParamSet, err.Code())
+
+	tp = testProposal(
+		params.NewParamChange(distribution.DefaultParamspace,
+			string(distribution.ParamStoreKeyCommunityTax), `"0.500000000000000000"`),
+		params.NewParamChange(distribution.DefaultParamspace,
+			string(distribution.ParamStoreKeyBonusProposerReward), `"0.100000000000000000"`),
+	)
+	require.NoError(t, hdlr(input.ctx, tp))
+
+	var communityTax sdk.Dec
+	ss.Get(input.ctx, distribution.ParamStoreKeyCommunityTax, &communityTax)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), communityTax)
+}
+
 func TestProposalHandlerUpdateOmitempty(t *testing.T) {
 	input := newTestInput(t)
 	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
@@ -127,3 +244,29 @@ func TestProposalHandlerUpdateOmitempty(t *testing.T) {
 	ss.Get(input.ctx, []byte(keySlashingRate), &param)
 	require.Equal(t, testParamsSlashingRate{10, 7}, param)
 }
+
+func TestProposalHandlerConsensusParams(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(baseapp.Paramspace).WithKeyTable(params.ConsensusParamsKeyTable())
+
+	bp := abci.BlockParams{MaxBytes: 1024, MaxGas: -1}
+	require.NoError(t, ss.Set(input.ctx, baseapp.ParamStoreKeyBlockParams, bp))
+
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+
+	tp := testProposal(params.NewParamChange(baseapp.Paramspace, string(baseapp.ParamStoreKeyBlockParams), `{"max_gas": "2000000"}`))
+	require.NoError(t, hdlr(input.ctx, tp))
+
+	ss.Get(input.ctx, baseapp.ParamStoreKeyBlockParams, &bp)
+	require.Equal(t, abci.BlockParams{MaxBytes: 1024, MaxGas: 2000000}, bp)
+
+	tp = testProposal(params.NewParamChange(baseapp.Paramspace, string(baseapp.ParamStoreKeyBlockParams), `{"max_bytes": "0"}`))
+	require.Error(t, hdlr(input.ctx, tp))
+
+	tp = testProposal(params.NewParamChange(baseapp.Paramspace, string(baseapp.ParamStoreKeyValidatorParams), `{"pub_key_types": ["unknown"]}`))
+	require.Error(t, hdlr(input.ctx, tp))
+	require.False(t, ss.Has(input.ctx, baseapp.ParamStoreKeyValidatorParams))
+
+	ss.Get(input.ctx, baseapp.ParamStoreKeyBlockParams, &bp)
+	require.Equal(t, abci.BlockParams{MaxBytes: 1024, MaxGas: 2000000}, bp)
+}
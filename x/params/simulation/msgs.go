@@ -79,6 +79,14 @@ var paramChangePool = []simParamChange{
 			return fmt.Sprintf("\"%s\"", simulation.ModuleParamSimulator[simulation.InflationRateChange](r).(sdk.Dec))
 		},
 	},
+	{
+		"mint",
+		"BlocksPerHalving",
+		"",
+		func(r *rand.Rand) string {
+			return fmt.Sprintf("\"%d\"", simulation.ModuleParamSimulator[simulation.BlocksPerHalving](r).(uint64))
+		},
+	},
 	// gov parameters
 	{
 		"gov",
@@ -1,9 +1,22 @@
 package subspace
 
-// Used for associating paramsubspace key and field of param structs
-type ParamSetPair struct {
-	Key   []byte
-	Value interface{}
+type (
+	// ValueValidatorFn validates a parameter value. It is given the value, not
+	// a pointer to it, and returns an error if the value is invalid.
+	ValueValidatorFn func(value interface{}) error
+
+	// ParamSetPair is used for associating paramsubspace key and field of param
+	// structs, along with the function validating the field's value
+	ParamSetPair struct {
+		Key         []byte
+		Value       interface{}
+		ValidatorFn ValueValidatorFn
+	}
+)
+
+// NewParamSetPair creates a new ParamSetPair instance
+func NewParamSetPair(key []byte, value interface{}, vfn ValueValidatorFn) ParamSetPair {
+	return ParamSetPair{key, value, vfn}
 }
 
 // Slice of KeyFieldPair
@@ -13,3 +26,11 @@ type ParamSetPairs []ParamSetPair
 type ParamSet interface {
 	ParamSetPairs() ParamSetPairs
 }
+
+// ParamSetValidator is implemented by the parameter sets whose parameters
+// depend on each other. Validate is called on the whole set once the changes
+// of a parameter change proposal are applied.
+type ParamSetValidator interface {
+	ParamSet
+	Validate() error
+}
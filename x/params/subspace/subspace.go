@@ -1,8 +1,9 @@
 package subspace
 
 import (
-	"errors"
+	"fmt"
 	"reflect"
+	"sort"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -130,6 +131,11 @@ func (s Subspace) GetRaw(ctx sdk.Context, key []byte) []byte {
 	return store.Get(key)
 }
 
+// Get raw bytes of parameter with a given key and subkey from store
+func (s Subspace) GetRawWithSubkey(ctx sdk.Context, key, subkey []byte) []byte {
+	return s.GetRaw(ctx, concatKeys(key, subkey))
+}
+
 // Check if the parameter is set in the store
 func (s Subspace) Has(ctx sdk.Context, key []byte) bool {
 	store := s.kvStore(ctx)
@@ -142,6 +148,22 @@ func (s Subspace) Modified(ctx sdk.Context, key []byte) bool {
 	return tstore.Has(key)
 }
 
+// Validate runs the validator function registered for the parameter on the
+// given value, which may be a pointer to the value
+func (s Subspace) Validate(ctx sdk.Context, key []byte, param interface{}) error {
+	attr, ok := s.table.m[string(key)]
+	if !ok {
+		return fmt.Errorf("parameter %s not registered", key)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(param)).Interface()
+	if err := attr.vfn(value); err != nil {
+		return fmt.Errorf("invalid parameter value for %s: %s", key, err)
+	}
+
+	return nil
+}
+
 func (s Subspace) checkType(store sdk.KVStore, key []byte, param interface{}) {
 	attr, ok := s.table.m[string(key)]
 	if !ok {
@@ -159,13 +181,19 @@ func (s Subspace) checkType(store sdk.KVStore, key []byte, param interface{}) {
 	}
 }
 
-// Set stores the parameter. It returns error if stored parameter has different type from input.
-// It also set to the transient store to record change.
-func (s Subspace) Set(ctx sdk.Context, key []byte, param interface{}) {
+// Set stores the parameter. It panics if the stored parameter has a different
+// type from the input and returns an error, without storing the parameter, if
+// the parameter's validator function rejects it. It also set to the transient
+// store to record change.
+func (s Subspace) Set(ctx sdk.Context, key []byte, param interface{}) error {
 	store := s.kvStore(ctx)
 
 	s.checkType(store, key, param)
 
+	if err := s.Validate(ctx, key, param); err != nil {
+		return err
+	}
+
 	bz, err := s.cdc.MarshalJSON(param)
 	if err != nil {
 		panic(err)
@@ -175,11 +203,12 @@ func (s Subspace) Set(ctx sdk.Context, key []byte, param interface{}) {
 	tstore := s.transientStore(ctx)
 	tstore.Set(key, []byte{})
 
+	return nil
 }
 
 // Update stores raw parameter bytes. It returns error if the stored parameter
-// has a different type from the input. It also sets to the transient store to
-// record change.
+// has a different type from the input or if the updated parameter is invalid.
+// It also sets to the transient store to record change.
 func (s Subspace) Update(ctx sdk.Context, key []byte, param []byte) error {
 	attr, ok := s.table.m[string(key)]
 	if !ok {
@@ -194,20 +223,20 @@ func (s Subspace) Update(ctx sdk.Context, key []byte, param []byte) error {
 		return err
 	}
 
-	s.Set(ctx, key, dest)
-	tStore := s.transientStore(ctx)
-	tStore.Set(key, []byte{})
-
-	return nil
+	return s.Set(ctx, key, dest)
 }
 
 // SetWithSubkey set a parameter with a key and subkey
-// Checks parameter type only over the key
-func (s Subspace) SetWithSubkey(ctx sdk.Context, key []byte, subkey []byte, param interface{}) {
+// Checks parameter type and validity only over the key
+func (s Subspace) SetWithSubkey(ctx sdk.Context, key []byte, subkey []byte, param interface{}) error {
 	store := s.kvStore(ctx)
 
 	s.checkType(store, key, param)
 
+	if err := s.Validate(ctx, key, param); err != nil {
+		return err
+	}
+
 	newkey := concatKeys(key, subkey)
 
 	bz, err := s.cdc.MarshalJSON(param)
@@ -218,16 +247,16 @@ func (s Subspace) SetWithSubkey(ctx sdk.Context, key []byte, subkey []byte, para
 
 	tstore := s.transientStore(ctx)
 	tstore.Set(newkey, []byte{})
+
+	return nil
 }
 
 // UpdateWithSubkey stores raw parameter bytes  with a key and subkey. It checks
-// the parameter type only over the key.
+// the parameter type and validity only over the key.
 func (s Subspace) UpdateWithSubkey(ctx sdk.Context, key []byte, subkey []byte, param []byte) error {
-	concatkey := concatKeys(key, subkey)
-
-	attr, ok := s.table.m[string(concatkey)]
+	attr, ok := s.table.m[string(key)]
 	if !ok {
-		return errors.New("parameter not registered")
+		return fmt.Errorf("parameter %s not registered", key)
 	}
 
 	ty := attr.ty
@@ -238,11 +267,7 @@ func (s Subspace) UpdateWithSubkey(ctx sdk.Context, key []byte, subkey []byte, p
 		return err
 	}
 
-	s.SetWithSubkey(ctx, key, subkey, dest)
-	tStore := s.transientStore(ctx)
-	tStore.Set(concatkey, []byte{})
-
-	return nil
+	return s.SetWithSubkey(ctx, key, subkey, dest)
 }
 
 // Get to ParamSet
@@ -252,7 +277,7 @@ func (s Subspace) GetParamSet(ctx sdk.Context, ps ParamSet) {
 	}
 }
 
-// Set from ParamSet
+// Set from ParamSet. It panics if any of the parameters is invalid.
 func (s Subspace) SetParamSet(ctx sdk.Context, ps ParamSet) {
 	for _, pair := range ps.ParamSetPairs() {
 		// pair.Field is a pointer to the field, so indirecting the ptr.
@@ -260,8 +285,41 @@ func (s Subspace) SetParamSet(ctx sdk.Context, ps ParamSet) {
 		// since SetStruct is meant to be used in InitGenesis
 		// so this method will not be called frequently
 		v := reflect.Indirect(reflect.ValueOf(pair.Value)).Interface()
-		s.Set(ctx, pair.Key, v)
+		if err := s.Set(ctx, pair.Key, v); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ValidateParamSet validates the stored parameters as a whole for each of the
+// parameter sets registered in the key table that implement ParamSetValidator.
+// Parameters that are not stored are left to their zero value.
+func (s Subspace) ValidateParamSet(ctx sdk.Context) error {
+	keys := make([]string, 0, len(s.table.m))
+	for key := range s.table.m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	validated := make(map[reflect.Type]bool)
+	for _, key := range keys {
+		psType := s.table.m[key].psType
+		if psType == nil || validated[psType] {
+			continue
+		}
+		validated[psType] = true
+
+		ps := reflect.New(psType.Elem()).Interface().(ParamSetValidator)
+		for _, pair := range ps.ParamSetPairs() {
+			s.GetIfExists(ctx, pair.Key, pair.Value)
+		}
+
+		if err := ps.Validate(); err != nil {
+			return err
+		}
 	}
+
+	return nil
 }
 
 // Returns name of Subspace
@@ -5,7 +5,12 @@ import (
 )
 
 type attribute struct {
-	ty reflect.Type
+	ty  reflect.Type
+	vfn ValueValidatorFn
+
+	// type of the parameter set validated as a whole the parameter was
+	// registered with, if any
+	psType reflect.Type
 }
 
 // KeyTable subspaces appropriate type for each parameter key
@@ -14,17 +19,13 @@ type KeyTable struct {
 }
 
 // Constructs new table
-func NewKeyTable(keytypes ...interface{}) (res KeyTable) {
-	if len(keytypes)%2 != 0 {
-		panic("odd number arguments in NewTypeKeyTable")
-	}
-
+func NewKeyTable(pairs ...ParamSetPair) (res KeyTable) {
 	res = KeyTable{
 		m: make(map[string]attribute),
 	}
 
-	for i := 0; i < len(keytypes); i += 2 {
-		res = res.RegisterType(keytypes[i].([]byte), keytypes[i+1])
+	for _, psp := range pairs {
+		res = res.RegisterType(psp)
 	}
 
 	return
@@ -41,20 +42,23 @@ func isAlphaNumeric(key []byte) bool {
 	return true
 }
 
-// Register single key-type pair
-func (t KeyTable) RegisterType(key []byte, ty interface{}) KeyTable {
-	if len(key) == 0 {
+// Register single key-type pair along with its validator function
+func (t KeyTable) RegisterType(psp ParamSetPair) KeyTable {
+	if len(psp.Key) == 0 {
 		panic("cannot register empty key")
 	}
-	if !isAlphaNumeric(key) {
+	if !isAlphaNumeric(psp.Key) {
 		panic("non alphanumeric parameter key")
 	}
-	keystr := string(key)
+	if psp.ValidatorFn == nil {
+		panic("cannot register parameter without a validator function")
+	}
+	keystr := string(psp.Key)
 	if _, ok := t.m[keystr]; ok {
 		panic("duplicate parameter key")
 	}
 
-	rty := reflect.TypeOf(ty)
+	rty := reflect.TypeOf(psp.Value)
 
 	// Indirect rty if it is ptr
 	if rty.Kind() == reflect.Ptr {
@@ -62,7 +66,8 @@ func (t KeyTable) RegisterType(key []byte, ty interface{}) KeyTable {
 	}
 
 	t.m[keystr] = attribute{
-		ty: rty,
+		ty:  rty,
+		vfn: psp.ValidatorFn,
 	}
 
 	return t
@@ -71,8 +76,22 @@ func (t KeyTable) RegisterType(key []byte, ty interface{}) KeyTable {
 // Register multiple pairs from ParamSet
 func (t KeyTable) RegisterParamSet(ps ParamSet) KeyTable {
 	for _, kvp := range ps.ParamSetPairs() {
-		t = t.RegisterType(kvp.Key, kvp.Value)
+		t = t.RegisterType(kvp)
 	}
+
+	// the parameter set is instantiated from its type to be validated, which
+	// requires a pointer as ParamSetPairs returns pointers to its fields
+	if _, ok := ps.(ParamSetValidator); ok {
+		if reflect.TypeOf(ps).Kind() != reflect.Ptr {
+			panic("validated parameter set must be registered as a pointer")
+		}
+		for _, kvp := range ps.ParamSetPairs() {
+			attr := t.m[string(kvp.Key)]
+			attr.psType = reflect.TypeOf(ps)
+			t.m[string(kvp.Key)] = attr
+		}
+	}
+
 	return t
 }
 
@@ -13,22 +13,25 @@ type testparams struct {
 
 func (tp *testparams) ParamSetPairs() ParamSetPairs {
 	return ParamSetPairs{
-		{[]byte("i"), &tp.i},
-		{[]byte("b"), &tp.b},
+		{[]byte("i"), &tp.i, validateNoOp},
+		{[]byte("b"), &tp.b, validateNoOp},
 	}
 }
 
+func validateNoOp(_ interface{}) error { return nil }
+
 func TestKeyTable(t *testing.T) {
 	table := NewKeyTable()
 
-	require.Panics(t, func() { table.RegisterType([]byte(""), nil) })
-	require.Panics(t, func() { table.RegisterType([]byte("!@#$%"), nil) })
-	require.Panics(t, func() { table.RegisterType([]byte("hello,"), nil) })
-	require.Panics(t, func() { table.RegisterType([]byte("hello"), nil) })
+	require.Panics(t, func() { table.RegisterType(ParamSetPair{[]byte(""), nil, validateNoOp}) })
+	require.Panics(t, func() { table.RegisterType(ParamSetPair{[]byte("!@#$%"), nil, validateNoOp}) })
+	require.Panics(t, func() { table.RegisterType(ParamSetPair{[]byte("hello,"), nil, validateNoOp}) })
+	require.Panics(t, func() { table.RegisterType(ParamSetPair{[]byte("hello"), nil, validateNoOp}) })
+	require.Panics(t, func() { table.RegisterType(ParamSetPair{[]byte("hello"), bool(false), nil}) })
 
-	require.NotPanics(t, func() { table.RegisterType([]byte("hello"), bool(false)) })
-	require.NotPanics(t, func() { table.RegisterType([]byte("world"), int64(0)) })
-	require.Panics(t, func() { table.RegisterType([]byte("hello"), bool(false)) })
+	require.NotPanics(t, func() { table.RegisterType(ParamSetPair{[]byte("hello"), bool(false), validateNoOp}) })
+	require.NotPanics(t, func() { table.RegisterType(ParamSetPair{[]byte("world"), int64(0), validateNoOp}) })
+	require.Panics(t, func() { table.RegisterType(ParamSetPair{[]byte("hello"), bool(false), validateNoOp}) })
 
 	require.NotPanics(t, func() { table.RegisterParamSet(&testparams{}) })
 	require.Panics(t, func() { table.RegisterParamSet(&testparams{}) })
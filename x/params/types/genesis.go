@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState - params genesis state
+type GenesisState struct {
+	ParamChanges ParamChangeRecords `json:"param_changes" yaml:"param_changes"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(paramChanges ParamChangeRecords) GenesisState {
+	return GenesisState{
+		ParamChanges: paramChanges,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(ParamChangeRecords{})
+}
+
+// ValidateGenesis performs basic validation of params genesis data returning
+// an error for any failed validation criteria. The changes of a parameter must
+// be in the order they were made.
+func ValidateGenesis(data GenesisState) error {
+	lastHeights := make(map[string]int64)
+	for _, record := range data.ParamChanges {
+		if err := record.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid change of parameter %s/%s: %s", record.Subspace, record.Key, err)
+		}
+
+		param := string(ParamChangesKey(record.Subspace, record.Key))
+		if height, ok := lastHeights[param]; ok && record.Height < height {
+			return fmt.Errorf("changes of parameter %s/%s are not ordered by height", record.Subspace, record.Key)
+		}
+		lastHeights[param] = record.Height
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+// ParamChangeKeyPrefix is the prefix of the keys under which the parameter
+// change records are stored. Subspace names are never empty, so the prefix
+// does not collide with the keys of the parameters held by the subspaces.
+var ParamChangeKeyPrefix = []byte{0x00}
+
+// ParamChangesKey returns the prefix of the keys of the change records of a
+// parameter, followed by the length-prefixed subspace and key.
+func ParamChangesKey(subspace, key string) []byte {
+	res := append([]byte{}, ParamChangeKeyPrefix...)
+	res = append(res, byte(len(subspace)))
+	res = append(res, subspace...)
+	res = append(res, byte(len(key)))
+	return append(res, key...)
+}
+
+// ParamChangeKey returns the key of a parameter change record, followed by the
+// big endian index of the record.
+func ParamChangeKey(subspace, key string, index uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, index)
+	return append(ParamChangesKey(subspace, key), bz...)
+}
+
+// ParamChangeIndexFromKey returns the index of a parameter change record from
+// its key.
+func ParamChangeIndexFromKey(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[len(key)-8:])
+}
+
+// ParamChangeRecord records a change of a parameter. The values are the raw
+// JSON values held by the subspace, the old value is empty if the parameter
+// was not set before the change.
+type ParamChangeRecord struct {
+	Subspace   string `json:"subspace" yaml:"subspace"`
+	Key        string `json:"key" yaml:"key"`
+	Subkey     string `json:"subkey,omitempty" yaml:"subkey,omitempty"`
+	Height     int64  `json:"height" yaml:"height"`
+	OldValue   string `json:"old_value" yaml:"old_value"`
+	NewValue   string `json:"new_value" yaml:"new_value"`
+	ProposalID uint64 `json:"proposal_id" yaml:"proposal_id"`
+}
+
+// NewParamChangeRecord creates a new ParamChangeRecord instance
+func NewParamChangeRecord(subspace, key, subkey string, height int64, oldValue, newValue string, proposalID uint64) ParamChangeRecord {
+	return ParamChangeRecord{
+		Subspace:   subspace,
+		Key:        key,
+		Subkey:     subkey,
+		Height:     height,
+		OldValue:   oldValue,
+		NewValue:   newValue,
+		ProposalID: proposalID,
+	}
+}
+
+// ValidateBasic performs basic validation of a ParamChangeRecord
+func (r ParamChangeRecord) ValidateBasic() error {
+	if len(r.Subspace) == 0 {
+		return fmt.Errorf("parameter subspace is empty")
+	}
+	if len(r.Key) == 0 {
+		return fmt.Errorf("parameter key is empty")
+	}
+	if len(r.Subspace) > 255 || len(r.Key) > 255 {
+		return fmt.Errorf("parameter subspace and key must not be longer than 255 bytes")
+	}
+	if len(r.NewValue) == 0 {
+		return fmt.Errorf("parameter value is empty")
+	}
+	if r.Height < 0 {
+		return fmt.Errorf("parameter change height must not be negative: %d", r.Height)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (r ParamChangeRecord) String() string {
+	return fmt.Sprintf(`Param Change:
+  Subspace:    %s
+  Key:         %s
+  Subkey:      %s
+  Height:      %d
+  Old Value:   %s
+  New Value:   %s
+  Proposal ID: %d`, r.Subspace, r.Key, r.Subkey, r.Height, r.OldValue, r.NewValue, r.ProposalID)
+}
+
+// ParamChangeRecords is a collection of ParamChangeRecord
+type ParamChangeRecords []ParamChangeRecord
+
+// String implements fmt.Stringer
+func (rs ParamChangeRecords) String() string {
+	out := make([]string, len(rs))
+	for i, r := range rs {
+		out[i] = r.String()
+	}
+	return strings.Join(out, "\n")
+}
@@ -0,0 +1,19 @@
+package types
+
+// query endpoints supported by the params Querier
+const (
+	QueryHistory = "history"
+)
+
+// QueryHistoryParams defines the params for the following queries:
+//
+// - 'custom/params/history'
+type QueryHistoryParams struct {
+	Subspace string
+	Key      string
+}
+
+// NewQueryHistoryParams creates a new instance of QueryHistoryParams
+func NewQueryHistoryParams(subspace, key string) QueryHistoryParams {
+	return QueryHistoryParams{subspace, key}
+}
@@ -18,32 +18,47 @@ const (
 	maxTimePerBlock int64 = 10000
 
 	// Simulation parameter constants
-	SendEnabled              = "send_enabled"
-	MaxMemoChars             = "max_memo_characters"
-	TxSigLimit               = "tx_sig_limit"
-	TxSizeCostPerByte        = "tx_size_cost_per_byte"
-	SigVerifyCostED25519     = "sig_verify_cost_ed25519"
-	SigVerifyCostSECP256K1   = "sig_verify_cost_secp256k1"
-	DepositParamsMinDeposit  = "deposit_params_min_deposit"
-	VotingParamsVotingPeriod = "voting_params_voting_period"
-	TallyParamsQuorum        = "tally_params_quorum"
-	TallyParamsThreshold     = "tally_params_threshold"
-	TallyParamsVeto          = "tally_params_veto"
-	UnbondingTime            = "unbonding_time"
-	MaxValidators            = "max_validators"
-	SignedBlocksWindow       = "signed_blocks_window"
-	MinSignedPerWindow       = "min_signed_per_window"
-	DowntimeJailDuration     = "downtime_jail_duration"
-	SlashFractionDoubleSign  = "slash_fraction_double_sign"
-	SlashFractionDowntime    = "slash_fraction_downtime"
-	InflationRateChange      = "inflation_rate_change"
-	Inflation                = "inflation"
-	InflationMax             = "inflation_max"
-	InflationMin             = "inflation_min"
-	GoalBonded               = "goal_bonded"
-	CommunityTax             = "community_tax"
-	BaseProposerReward       = "base_proposer_reward"
-	BonusProposerReward      = "bonus_proposer_reward"
+	SendEnabled                       = "send_enabled"
+	MaxMemoChars                      = "max_memo_characters"
+	TxSigLimit                        = "tx_sig_limit"
+	TxSizeCostPerByte                 = "tx_size_cost_per_byte"
+	SigVerifyCostED25519              = "sig_verify_cost_ed25519"
+	SigVerifyCostSECP256K1            = "sig_verify_cost_secp256k1"
+	DepositParamsMinDeposit           = "deposit_params_min_deposit"
+	VotingParamsVotingPeriod          = "voting_params_voting_period"
+	TallyParamsQuorum                 = "tally_params_quorum"
+	TallyParamsThreshold              = "tally_params_threshold"
+	TallyParamsVeto                   = "tally_params_veto"
+	DepositParamsExpeditedMinDeposit  = "deposit_params_expedited_min_deposit"
+	VotingParamsExpeditedVotingPeriod = "voting_params_expedited_voting_period"
+	TallyParamsExpeditedThreshold     = "tally_params_expedited_threshold"
+	DepositParamsProposalCancelRatio  = "deposit_params_proposal_cancel_ratio"
+	UnbondingTime                     = "unbonding_time"
+	MaxValidators                     = "max_validators"
+	HistoricalEntries                 = "historical_entries"
+	MaxVotingPowerRatio               = "max_voting_power_ratio"
+	VotingPowerCapMode                = "voting_power_cap_mode"
+	SignedBlocksWindow                = "signed_blocks_window"
+	MinSignedPerWindow                = "min_signed_per_window"
+	DowntimeJailDuration              = "downtime_jail_duration"
+	SlashFractionDoubleSign           = "slash_fraction_double_sign"
+	SlashFractionDowntime             = "slash_fraction_downtime"
+	DowntimeJailDecayPeriod           = "downtime_jail_decay_period"
+	SlashFractionDowntimeSchedule     = "slash_fraction_downtime_schedule"
+	DowntimeJailDurationSchedule      = "downtime_jail_duration_schedule"
+	InflationRateChange               = "inflation_rate_change"
+	Inflation                         = "inflation"
+	InflationMax                      = "inflation_max"
+	InflationMin                      = "inflation_min"
+	GoalBonded                        = "goal_bonded"
+	BlocksPerHalving                  = "blocks_per_halving"
+	MintFeeCollectorWeight            = "mint_fee_collector_weight"
+	CommunityTax                      = "community_tax"
+	BaseProposerReward                = "base_proposer_reward"
+	BonusProposerReward               = "bonus_proposer_reward"
+	AutoCompoundPeriod                = "auto_compound_period"
+	AutoCompoundMaxPerBlock           = "auto_compound_max_per_block"
+	DenomCreationFee                  = "denom_creation_fee"
 )
 
 // TODO explain transitional matrix usage
@@ -101,12 +116,35 @@ var (
 		TallyParamsVeto: func(r *rand.Rand) interface{} {
 			return sdk.NewDecWithPrec(int64(RandIntBetween(r, 250, 334)), 3)
 		},
+		DepositParamsProposalCancelRatio: func(r *rand.Rand) interface{} {
+			return sdk.NewDecWithPrec(int64(RandIntBetween(r, 0, 100)), 2)
+		},
+		// margin of the expedited threshold above the threshold
+		TallyParamsExpeditedThreshold: func(r *rand.Rand) interface{} {
+			return sdk.NewDecWithPrec(int64(RandIntBetween(r, 0, 200)), 3)
+		},
 		UnbondingTime: func(r *rand.Rand) interface{} {
 			return time.Duration(RandIntBetween(r, 60, 60*60*24*3*2)) * time.Second
 		},
 		MaxValidators: func(r *rand.Rand) interface{} {
 			return uint16(r.Intn(250) + 1)
 		},
+		HistoricalEntries: func(r *rand.Rand) interface{} {
+			return uint16(r.Intn(100))
+		},
+		MaxVotingPowerRatio: func(r *rand.Rand) interface{} {
+			// the cap is disabled half of the time
+			if r.Intn(2) == 0 {
+				return sdk.ZeroDec()
+			}
+			return sdk.NewDecWithPrec(int64(RandIntBetween(r, 10, 100)), 2)
+		},
+		VotingPowerCapMode: func(r *rand.Rand) interface{} {
+			if r.Intn(2) == 0 {
+				return "reject"
+			}
+			return "warn"
+		},
 		SignedBlocksWindow: func(r *rand.Rand) interface{} {
 			return int64(RandIntBetween(r, 10, 1000))
 		},
@@ -122,6 +160,27 @@ var (
 		SlashFractionDowntime: func(r *rand.Rand) interface{} {
 			return sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(200) + 1)))
 		},
+		DowntimeJailDecayPeriod: func(r *rand.Rand) interface{} {
+			return time.Duration(RandIntBetween(r, 0, 60*60*24)) * time.Second
+		},
+		SlashFractionDowntimeSchedule: func(r *rand.Rand) interface{} {
+			schedule := make([]sdk.Dec, r.Intn(4))
+			fraction := sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(200) + 1)))
+			for i := range schedule {
+				schedule[i] = fraction
+				fraction = sdk.MinDec(fraction.Add(sdk.NewDecWithPrec(int64(r.Intn(10)), 2)), sdk.OneDec())
+			}
+			return schedule
+		},
+		DowntimeJailDurationSchedule: func(r *rand.Rand) interface{} {
+			schedule := make([]time.Duration, r.Intn(4))
+			duration := time.Duration(RandIntBetween(r, 60, 60*60*24)) * time.Second
+			for i := range schedule {
+				schedule[i] = duration
+				duration += time.Duration(r.Intn(60*60*24)) * time.Second
+			}
+			return schedule
+		},
 		InflationRateChange: func(r *rand.Rand) interface{} {
 			return sdk.NewDecWithPrec(int64(r.Intn(99)), 2)
 		},
@@ -137,6 +196,15 @@ var (
 		GoalBonded: func(r *rand.Rand) interface{} {
 			return sdk.NewDecWithPrec(67, 2)
 		},
+		BlocksPerHalving: func(r *rand.Rand) interface{} {
+			return uint64(RandIntBetween(r, 10, 100))
+		},
+		MintFeeCollectorWeight: func(r *rand.Rand) interface{} {
+			if r.Intn(2) == 0 {
+				return sdk.OneDec()
+			}
+			return sdk.NewDecWithPrec(int64(RandIntBetween(r, 50, 100)), 2)
+		},
 		CommunityTax: func(r *rand.Rand) interface{} {
 			return sdk.NewDecWithPrec(1, 2).Add(sdk.NewDecWithPrec(int64(r.Intn(30)), 2))
 		},
@@ -146,6 +214,15 @@ var (
 		BonusProposerReward: func(r *rand.Rand) interface{} {
 			return sdk.NewDecWithPrec(1, 2).Add(sdk.NewDecWithPrec(int64(r.Intn(30)), 2))
 		},
+		AutoCompoundPeriod: func(r *rand.Rand) interface{} {
+			return int64(RandIntBetween(r, 1, 20))
+		},
+		AutoCompoundMaxPerBlock: func(r *rand.Rand) interface{} {
+			return int64(RandIntBetween(r, 1, 10))
+		},
+		DenomCreationFee: func(r *rand.Rand) interface{} {
+			return sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(RandIntBetween(r, 1, 1e3)))}
+		},
 	}
 )
 
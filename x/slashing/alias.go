@@ -23,10 +23,13 @@ const (
 	QueryParameters             = types.QueryParameters
 	QuerySigningInfo            = types.QuerySigningInfo
 	QuerySigningInfos           = types.QuerySigningInfos
+	QueryOffences               = types.QueryOffences
+	QueryMissedBlocks           = types.QueryMissedBlocks
 	DefaultParamspace           = types.DefaultParamspace
-	DefaultMaxEvidenceAge       = types.DefaultMaxEvidenceAge
 	DefaultSignedBlocksWindow   = types.DefaultSignedBlocksWindow
 	DefaultDowntimeJailDuration = types.DefaultDowntimeJailDuration
+
+	DefaultDowntimeJailDecayPeriod = types.DefaultDowntimeJailDecayPeriod
 )
 
 var (
@@ -47,38 +50,60 @@ var (
 	GetValidatorMissedBlockBitArrayPrefixKey = types.GetValidatorMissedBlockBitArrayPrefixKey
 	GetValidatorMissedBlockBitArrayKey       = types.GetValidatorMissedBlockBitArrayKey
 	GetAddrPubkeyRelationKey                 = types.GetAddrPubkeyRelationKey
+	GetValidatorDowntimeOffencePrefixKey     = types.GetValidatorDowntimeOffencePrefixKey
+	GetValidatorDowntimeOffenceKey           = types.GetValidatorDowntimeOffenceKey
+	GetDowntimeOffenceQueueTimeKey           = types.GetDowntimeOffenceQueueTimeKey
+	GetDowntimeOffenceQueueKey               = types.GetDowntimeOffenceQueueKey
+	SplitDowntimeOffenceQueueKey             = types.SplitDowntimeOffenceQueueKey
 	NewMsgUnjail                             = types.NewMsgUnjail
 	ParamKeyTable                            = types.ParamKeyTable
 	NewParams                                = types.NewParams
 	DefaultParams                            = types.DefaultParams
 	NewQuerySigningInfoParams                = types.NewQuerySigningInfoParams
 	NewQuerySigningInfosParams               = types.NewQuerySigningInfosParams
+	NewQueryOffencesParams                   = types.NewQueryOffencesParams
+	NewQueryMissedBlocksParams               = types.NewQueryMissedBlocksParams
 	NewValidatorSigningInfo                  = types.NewValidatorSigningInfo
+	NewDowntimeOffence                       = types.NewDowntimeOffence
+	NewValidatorMissedBlocks                 = types.NewValidatorMissedBlocks
 
 	// variable aliases
 	ModuleCdc                       = types.ModuleCdc
 	ValidatorSigningInfoKey         = types.ValidatorSigningInfoKey
 	ValidatorMissedBlockBitArrayKey = types.ValidatorMissedBlockBitArrayKey
 	AddrPubkeyRelationKey           = types.AddrPubkeyRelationKey
+	ValidatorDowntimeOffenceKey     = types.ValidatorDowntimeOffenceKey
+	DowntimeOffenceQueueKey         = types.DowntimeOffenceQueueKey
 	DoubleSignJailEndTime           = types.DoubleSignJailEndTime
 	DefaultMinSignedPerWindow       = types.DefaultMinSignedPerWindow
 	DefaultSlashFractionDoubleSign  = types.DefaultSlashFractionDoubleSign
 	DefaultSlashFractionDowntime    = types.DefaultSlashFractionDowntime
-	KeyMaxEvidenceAge               = types.KeyMaxEvidenceAge
 	KeySignedBlocksWindow           = types.KeySignedBlocksWindow
 	KeyMinSignedPerWindow           = types.KeyMinSignedPerWindow
 	KeyDowntimeJailDuration         = types.KeyDowntimeJailDuration
 	KeySlashFractionDoubleSign      = types.KeySlashFractionDoubleSign
 	KeySlashFractionDowntime        = types.KeySlashFractionDowntime
+
+	DefaultSlashFractionDowntimeSchedule = types.DefaultSlashFractionDowntimeSchedule
+	DefaultDowntimeJailDurationSchedule  = types.DefaultDowntimeJailDurationSchedule
+	KeyDowntimeJailDecayPeriod           = types.KeyDowntimeJailDecayPeriod
+	KeySlashFractionDowntimeSchedule     = types.KeySlashFractionDowntimeSchedule
+	KeyDowntimeJailDurationSchedule      = types.KeyDowntimeJailDurationSchedule
 )
 
 type (
 	CodeType                = types.CodeType
+	DowntimeOffence         = types.DowntimeOffence
+	DowntimeOffences        = types.DowntimeOffences
 	GenesisState            = types.GenesisState
 	MissedBlock             = types.MissedBlock
 	MsgUnjail               = types.MsgUnjail
 	Params                  = types.Params
+	QueryMissedBlocksParams = types.QueryMissedBlocksParams
+	QueryOffencesParams     = types.QueryOffencesParams
 	QuerySigningInfoParams  = types.QuerySigningInfoParams
 	QuerySigningInfosParams = types.QuerySigningInfosParams
+	ValidatorMissedBlocks   = types.ValidatorMissedBlocks
 	ValidatorSigningInfo    = types.ValidatorSigningInfo
+	ValidatorSigningInfos   = types.ValidatorSigningInfos
 )
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -28,6 +29,9 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	slashingQueryCmd.AddCommand(
 		client.GetCommands(
 			GetCmdQuerySigningInfo(queryRoute, cdc),
+			GetCmdQuerySigningInfos(queryRoute, cdc),
+			GetCmdQueryMissedBlocks(queryRoute, cdc),
+			GetCmdQueryOffences(queryRoute, cdc),
 			GetCmdQueryParams(cdc),
 		)...,
 	)
@@ -73,6 +77,123 @@ $ <appcli> query slashing signing-info cosmosvalconspub1zcjduepqfhvwcmt7p06fvdge
 	}
 }
 
+// GetCmdQuerySigningInfos implements the command to query the signing info of
+// all validators.
+func GetCmdQuerySigningInfos(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-infos",
+		Short: "Query the signing information of all validators",
+		Long: strings.TrimSpace(`Query the signing information of all validators. The results can be paginated with the
+--page and --limit flags, a limit of 0 returns as many results as the maximum number of validators:
+
+$ <appcli> query slashing signing-infos --page=2 --limit=50
+`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQuerySigningInfosParams(viper.GetInt(FlagPage), viper.GetInt(FlagLimit))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySigningInfos)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var signingInfos types.ValidatorSigningInfos
+			cdc.MustUnmarshalJSON(res, &signingInfos)
+			return cliCtx.PrintOutput(signingInfos)
+		},
+	}
+
+	cmd.Flags().Int(FlagPage, 1, "Query a specific page of paginated results")
+	cmd.Flags().Int(FlagLimit, 0, "Query number of signing infos per page returned")
+
+	return cmd
+}
+
+// GetCmdQueryMissedBlocks implements the command to query a validator's missed
+// blocks bitmap over the current signed blocks window.
+func GetCmdQueryMissedBlocks(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "missed-blocks [validator-conspub]",
+		Short: "Query a validator's missed blocks over the current signed blocks window",
+		Long: strings.TrimSpace(`Use a validators' consensus public key to query the bitmap of the blocks it missed over
+the current signed blocks window, along with the number of blocks it can still miss before being jailed:
+
+$ <appcli> query slashing missed-blocks cosmosvalconspub1zcjduepqfhvwcmt7p06fvdgexxhmz0l8c7sgswl7ulv7aulk364x4g5xsw7sr0k2g5
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			pk, err := sdk.GetConsPubKeyBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryMissedBlocksParams(sdk.ConsAddress(pk.Address()))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryMissedBlocks)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var missedBlocks types.ValidatorMissedBlocks
+			cdc.MustUnmarshalJSON(res, &missedBlocks)
+			return cliCtx.PrintOutput(missedBlocks)
+		},
+	}
+}
+
+// GetCmdQueryOffences implements the command to query a validator's downtime
+// offence history.
+func GetCmdQueryOffences(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "offences [validator-conspub]",
+		Short: "Query a validator's downtime offence history",
+		Long: strings.TrimSpace(`Use a validators' consensus public key to list the downtime offences of that validator,
+including the slash fraction and jail duration applied to each of them:
+
+$ <appcli> query slashing offences cosmosvalconspub1zcjduepqfhvwcmt7p06fvdgexxhmz0l8c7sgswl7ulv7aulk364x4g5xsw7sr0k2g5
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			pk, err := sdk.GetConsPubKeyBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryOffencesParams(sdk.ConsAddress(pk.Address()))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryOffences)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var offences types.DowntimeOffences
+			cdc.MustUnmarshalJSON(res, &offences)
+			return cliCtx.PrintOutput(offences)
+		},
+	}
+}
+
 // GetCmdQueryParams implements a command to fetch slashing parameters.
 func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
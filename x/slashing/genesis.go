@@ -34,6 +34,16 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, stakingKeeper types.StakingKeep
 		}
 	}
 
+	for addr, offences := range data.DowntimeOffences {
+		address, err := sdk.ConsAddressFromBech32(addr)
+		if err != nil {
+			panic(err)
+		}
+		for _, offence := range offences {
+			keeper.setValidatorDowntimeOffence(ctx, address, offence)
+		}
+	}
+
 	keeper.paramspace.SetParamSet(ctx, &data.Params)
 }
 
@@ -46,6 +56,7 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) (data types.GenesisState) {
 
 	signingInfos := make(map[string]types.ValidatorSigningInfo)
 	missedBlocks := make(map[string][]types.MissedBlock)
+	downtimeOffences := make(map[string][]types.DowntimeOffence)
 	keeper.IterateValidatorSigningInfos(ctx, func(address sdk.ConsAddress, info types.ValidatorSigningInfo) (stop bool) {
 		bechAddr := address.String()
 		signingInfos[bechAddr] = info
@@ -57,12 +68,17 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) (data types.GenesisState) {
 		})
 		missedBlocks[bechAddr] = localMissedBlocks
 
+		if offences := keeper.GetValidatorDowntimeOffences(ctx, address); len(offences) > 0 {
+			downtimeOffences[bechAddr] = offences
+		}
+
 		return false
 	})
 
 	return types.GenesisState{
-		Params:       params,
-		SigningInfos: signingInfos,
-		MissedBlocks: missedBlocks,
+		Params:           params,
+		SigningInfos:     signingInfos,
+		MissedBlocks:     missedBlocks,
+		DowntimeOffences: downtimeOffences,
 	}
 }
@@ -2,7 +2,6 @@ package slashing
 
 import (
 	"fmt"
-	"time"
 
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/libs/log"
@@ -41,76 +40,9 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
-// handle a validator signing two blocks at the same height
-// power: power of the double-signing validator at the height of infraction
-func (k Keeper) HandleDoubleSign(ctx sdk.Context, addr crypto.Address, infractionHeight int64, timestamp time.Time, power int64) {
-	logger := k.Logger(ctx)
-
-	// calculate the age of the evidence
-	time := ctx.BlockHeader().Time
-	age := time.Sub(timestamp)
-
-	// fetch the validator public key
-	consAddr := sdk.ConsAddress(addr)
-	pubkey, err := k.getPubkey(ctx, addr)
-	if err != nil {
-		// Ignore evidence that cannot be handled.
-		// NOTE:
-		// We used to panic with:
-		// `panic(fmt.Sprintf("Validator consensus-address %v not found", consAddr))`,
-		// but this couples the expectations of the app to both Tendermint and
-		// the simulator.  Both are expected to provide the full range of
-		// allowable but none of the disallowed evidence types.  Instead of
-		// getting this coordination right, it is easier to relax the
-		// constraints and ignore evidence that cannot be handled.
-		return
-	}
-
-	// Reject evidence if the double-sign is too old
-	if age > k.MaxEvidenceAge(ctx) {
-		logger.Info(fmt.Sprintf("Ignored double sign from %s at height %d, age of %d past max age of %d",
-			sdk.ConsAddress(pubkey.Address()), infractionHeight, age, k.MaxEvidenceAge(ctx)))
-		return
-	}
-
-	// Get validator and signing info
-	validator := k.sk.ValidatorByConsAddr(ctx, consAddr)
-	if validator == nil || validator.IsUnbonded() {
-		// Defensive.
-		// Simulation doesn't take unbonding periods into account, and
-		// Tendermint might break this assumption at some point.
-		return
-	}
-
-	// fetch the validator signing info
-	signInfo, found := k.getValidatorSigningInfo(ctx, consAddr)
-	if !found {
-		panic(fmt.Sprintf("Expected signing info for validator %s but not found", consAddr))
-	}
-
-	// validator is already tombstoned
-	if signInfo.Tombstoned {
-		logger.Info(fmt.Sprintf("Ignored double sign from %s at height %d, validator already tombstoned", sdk.ConsAddress(pubkey.Address()), infractionHeight))
-		return
-	}
-
-	// double sign confirmed
-	logger.Info(fmt.Sprintf("Confirmed double sign from %s at height %d, age of %d", sdk.ConsAddress(pubkey.Address()), infractionHeight, age))
-
-	// We need to retrieve the stake distribution which signed the block, so we subtract ValidatorUpdateDelay from the evidence height.
-	// Note that this *can* result in a negative "distributionHeight", up to -ValidatorUpdateDelay,
-	// i.e. at the end of the pre-genesis block (none) = at the beginning of the genesis block.
-	// That's fine since this is just used to filter unbonding delegations & redelegations.
-	distributionHeight := infractionHeight - sdk.ValidatorUpdateDelay
-
-	// get the percentage slash penalty fraction
-	fraction := k.SlashFractionDoubleSign(ctx)
-
-	// Slash validator
-	// `power` is the int64 power of the validator as provided to/by
-	// Tendermint. This value is validator.Tokens as sent to Tendermint via
-	// ABCI, and now received as evidence.
-	// The fraction is passed in to separately to slash unbonding and rebonding delegations.
+// Slash attempts to slash a validator. The slash is delegated to the staking
+// module to make the necessary validator changes.
+func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.Dec, power, distributionHeight int64) {
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSlash,
@@ -119,28 +51,21 @@ func (k Keeper) HandleDoubleSign(ctx sdk.Context, addr crypto.Address, infractio
 			sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueDoubleSign),
 		),
 	)
-	k.sk.Slash(ctx, consAddr, distributionHeight, power, fraction)
 
-	// Jail validator if not already jailed
-	// begin unbonding validator if not already unbonding (tombstone)
-	if !validator.IsJailed() {
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeSlash,
-				sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
-			),
-		)
-		k.sk.Jail(ctx, consAddr)
-	}
-
-	// Set tombstoned to be true
-	signInfo.Tombstoned = true
+	k.sk.Slash(ctx, consAddr, distributionHeight, power, fraction)
+}
 
-	// Set jailed until to be forever (max time)
-	signInfo.JailedUntil = types.DoubleSignJailEndTime
+// Jail attempts to jail a validator. The slash is delegated to the staking
+// module to make the necessary validator changes.
+func (k Keeper) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlash,
+			sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
+		),
+	)
 
-	// Set validator signing info
-	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+	k.sk.Jail(ctx, consAddr)
 }
 
 // handle a validator signature, must be called once per validator per block
@@ -149,7 +74,7 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 	logger := k.Logger(ctx)
 	height := ctx.BlockHeight()
 	consAddr := sdk.ConsAddress(addr)
-	pubkey, err := k.getPubkey(ctx, addr)
+	pubkey, err := k.GetPubkey(ctx, addr)
 	if err != nil {
 		panic(fmt.Sprintf("Validator consensus-address %v not found", consAddr.String()))
 	}
@@ -224,10 +149,21 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 					sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
 				),
 			)
-			k.sk.Slash(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx))
+			// Repeat offenders are penalised according to the number of downtime
+			// jails still counted against them after decay.
+			jailCount := k.decayedDowntimeJailCount(ctx, signInfo)
+			slashFraction, jailDuration := k.GetParams(ctx).DowntimePenalty(jailCount)
+
+			k.sk.Slash(ctx, consAddr, distributionHeight, power, slashFraction)
 			k.sk.Jail(ctx, consAddr)
 
-			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
+			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(jailDuration)
+			signInfo.DowntimeJailCount = jailCount + 1
+			signInfo.LastDowntimeJailTime = ctx.BlockHeader().Time
+
+			k.setValidatorDowntimeOffence(ctx, consAddr, types.NewDowntimeOffence(
+				height, ctx.BlockHeader().Time, signInfo.DowntimeJailCount, slashFraction, jailDuration,
+			))
 
 			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon rebonding.
 			signInfo.MissedBlocksCounter = 0
@@ -244,12 +180,35 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
 }
 
+// decayedDowntimeJailCount returns the downtime jail count of a validator after
+// decrementing it once for every full decay period elapsed since its latest
+// downtime jail. A zero decay period disables the decay.
+func (k Keeper) decayedDowntimeJailCount(ctx sdk.Context, signInfo types.ValidatorSigningInfo) int64 {
+	count := signInfo.DowntimeJailCount
+	decayPeriod := k.DowntimeJailDecayPeriod(ctx)
+	if count <= 0 || decayPeriod <= 0 {
+		return count
+	}
+
+	elapsed := ctx.BlockHeader().Time.Sub(signInfo.LastDowntimeJailTime)
+	if elapsed <= 0 {
+		return count
+	}
+
+	count -= int64(elapsed / decayPeriod)
+	if count < 0 {
+		count = 0
+	}
+	return count
+}
+
 func (k Keeper) addPubkey(ctx sdk.Context, pubkey crypto.PubKey) {
 	addr := pubkey.Address()
 	k.setAddrPubkeyRelation(ctx, addr, pubkey)
 }
 
-func (k Keeper) getPubkey(ctx sdk.Context, address crypto.Address) (crypto.PubKey, error) {
+// GetPubkey returns the pubkey from the address-pubkey relation
+func (k Keeper) GetPubkey(ctx sdk.Context, address crypto.Address) (crypto.PubKey, error) {
 	store := ctx.KVStore(k.storeKey)
 	var pubkey crypto.PubKey
 	err := k.cdc.UnmarshalBinaryLengthPrefixed(store.Get(types.GetAddrPubkeyRelationKey(address)), &pubkey)
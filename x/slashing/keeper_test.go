@@ -21,106 +21,6 @@ func keeperTestParams() types.Params {
 	return params
 }
 
-// ______________________________________________________________
-
-// Test that a validator is slashed correctly
-// when we discover evidence of infraction
-func TestHandleDoubleSign(t *testing.T) {
-
-	// initial setup
-	ctx, ck, sk, _, keeper := createTestInput(t, keeperTestParams())
-	// validator added pre-genesis
-	ctx = ctx.WithBlockHeight(-1)
-	power := int64(100)
-	amt := sdk.TokensFromConsensusPower(power)
-	operatorAddr, val := addrs[0], pks[0]
-	got := staking.NewHandler(sk)(ctx, NewTestMsgCreateValidator(operatorAddr, val, amt))
-	require.True(t, got.IsOK())
-	staking.EndBlocker(ctx, sk)
-	require.Equal(
-		t, ck.GetCoins(ctx, sdk.AccAddress(operatorAddr)),
-		sdk.NewCoins(sdk.NewCoin(sk.GetParams(ctx).BondDenom, initTokens.Sub(amt))),
-	)
-	require.Equal(t, amt, sk.Validator(ctx, operatorAddr).GetBondedTokens())
-
-	// handle a signature to set signing info
-	keeper.HandleValidatorSignature(ctx, val.Address(), amt.Int64(), true)
-
-	oldTokens := sk.Validator(ctx, operatorAddr).GetTokens()
-
-	// double sign less than max age
-	keeper.HandleDoubleSign(ctx, val.Address(), 0, time.Unix(0, 0), power)
-
-	// should be jailed
-	require.True(t, sk.Validator(ctx, operatorAddr).IsJailed())
-
-	// tokens should be decreased
-	newTokens := sk.Validator(ctx, operatorAddr).GetTokens()
-	require.True(t, newTokens.LT(oldTokens))
-
-	// New evidence
-	keeper.HandleDoubleSign(ctx, val.Address(), 0, time.Unix(0, 0), power)
-
-	// tokens should be the same (capped slash)
-	require.True(t, sk.Validator(ctx, operatorAddr).GetTokens().Equal(newTokens))
-
-	// Jump to past the unbonding period
-	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(1, 0).Add(sk.GetParams(ctx).UnbondingTime)})
-
-	// Still shouldn't be able to unjail
-	msgUnjail := types.NewMsgUnjail(operatorAddr)
-	res := handleMsgUnjail(ctx, msgUnjail, keeper)
-	require.False(t, res.IsOK())
-
-	// Should be able to unbond now
-	del, _ := sk.GetDelegation(ctx, sdk.AccAddress(operatorAddr), operatorAddr)
-	validator, _ := sk.GetValidator(ctx, operatorAddr)
-
-	totalBond := validator.TokensFromShares(del.GetShares()).TruncateInt()
-	msgUnbond := staking.NewMsgUndelegate(sdk.AccAddress(operatorAddr), operatorAddr, sdk.NewCoin(sk.GetParams(ctx).BondDenom, totalBond))
-	res = staking.NewHandler(sk)(ctx, msgUnbond)
-	require.True(t, res.IsOK())
-}
-
-// ______________________________________________________________
-
-// Test that a validator is slashed correctly
-// when we discover evidence of infraction
-func TestPastMaxEvidenceAge(t *testing.T) {
-
-	// initial setup
-	ctx, ck, sk, _, keeper := createTestInput(t, keeperTestParams())
-	// validator added pre-genesis
-	ctx = ctx.WithBlockHeight(-1)
-	power := int64(100)
-	amt := sdk.TokensFromConsensusPower(power)
-	operatorAddr, val := addrs[0], pks[0]
-	got := staking.NewHandler(sk)(ctx, NewTestMsgCreateValidator(operatorAddr, val, amt))
-	require.True(t, got.IsOK())
-	staking.EndBlocker(ctx, sk)
-	require.Equal(
-		t, ck.GetCoins(ctx, sdk.AccAddress(operatorAddr)),
-		sdk.NewCoins(sdk.NewCoin(sk.GetParams(ctx).BondDenom, initTokens.Sub(amt))),
-	)
-	require.Equal(t, amt, sk.Validator(ctx, operatorAddr).GetBondedTokens())
-
-	// handle a signature to set signing info
-	keeper.HandleValidatorSignature(ctx, val.Address(), power, true)
-
-	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(1, 0).Add(keeper.MaxEvidenceAge(ctx))})
-
-	oldPower := sk.Validator(ctx, operatorAddr).GetConsensusPower()
-
-	// double sign past max age
-	keeper.HandleDoubleSign(ctx, val.Address(), 0, time.Unix(0, 0), power)
-
-	// should still be bonded
-	require.True(t, sk.Validator(ctx, operatorAddr).IsBonded())
-
-	// should still have same power
-	require.Equal(t, oldPower, sk.Validator(ctx, operatorAddr).GetConsensusPower())
-}
-
 // Test a validator through uptime, downtime, revocation,
 // unrevocation, starting height reset, and revocation again
 func TestHandleAbsentValidator(t *testing.T) {
@@ -472,3 +372,107 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	require.Equal(t, sdk.Unbonding, validator.Status)
 
 }
+
+// Test that repeated downtime offences are penalised according to the
+// escalation schedules and that the downtime jail count decays over time
+func TestHandleRepeatedDowntime(t *testing.T) {
+	params := keeperTestParams()
+	params.SignedBlocksWindow = 100
+	params.DowntimeJailDuration = time.Hour
+	params.DowntimeJailDecayPeriod = 24 * time.Hour
+	params.SlashFractionDowntimeSchedule = []sdk.Dec{sdk.NewDecWithPrec(2, 2), sdk.NewDecWithPrec(5, 2)}
+	params.DowntimeJailDurationSchedule = []time.Duration{2 * time.Hour, 4 * time.Hour}
+
+	// initial setup
+	ctx, _, sk, _, keeper := createTestInput(t, params)
+	power := int64(100)
+	amt := sdk.TokensFromConsensusPower(power)
+	addr, val := addrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	sh := staking.NewHandler(sk)
+	slh := NewHandler(keeper)
+	got := sh(ctx, NewTestMsgCreateValidator(addr, val, amt))
+	require.True(t, got.IsOK())
+	staking.EndBlocker(ctx, sk)
+
+	// first window OK
+	height := int64(0)
+	for ; height < keeper.SignedBlocksWindow(ctx); height++ {
+		ctx = ctx.WithBlockHeight(height)
+		keeper.HandleValidatorSignature(ctx, val.Address(), power, true)
+	}
+
+	// miss blocks until the validator is jailed, then unjail it once the jail expires
+	jailAndUnjail := func(blockTime time.Time) types.ValidatorSigningInfo {
+		ctx = ctx.WithBlockHeader(abci.Header{Time: blockTime})
+		for {
+			ctx = ctx.WithBlockHeight(height)
+			keeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+			height++
+			if validator, _ := sk.GetValidatorByConsAddr(ctx, consAddr); validator.IsJailed() {
+				break
+			}
+		}
+		staking.EndBlocker(ctx, sk)
+
+		info, found := keeper.getValidatorSigningInfo(ctx, consAddr)
+		require.True(t, found)
+
+		ctx = ctx.WithBlockHeader(abci.Header{Time: info.JailedUntil})
+		got := slh(ctx, NewMsgUnjail(addr))
+		require.True(t, got.IsOK(), got.Log)
+		staking.EndBlocker(ctx, sk)
+
+		return info
+	}
+
+	// first offence is penalised with the base parameters
+	start := time.Unix(0, 0).UTC()
+	info := jailAndUnjail(start)
+	require.Equal(t, int64(1), info.DowntimeJailCount)
+	require.Equal(t, start, info.LastDowntimeJailTime)
+	require.Equal(t, start.Add(time.Hour), info.JailedUntil)
+
+	// repeat offences escalate through the schedules
+	info = jailAndUnjail(info.JailedUntil)
+	require.Equal(t, int64(2), info.DowntimeJailCount)
+	require.Equal(t, start.Add(3*time.Hour), info.JailedUntil)
+
+	info = jailAndUnjail(info.JailedUntil)
+	require.Equal(t, int64(3), info.DowntimeJailCount)
+	require.Equal(t, start.Add(7*time.Hour), info.JailedUntil)
+
+	// the last schedule entry applies once the schedule is exhausted
+	info = jailAndUnjail(info.JailedUntil)
+	require.Equal(t, int64(4), info.DowntimeJailCount)
+	require.Equal(t, start.Add(11*time.Hour), info.JailedUntil)
+
+	// after two decay periods without an offence the count drops by two
+	decayed := info.JailedUntil.Add(48 * time.Hour)
+	info = jailAndUnjail(decayed)
+	require.Equal(t, int64(3), info.DowntimeJailCount)
+	require.Equal(t, decayed.Add(4*time.Hour), info.JailedUntil)
+
+	// the offence history records every jail in order
+	offences := keeper.GetValidatorDowntimeOffences(ctx, consAddr)
+	require.Len(t, offences, 5)
+	expected := []struct {
+		jailCount     int64
+		slashFraction sdk.Dec
+		jailDuration  time.Duration
+	}{
+		{1, params.SlashFractionDowntime, time.Hour},
+		{2, sdk.NewDecWithPrec(2, 2), 2 * time.Hour},
+		{3, sdk.NewDecWithPrec(5, 2), 4 * time.Hour},
+		{4, sdk.NewDecWithPrec(5, 2), 4 * time.Hour},
+		{3, sdk.NewDecWithPrec(5, 2), 4 * time.Hour},
+	}
+	for i, offence := range offences {
+		require.Equal(t, expected[i].jailCount, offence.JailCount, "offence %d", i)
+		require.True(t, expected[i].slashFraction.Equal(offence.SlashFraction), "offence %d", i)
+		require.Equal(t, expected[i].jailDuration, offence.JailDuration, "offence %d", i)
+		if i > 0 {
+			require.True(t, offence.Height > offences[i-1].Height)
+		}
+	}
+}
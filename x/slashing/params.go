@@ -7,12 +7,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
 
-// MaxEvidenceAge - max age for evidence
-func (k Keeper) MaxEvidenceAge(ctx sdk.Context) (res time.Duration) {
-	k.paramspace.Get(ctx, types.KeyMaxEvidenceAge, &res)
-	return
-}
-
 // SignedBlocksWindow - sliding window for downtime slashing
 func (k Keeper) SignedBlocksWindow(ctx sdk.Context) (res int64) {
 	k.paramspace.Get(ctx, types.KeySignedBlocksWindow, &res)
@@ -48,6 +42,25 @@ func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// DowntimeJailDecayPeriod - period after which a validator's downtime jail
+// count is decremented
+func (k Keeper) DowntimeJailDecayPeriod(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeyDowntimeJailDecayPeriod, &res)
+	return
+}
+
+// SlashFractionDowntimeSchedule - slash fractions for repeat downtime offences
+func (k Keeper) SlashFractionDowntimeSchedule(ctx sdk.Context) (res []sdk.Dec) {
+	k.paramspace.Get(ctx, types.KeySlashFractionDowntimeSchedule, &res)
+	return
+}
+
+// DowntimeJailDurationSchedule - jail durations for repeat downtime offences
+func (k Keeper) DowntimeJailDurationSchedule(ctx sdk.Context) (res []time.Duration) {
+	k.paramspace.Get(ctx, types.KeyDowntimeJailDurationSchedule, &res)
+	return
+}
+
 // GetParams returns the total set of slashing parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramspace.GetParamSet(ctx, &params)
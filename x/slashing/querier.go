@@ -19,6 +19,10 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return querySigningInfo(ctx, req, k)
 		case QuerySigningInfos:
 			return querySigningInfos(ctx, req, k)
+		case QueryOffences:
+			return queryOffences(ctx, req, k)
+		case QueryMissedBlocks:
+			return queryMissedBlocks(ctx, req, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown staking query endpoint")
 		}
@@ -65,6 +69,10 @@ func querySigningInfos(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
 
+	if params.Page < 1 {
+		params.Page = 1
+	}
+
 	if params.Limit == 0 {
 		// set the default limit to max bonded if no limit was provided
 		params.Limit = int(k.sk.MaxValidators(ctx))
@@ -98,3 +106,56 @@ func querySigningInfos(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte
 
 	return res, nil
 }
+
+func queryOffences(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryOffencesParams
+
+	err := ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if !k.HasValidatorSigningInfo(ctx, params.ConsAddress) {
+		return nil, ErrNoSigningInfoFound(DefaultCodespace, params.ConsAddress)
+	}
+
+	offences := k.GetValidatorDowntimeOffences(ctx, params.ConsAddress)
+	if offences == nil {
+		offences = []DowntimeOffence{}
+	}
+
+	res, err := codec.MarshalJSONIndent(ModuleCdc, offences)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryMissedBlocks(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryMissedBlocksParams
+
+	err := ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	signingInfo, found := k.getValidatorSigningInfo(ctx, params.ConsAddress)
+	if !found {
+		return nil, ErrNoSigningInfoFound(DefaultCodespace, params.ConsAddress)
+	}
+
+	signedBlocksWindow := k.SignedBlocksWindow(ctx)
+	missedBlocks := NewValidatorMissedBlocks(
+		params.ConsAddress, signedBlocksWindow, signingInfo.IndexOffset,
+		signingInfo.MissedBlocksCounter, signedBlocksWindow-k.MinSignedPerWindow(ctx),
+		k.GetValidatorMissedBlocks(ctx, params.ConsAddress),
+	)
+
+	res, err := codec.MarshalJSONIndent(ModuleCdc, missedBlocks)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
@@ -2,11 +2,13 @@ package slashing
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestNewQuerier(t *testing.T) {
@@ -35,3 +37,131 @@ func TestQueryParams(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, keeper.GetParams(ctx), params)
 }
+
+func TestQueryOffences(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, _, _, keeper := createTestInput(t, keeperTestParams())
+	consAddr := sdk.ConsAddress(pks[0].Address())
+
+	bz, err := cdc.MarshalJSON(NewQueryOffencesParams(consAddr))
+	require.NoError(t, err)
+	query := abci.RequestQuery{Data: bz}
+
+	// unknown validator
+	_, errRes := queryOffences(ctx, query, keeper)
+	require.Error(t, errRes)
+
+	keeper.SetValidatorSigningInfo(ctx, consAddr, NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0), false, 0))
+
+	var offences []DowntimeOffence
+	res, errRes := queryOffences(ctx, query, keeper)
+	require.NoError(t, errRes)
+	require.NoError(t, cdc.UnmarshalJSON(res, &offences))
+	require.Empty(t, offences)
+
+	first := NewDowntimeOffence(20, time.Unix(20, 0).UTC(), 1, sdk.NewDecWithPrec(1, 2), time.Hour)
+	second := NewDowntimeOffence(10, time.Unix(10, 0).UTC(), 1, sdk.NewDecWithPrec(1, 2), time.Hour)
+	keeper.setValidatorDowntimeOffence(ctx, consAddr, first)
+	keeper.setValidatorDowntimeOffence(ctx, consAddr, second)
+
+	res, errRes = queryOffences(ctx, query, keeper)
+	require.NoError(t, errRes)
+	require.NoError(t, cdc.UnmarshalJSON(res, &offences))
+	require.Equal(t, []DowntimeOffence{second, first}, offences)
+}
+
+func TestQuerySigningInfos(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, _, _, keeper := createTestInput(t, keeperTestParams())
+
+	for i, pk := range pks {
+		consAddr := sdk.ConsAddress(pk.Address())
+		keeper.SetValidatorSigningInfo(ctx, consAddr, NewValidatorSigningInfo(consAddr, int64(i), 0, time.Unix(0, 0), false, 0))
+	}
+
+	tests := []struct {
+		page, limit int
+		expected    int
+	}{
+		{1, 2, 2},
+		{2, 2, 1},
+		{3, 2, 0},
+		{0, 2, 2},
+		{1, 0, len(pks)},
+	}
+
+	for _, tc := range tests {
+		bz, err := cdc.MarshalJSON(NewQuerySigningInfosParams(tc.page, tc.limit))
+		require.NoError(t, err)
+
+		res, errRes := querySigningInfos(ctx, abci.RequestQuery{Data: bz}, keeper)
+		require.NoError(t, errRes)
+
+		var signingInfos []ValidatorSigningInfo
+		require.NoError(t, cdc.UnmarshalJSON(res, &signingInfos))
+		require.Len(t, signingInfos, tc.expected, "page %d limit %d", tc.page, tc.limit)
+	}
+}
+
+func TestQuerySigningInfosPageClamp(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, _, _, keeper := createTestInput(t, keeperTestParams())
+
+	for i, pk := range pks {
+		consAddr := sdk.ConsAddress(pk.Address())
+		keeper.SetValidatorSigningInfo(ctx, consAddr, NewValidatorSigningInfo(consAddr, int64(i), 0, time.Unix(0, 0), false, 0))
+	}
+
+	query := func(page int) []ValidatorSigningInfo {
+		bz, err := cdc.MarshalJSON(NewQuerySigningInfosParams(page, 2))
+		require.NoError(t, err)
+
+		res, errRes := querySigningInfos(ctx, abci.RequestQuery{Data: bz}, keeper)
+		require.NoError(t, errRes)
+
+		var signingInfos []ValidatorSigningInfo
+		require.NoError(t, cdc.UnmarshalJSON(res, &signingInfos))
+		return signingInfos
+	}
+
+	// pages lower than 1 return the first page
+	firstPage := query(1)
+	require.Len(t, firstPage, 2)
+	require.Equal(t, firstPage, query(0))
+	require.Equal(t, firstPage, query(-1))
+}
+
+func TestQueryMissedBlocks(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, _, _, keeper := createTestInput(t, keeperTestParams())
+	consAddr := sdk.ConsAddress(pks[0].Address())
+
+	bz, err := cdc.MarshalJSON(NewQueryMissedBlocksParams(consAddr))
+	require.NoError(t, err)
+	query := abci.RequestQuery{Data: bz}
+
+	// unknown validator
+	_, errRes := queryMissedBlocks(ctx, query, keeper)
+	require.Error(t, errRes)
+
+	keeper.SetValidatorSigningInfo(ctx, consAddr, NewValidatorSigningInfo(consAddr, 0, 12, time.Unix(0, 0), false, 2))
+	keeper.setValidatorMissedBlockBitArray(ctx, consAddr, 3, true)
+	keeper.setValidatorMissedBlockBitArray(ctx, consAddr, 4, false)
+	keeper.setValidatorMissedBlockBitArray(ctx, consAddr, 11, true)
+
+	res, errRes := queryMissedBlocks(ctx, query, keeper)
+	require.NoError(t, errRes)
+
+	var missedBlocks ValidatorMissedBlocks
+	require.NoError(t, cdc.UnmarshalJSON(res, &missedBlocks))
+	require.Equal(t, consAddr, missedBlocks.Address)
+	require.Equal(t, keeper.SignedBlocksWindow(ctx), missedBlocks.SignedBlocksWindow)
+	require.Equal(t, int64(12), missedBlocks.IndexOffset)
+	require.Equal(t, int64(2), missedBlocks.MissedBlocksCounter)
+	require.Equal(t, keeper.SignedBlocksWindow(ctx)-keeper.MinSignedPerWindow(ctx), missedBlocks.MaxMissedBlocks)
+	require.Equal(t, int(keeper.SignedBlocksWindow(ctx)), missedBlocks.MissedBlocks.Size())
+
+	for i := 0; i < missedBlocks.MissedBlocks.Size(); i++ {
+		require.Equal(t, i == 3 || i == 11, missedBlocks.MissedBlocks.GetIndex(i), "index %d", i)
+	}
+}
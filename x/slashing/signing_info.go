@@ -1,6 +1,10 @@
 package slashing
 
 import (
+	"time"
+
+	"github.com/tendermint/tendermint/crypto/multisig/bitarray"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
@@ -42,6 +46,51 @@ func (k Keeper) SetValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress
 	store.Set(types.GetValidatorSigningInfoKey(address), bz)
 }
 
+// HasValidatorSigningInfo returns if a given validator has signing information
+// persisted.
+func (k Keeper) HasValidatorSigningInfo(ctx sdk.Context, consAddr sdk.ConsAddress) bool {
+	_, ok := k.getValidatorSigningInfo(ctx, consAddr)
+	return ok
+}
+
+// JailUntil attempts to set a validator's JailedUntil attribute in its signing
+// info. It will panic if the signing info does not exist for the validator.
+func (k Keeper) JailUntil(ctx sdk.Context, consAddr sdk.ConsAddress, jailTime time.Time) {
+	signInfo, ok := k.getValidatorSigningInfo(ctx, consAddr)
+	if !ok {
+		panic("cannot jail validator that does not have any signing information")
+	}
+
+	signInfo.JailedUntil = jailTime
+	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+}
+
+// Tombstone attempts to tombstone a validator. It will panic if signing info for
+// the given validator does not exist.
+func (k Keeper) Tombstone(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	signInfo, ok := k.getValidatorSigningInfo(ctx, consAddr)
+	if !ok {
+		panic("cannot tombstone validator that does not have any signing information")
+	}
+
+	if signInfo.Tombstoned {
+		panic("cannot tombstone validator that is already tombstoned")
+	}
+
+	signInfo.Tombstoned = true
+	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+}
+
+// IsTombstoned returns if a given validator by consensus address is tombstoned.
+func (k Keeper) IsTombstoned(ctx sdk.Context, consAddr sdk.ConsAddress) bool {
+	signInfo, ok := k.getValidatorSigningInfo(ctx, consAddr)
+	if !ok {
+		return false
+	}
+
+	return signInfo.Tombstoned
+}
+
 // Stored by *validator* address (not operator address)
 func (k Keeper) getValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress, index int64) (missed bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -75,6 +124,17 @@ func (k Keeper) IterateValidatorMissedBlockBitArray(ctx sdk.Context,
 	}
 }
 
+// GetValidatorMissedBlocks returns the missed blocks bitmap of a validator over
+// the current signed blocks window.
+func (k Keeper) GetValidatorMissedBlocks(ctx sdk.Context, address sdk.ConsAddress) *bitarray.CompactBitArray {
+	missedBlocks := bitarray.NewCompactBitArray(int(k.SignedBlocksWindow(ctx)))
+	k.IterateValidatorMissedBlockBitArray(ctx, address, func(index int64, missed bool) (stop bool) {
+		missedBlocks.SetIndex(int(index), missed)
+		return false
+	})
+	return missedBlocks
+}
+
 // Stored by *validator* address (not operator address)
 func (k Keeper) setValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress, index int64, missed bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -91,3 +151,64 @@ func (k Keeper) clearValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.C
 		store.Delete(iter.Key())
 	}
 }
+
+// Stored by *validator* address (not operator address) and height
+func (k Keeper) setValidatorDowntimeOffence(ctx sdk.Context, address sdk.ConsAddress, offence types.DowntimeOffence) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(offence)
+	store.Set(types.GetValidatorDowntimeOffenceKey(address, offence.Height), bz)
+	store.Set(types.GetDowntimeOffenceQueueKey(offence.Time, address, offence.Height), []byte{0x01})
+}
+
+// pruneDowntimeOffences deletes the downtime offences that no longer count
+// against a validator, i.e. whose downtime jail has decayed. Nothing is pruned
+// if the decay is disabled, as every offence still counts.
+func (k Keeper) pruneDowntimeOffences(ctx sdk.Context) {
+	decayPeriod := k.DowntimeJailDecayPeriod(ctx)
+	if decayPeriod <= 0 {
+		return
+	}
+
+	// collect the decayed offences before modifying the store
+	var keys [][]byte
+	store := ctx.KVStore(k.storeKey)
+	end := sdk.PrefixEndBytes(types.GetDowntimeOffenceQueueTimeKey(ctx.BlockHeader().Time.Add(-decayPeriod)))
+	iter := store.Iterator(types.DowntimeOffenceQueueKey, end)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		address, height := types.SplitDowntimeOffenceQueueKey(key)
+		store.Delete(types.GetValidatorDowntimeOffenceKey(address, height))
+		store.Delete(key)
+	}
+}
+
+// IterateValidatorDowntimeOffences iterates over the downtime offences of a
+// validator in chronological order
+func (k Keeper) IterateValidatorDowntimeOffences(ctx sdk.Context,
+	address sdk.ConsAddress, handler func(offence types.DowntimeOffence) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetValidatorDowntimeOffencePrefixKey(address))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var offence types.DowntimeOffence
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &offence)
+		if handler(offence) {
+			break
+		}
+	}
+}
+
+// GetValidatorDowntimeOffences returns the downtime offence history of a
+// validator in chronological order
+func (k Keeper) GetValidatorDowntimeOffences(ctx sdk.Context, address sdk.ConsAddress) (offences []types.DowntimeOffence) {
+	k.IterateValidatorDowntimeOffences(ctx, address, func(offence types.DowntimeOffence) bool {
+		offences = append(offences, offence)
+		return false
+	})
+	return offences
+}
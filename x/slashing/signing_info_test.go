@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -38,3 +39,84 @@ func TestGetSetValidatorMissedBlockBitArray(t *testing.T) {
 	missed = keeper.getValidatorMissedBlockBitArray(ctx, sdk.ConsAddress(addrs[0]), 0)
 	require.True(t, missed) // now should be missed
 }
+
+func TestTombstoned(t *testing.T) {
+	ctx, _, _, _, keeper := createTestInput(t, DefaultParams())
+	consAddr := sdk.ConsAddress(addrs[0])
+	require.Panics(t, func() { keeper.Tombstone(ctx, consAddr) })
+	require.False(t, keeper.IsTombstoned(ctx, consAddr))
+	require.False(t, keeper.HasValidatorSigningInfo(ctx, consAddr))
+
+	newInfo := NewValidatorSigningInfo(consAddr, int64(4), int64(3), time.Unix(2, 0), false, int64(10))
+	keeper.SetValidatorSigningInfo(ctx, consAddr, newInfo)
+	require.True(t, keeper.HasValidatorSigningInfo(ctx, consAddr))
+
+	require.False(t, keeper.IsTombstoned(ctx, consAddr))
+	keeper.Tombstone(ctx, consAddr)
+	require.True(t, keeper.IsTombstoned(ctx, consAddr))
+	require.Panics(t, func() { keeper.Tombstone(ctx, consAddr) })
+}
+
+func TestJailUntil(t *testing.T) {
+	ctx, _, _, _, keeper := createTestInput(t, DefaultParams())
+	consAddr := sdk.ConsAddress(addrs[0])
+	require.Panics(t, func() { keeper.JailUntil(ctx, consAddr, time.Now()) })
+
+	newInfo := NewValidatorSigningInfo(consAddr, int64(4), int64(3), time.Unix(2, 0), false, int64(10))
+	keeper.SetValidatorSigningInfo(ctx, consAddr, newInfo)
+	keeper.JailUntil(ctx, consAddr, time.Unix(253402300799, 0).UTC())
+
+	info, ok := keeper.getValidatorSigningInfo(ctx, consAddr)
+	require.True(t, ok)
+	require.Equal(t, time.Unix(253402300799, 0).UTC(), info.JailedUntil)
+}
+
+func TestPruneDowntimeOffences(t *testing.T) {
+	params := DefaultParams()
+	params.DowntimeJailDecayPeriod = 24 * time.Hour
+	ctx, _, _, _, keeper := createTestInput(t, params)
+
+	start := time.Unix(0, 0).UTC()
+	consAddrs := []sdk.ConsAddress{sdk.ConsAddress(addrs[0]), sdk.ConsAddress(addrs[1])}
+	for i, offset := range []time.Duration{0, time.Hour, 25 * time.Hour} {
+		offence := NewDowntimeOffence(int64(i+1), start.Add(offset), int64(i+1), params.SlashFractionDowntime, time.Hour)
+		keeper.setValidatorDowntimeOffence(ctx, consAddrs[0], offence)
+		keeper.setValidatorDowntimeOffence(ctx, consAddrs[1], offence)
+	}
+
+	// no offence is older than the decay period yet
+	ctx = ctx.WithBlockHeader(abci.Header{Time: start.Add(24*time.Hour - time.Second)})
+	keeper.pruneDowntimeOffences(ctx)
+	for _, consAddr := range consAddrs {
+		require.Len(t, keeper.GetValidatorDowntimeOffences(ctx, consAddr), 3)
+	}
+
+	// an offence is pruned once a full decay period has elapsed
+	ctx = ctx.WithBlockHeader(abci.Header{Time: start.Add(25 * time.Hour)})
+	keeper.pruneDowntimeOffences(ctx)
+	for _, consAddr := range consAddrs {
+		offences := keeper.GetValidatorDowntimeOffences(ctx, consAddr)
+		require.Len(t, offences, 1)
+		require.Equal(t, int64(3), offences[0].Height)
+	}
+
+	// nothing is pruned while the decay is disabled
+	keeper.paramspace.Set(ctx, KeyDowntimeJailDecayPeriod, time.Duration(0))
+	ctx = ctx.WithBlockHeader(abci.Header{Time: start.Add(100 * time.Hour)})
+	keeper.pruneDowntimeOffences(ctx)
+	for _, consAddr := range consAddrs {
+		require.Len(t, keeper.GetValidatorDowntimeOffences(ctx, consAddr), 1)
+	}
+
+	// the pruning queue holds the remaining offences only
+	var queued int
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(keeper.storeKey), DowntimeOffenceQueueKey)
+	for ; iter.Valid(); iter.Next() {
+		consAddr, height := SplitDowntimeOffenceQueueKey(iter.Key())
+		require.Contains(t, consAddrs, consAddr)
+		require.Equal(t, int64(3), height)
+		queued++
+	}
+	iter.Close()
+	require.Equal(t, 2, queued)
+}
@@ -3,25 +3,25 @@ package types
 import (
 	"fmt"
 	"time"
-
-	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // GenesisState - all slashing state that must be provided at genesis
 type GenesisState struct {
-	Params       Params                          `json:"params"`
-	SigningInfos map[string]ValidatorSigningInfo `json:"signing_infos"`
-	MissedBlocks map[string][]MissedBlock        `json:"missed_blocks"`
+	Params           Params                          `json:"params"`
+	SigningInfos     map[string]ValidatorSigningInfo `json:"signing_infos"`
+	MissedBlocks     map[string][]MissedBlock        `json:"missed_blocks"`
+	DowntimeOffences map[string][]DowntimeOffence    `json:"downtime_offences"`
 }
 
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(params Params, signingInfos map[string]ValidatorSigningInfo,
-	missedBlocks map[string][]MissedBlock) GenesisState {
+	missedBlocks map[string][]MissedBlock, downtimeOffences map[string][]DowntimeOffence) GenesisState {
 
 	return GenesisState{
-		Params:       params,
-		SigningInfos: signingInfos,
-		MissedBlocks: missedBlocks,
+		Params:           params,
+		SigningInfos:     signingInfos,
+		MissedBlocks:     missedBlocks,
+		DowntimeOffences: downtimeOffences,
 	}
 }
 
@@ -34,32 +34,17 @@ type MissedBlock struct {
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Params:       DefaultParams(),
-		SigningInfos: make(map[string]ValidatorSigningInfo),
-		MissedBlocks: make(map[string][]MissedBlock),
+		Params:           DefaultParams(),
+		SigningInfos:     make(map[string]ValidatorSigningInfo),
+		MissedBlocks:     make(map[string][]MissedBlock),
+		DowntimeOffences: make(map[string][]DowntimeOffence),
 	}
 }
 
 // ValidateGenesis validates the slashing genesis parameters
 func ValidateGenesis(data GenesisState) error {
-	downtime := data.Params.SlashFractionDowntime
-	if downtime.IsNegative() || downtime.GT(sdk.OneDec()) {
-		return fmt.Errorf("Slashing fraction downtime should be less than or equal to one and greater than zero, is %s", downtime.String())
-	}
-
-	dblSign := data.Params.SlashFractionDoubleSign
-	if dblSign.IsNegative() || dblSign.GT(sdk.OneDec()) {
-		return fmt.Errorf("Slashing fraction double sign should be less than or equal to one and greater than zero, is %s", dblSign.String())
-	}
-
-	minSign := data.Params.MinSignedPerWindow
-	if minSign.IsNegative() || minSign.GT(sdk.OneDec()) {
-		return fmt.Errorf("Min signed per window should be less than or equal to one and greater than zero, is %s", minSign.String())
-	}
-
-	maxEvidence := data.Params.MaxEvidenceAge
-	if maxEvidence < 1*time.Minute {
-		return fmt.Errorf("Max evidence age must be at least 1 minute, is %s", maxEvidence.String())
+	if err := data.Params.Validate(); err != nil {
+		return err
 	}
 
 	downtimeJail := data.Params.DowntimeJailDuration
@@ -72,5 +57,11 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("Signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	for i, duration := range data.Params.DowntimeJailDurationSchedule {
+		if duration < 1*time.Minute {
+			return fmt.Errorf("Downtime jail duration schedule entries must be at least 1 minute, entry %d is %s", i, duration.String())
+		}
+	}
+
 	return nil
 }
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/binary"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -25,6 +26,8 @@ const (
 	QueryParameters   = "parameters"
 	QuerySigningInfo  = "signingInfo"
 	QuerySigningInfos = "signingInfos"
+	QueryOffences     = "offences"
+	QueryMissedBlocks = "missedBlocks"
 )
 
 // Keys for slashing store
@@ -35,12 +38,20 @@ const (
 // - 0x02<consAddress_Bytes><period_Bytes>: bool
 //
 // - 0x03<accAddr_Bytes>: crypto.PubKey
+//
+// - 0x04<consAddress_Bytes><height_Bytes>: DowntimeOffence
+//
+// - 0x05<time_Bytes><consAddress_Bytes><height_Bytes>: 0x01
 var (
 	ValidatorSigningInfoKey         = []byte{0x01} // Prefix for signing info
 	ValidatorMissedBlockBitArrayKey = []byte{0x02} // Prefix for missed block bit array
 	AddrPubkeyRelationKey           = []byte{0x03} // Prefix for address-pubkey relation
+	ValidatorDowntimeOffenceKey     = []byte{0x04} // Prefix for downtime offence history
+	DowntimeOffenceQueueKey         = []byte{0x05} // Prefix for the downtime offence pruning queue
 )
 
+var lenTime = len(sdk.FormatTimeBytes(time.Now()))
+
 // stored by *Consensus* address (not operator address)
 func GetValidatorSigningInfoKey(v sdk.ConsAddress) []byte {
 	return append(ValidatorSigningInfoKey, v.Bytes()...)
@@ -71,3 +82,37 @@ func GetValidatorMissedBlockBitArrayKey(v sdk.ConsAddress, i int64) []byte {
 func GetAddrPubkeyRelationKey(address []byte) []byte {
 	return append(AddrPubkeyRelationKey, address...)
 }
+
+// stored by *Consensus* address (not operator address)
+func GetValidatorDowntimeOffencePrefixKey(v sdk.ConsAddress) []byte {
+	return append(ValidatorDowntimeOffenceKey, v.Bytes()...)
+}
+
+// stored by *Consensus* address (not operator address) and height, so that
+// iterating over the prefix yields the offences in chronological order
+func GetValidatorDowntimeOffenceKey(v sdk.ConsAddress, height int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(height))
+	return append(GetValidatorDowntimeOffencePrefixKey(v), b...)
+}
+
+// gets the prefix of the downtime offences queued at the given time
+func GetDowntimeOffenceQueueTimeKey(t time.Time) []byte {
+	return append(DowntimeOffenceQueueKey, sdk.FormatTimeBytes(t)...)
+}
+
+// stored by the time of the offence, so that the offences can be pruned in
+// chronological order
+func GetDowntimeOffenceQueueKey(t time.Time, v sdk.ConsAddress, height int64) []byte {
+	key := GetValidatorDowntimeOffenceKey(v, height)
+	return append(GetDowntimeOffenceQueueTimeKey(t), key[1:]...)
+}
+
+// extract the address and height from a downtime offence queue key
+func SplitDowntimeOffenceQueueKey(key []byte) (v sdk.ConsAddress, height int64) {
+	if len(key) != 1+lenTime+sdk.AddrLen+8 {
+		panic("unexpected key length")
+	}
+	addr := key[1+lenTime : 1+lenTime+sdk.AddrLen]
+	return sdk.ConsAddress(addr), int64(binary.BigEndian.Uint64(key[1+lenTime+sdk.AddrLen:]))
+}
@@ -11,9 +11,12 @@ import (
 // Default parameter namespace
 const (
 	DefaultParamspace                         = ModuleName
-	DefaultMaxEvidenceAge       time.Duration = 60 * 2 * time.Second
 	DefaultSignedBlocksWindow   int64         = 100
 	DefaultDowntimeJailDuration time.Duration = 60 * 10 * time.Second
+
+	// DefaultDowntimeJailDecayPeriod is the period after which a validator's
+	// downtime jail count is decremented by one.
+	DefaultDowntimeJailDecayPeriod time.Duration = 60 * 60 * 24 * 30 * time.Second
 )
 
 // The Double Sign Jail period ends at Max Time supported by Amino (Dec 31, 9999 - 23:59:59 GMT)
@@ -22,16 +25,24 @@ var (
 	DefaultMinSignedPerWindow      = sdk.NewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = sdk.NewDec(1).Quo(sdk.NewDec(20))
 	DefaultSlashFractionDowntime   = sdk.NewDec(1).Quo(sdk.NewDec(100))
+
+	// escalation schedules are empty by default so that repeat offences are
+	// penalised with the base downtime parameters
+	DefaultSlashFractionDowntimeSchedule = []sdk.Dec{}
+	DefaultDowntimeJailDurationSchedule  = []time.Duration{}
 )
 
 // Parameter store keys
 var (
-	KeyMaxEvidenceAge          = []byte("MaxEvidenceAge")
 	KeySignedBlocksWindow      = []byte("SignedBlocksWindow")
 	KeyMinSignedPerWindow      = []byte("MinSignedPerWindow")
 	KeyDowntimeJailDuration    = []byte("DowntimeJailDuration")
 	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
 	KeySlashFractionDowntime   = []byte("SlashFractionDowntime")
+
+	KeyDowntimeJailDecayPeriod       = []byte("DowntimeJailDecayPeriod")
+	KeySlashFractionDowntimeSchedule = []byte("SlashFractionDowntimeSchedule")
+	KeyDowntimeJailDurationSchedule  = []byte("DowntimeJailDurationSchedule")
 )
 
 // ParamKeyTable for slashing module
@@ -40,63 +51,248 @@ func ParamKeyTable() params.KeyTable {
 }
 
 // Params - used for initializing default parameter for slashing at genesis
+//
+// The downtime schedules escalate the penalty of repeat offenders: the n-th
+// downtime offence (n >= 2) counted within the decay period is penalised with
+// the (n-1)-th schedule entry, or the last entry if the schedule is shorter.
+// The first offence, and every offence when a schedule is empty, uses the base
+// SlashFractionDowntime and DowntimeJailDuration.
 type Params struct {
-	MaxEvidenceAge          time.Duration `json:"max_evidence_age"`
-	SignedBlocksWindow      int64         `json:"signed_blocks_window"`
-	MinSignedPerWindow      sdk.Dec       `json:"min_signed_per_window"`
-	DowntimeJailDuration    time.Duration `json:"downtime_jail_duration"`
-	SlashFractionDoubleSign sdk.Dec       `json:"slash_fraction_double_sign"`
-	SlashFractionDowntime   sdk.Dec       `json:"slash_fraction_downtime"`
+	SignedBlocksWindow            int64           `json:"signed_blocks_window"`
+	MinSignedPerWindow            sdk.Dec         `json:"min_signed_per_window"`
+	DowntimeJailDuration          time.Duration   `json:"downtime_jail_duration"`
+	SlashFractionDoubleSign       sdk.Dec         `json:"slash_fraction_double_sign"`
+	SlashFractionDowntime         sdk.Dec         `json:"slash_fraction_downtime"`
+	DowntimeJailDecayPeriod       time.Duration   `json:"downtime_jail_decay_period"`       // period after which the downtime jail count is decremented, zero disables decay
+	SlashFractionDowntimeSchedule []sdk.Dec       `json:"slash_fraction_downtime_schedule"` // slash fractions applied to repeat downtime offences
+	DowntimeJailDurationSchedule  []time.Duration `json:"downtime_jail_duration_schedule"`  // jail durations applied to repeat downtime offences
 }
 
 // NewParams creates a new Params object
-func NewParams(maxEvidenceAge time.Duration, signedBlocksWindow int64,
+func NewParams(signedBlocksWindow int64,
 	minSignedPerWindow sdk.Dec, downtimeJailDuration time.Duration,
-	slashFractionDoubleSign sdk.Dec, slashFractionDowntime sdk.Dec) Params {
+	slashFractionDoubleSign sdk.Dec, slashFractionDowntime sdk.Dec,
+	downtimeJailDecayPeriod time.Duration, slashFractionDowntimeSchedule []sdk.Dec,
+	downtimeJailDurationSchedule []time.Duration) Params {
 
 	return Params{
-		MaxEvidenceAge:          maxEvidenceAge,
-		SignedBlocksWindow:      signedBlocksWindow,
-		MinSignedPerWindow:      minSignedPerWindow,
-		DowntimeJailDuration:    downtimeJailDuration,
-		SlashFractionDoubleSign: slashFractionDoubleSign,
-		SlashFractionDowntime:   slashFractionDowntime,
+		SignedBlocksWindow:            signedBlocksWindow,
+		MinSignedPerWindow:            minSignedPerWindow,
+		DowntimeJailDuration:          downtimeJailDuration,
+		SlashFractionDoubleSign:       slashFractionDoubleSign,
+		SlashFractionDowntime:         slashFractionDowntime,
+		DowntimeJailDecayPeriod:       downtimeJailDecayPeriod,
+		SlashFractionDowntimeSchedule: slashFractionDowntimeSchedule,
+		DowntimeJailDurationSchedule:  downtimeJailDurationSchedule,
 	}
 }
 
 func (p Params) String() string {
 	return fmt.Sprintf(`Slashing Params:
-  MaxEvidenceAge:          %s
-  SignedBlocksWindow:      %d
-  MinSignedPerWindow:      %s
-  DowntimeJailDuration:    %s
-  SlashFractionDoubleSign: %s
-  SlashFractionDowntime:   %s`, p.MaxEvidenceAge,
-		p.SignedBlocksWindow, p.MinSignedPerWindow,
-		p.DowntimeJailDuration, p.SlashFractionDoubleSign,
-		p.SlashFractionDowntime)
+  SignedBlocksWindow:            %d
+  MinSignedPerWindow:            %s
+  DowntimeJailDuration:          %s
+  SlashFractionDoubleSign:       %s
+  SlashFractionDowntime:         %s
+  DowntimeJailDecayPeriod:       %s
+  SlashFractionDowntimeSchedule: %v
+  DowntimeJailDurationSchedule:  %v`, p.SignedBlocksWindow,
+		p.MinSignedPerWindow, p.DowntimeJailDuration,
+		p.SlashFractionDoubleSign, p.SlashFractionDowntime,
+		p.DowntimeJailDecayPeriod, p.SlashFractionDowntimeSchedule,
+		p.DowntimeJailDurationSchedule)
+}
+
+// DowntimePenalty returns the slash fraction and jail duration for a downtime
+// offence committed by a validator with the given number of prior downtime
+// jails still counted against it.
+func (p Params) DowntimePenalty(priorJailCount int64) (sdk.Dec, time.Duration) {
+	fraction, duration := p.SlashFractionDowntime, p.DowntimeJailDuration
+	if priorJailCount <= 0 {
+		return fraction, duration
+	}
+
+	if n := int64(len(p.SlashFractionDowntimeSchedule)); n > 0 {
+		fraction = p.SlashFractionDowntimeSchedule[minInt64(priorJailCount, n)-1]
+	}
+	if n := int64(len(p.DowntimeJailDurationSchedule)); n > 0 {
+		duration = p.DowntimeJailDurationSchedule[minInt64(priorJailCount, n)-1]
+	}
+
+	return fraction, duration
+}
+
+func minInt64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
 }
 
 // Implements params.ParamSet
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
-		{KeyMaxEvidenceAge, &p.MaxEvidenceAge},
-		{KeySignedBlocksWindow, &p.SignedBlocksWindow},
-		{KeyMinSignedPerWindow, &p.MinSignedPerWindow},
-		{KeyDowntimeJailDuration, &p.DowntimeJailDuration},
-		{KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign},
-		{KeySlashFractionDowntime, &p.SlashFractionDowntime},
+		params.NewParamSetPair(KeySignedBlocksWindow, &p.SignedBlocksWindow, validateSignedBlocksWindow),
+		params.NewParamSetPair(KeyMinSignedPerWindow, &p.MinSignedPerWindow, validateMinSignedPerWindow),
+		params.NewParamSetPair(KeyDowntimeJailDuration, &p.DowntimeJailDuration, validateDowntimeJailDuration),
+		params.NewParamSetPair(KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign, validateSlashFractionDoubleSign),
+		params.NewParamSetPair(KeySlashFractionDowntime, &p.SlashFractionDowntime, validateSlashFractionDowntime),
+		params.NewParamSetPair(KeyDowntimeJailDecayPeriod, &p.DowntimeJailDecayPeriod, validateDowntimeJailDecayPeriod),
+		params.NewParamSetPair(KeySlashFractionDowntimeSchedule, &p.SlashFractionDowntimeSchedule, validateSlashFractionDowntimeSchedule),
+		params.NewParamSetPair(KeyDowntimeJailDurationSchedule, &p.DowntimeJailDurationSchedule, validateDowntimeJailDurationSchedule),
 	}
 }
 
 // Default parameters for this module
 func DefaultParams() Params {
 	return Params{
-		MaxEvidenceAge:          DefaultMaxEvidenceAge,
-		SignedBlocksWindow:      DefaultSignedBlocksWindow,
-		MinSignedPerWindow:      DefaultMinSignedPerWindow,
-		DowntimeJailDuration:    DefaultDowntimeJailDuration,
-		SlashFractionDoubleSign: DefaultSlashFractionDoubleSign,
-		SlashFractionDowntime:   DefaultSlashFractionDowntime,
+		SignedBlocksWindow:            DefaultSignedBlocksWindow,
+		MinSignedPerWindow:            DefaultMinSignedPerWindow,
+		DowntimeJailDuration:          DefaultDowntimeJailDuration,
+		SlashFractionDoubleSign:       DefaultSlashFractionDoubleSign,
+		SlashFractionDowntime:         DefaultSlashFractionDowntime,
+		DowntimeJailDecayPeriod:       DefaultDowntimeJailDecayPeriod,
+		SlashFractionDowntimeSchedule: DefaultSlashFractionDowntimeSchedule,
+		DowntimeJailDurationSchedule:  DefaultDowntimeJailDurationSchedule,
+	}
+}
+
+// Validate performs basic validation on slashing parameters
+func (p Params) Validate() error {
+	if err := validateSignedBlocksWindow(p.SignedBlocksWindow); err != nil {
+		return err
+	}
+	if err := validateMinSignedPerWindow(p.MinSignedPerWindow); err != nil {
+		return err
+	}
+	if err := validateDowntimeJailDuration(p.DowntimeJailDuration); err != nil {
+		return err
+	}
+	if err := validateSlashFractionDoubleSign(p.SlashFractionDoubleSign); err != nil {
+		return err
+	}
+	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
+		return err
+	}
+	if err := validateDowntimeJailDecayPeriod(p.DowntimeJailDecayPeriod); err != nil {
+		return err
+	}
+	if err := validateSlashFractionDowntimeSchedule(p.SlashFractionDowntimeSchedule); err != nil {
+		return err
+	}
+	return validateDowntimeJailDurationSchedule(p.DowntimeJailDurationSchedule)
+}
+
+func validateSignedBlocksWindow(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("Signed blocks window must be positive, is %d", v)
+	}
+
+	return nil
+}
+
+func validateMinSignedPerWindow(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("Min signed per window should be less than or equal to one and greater than zero, is %s", v)
+	}
+
+	return nil
+}
+
+func validateDowntimeJailDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("Downtime jail duration must be positive, is %s", v)
+	}
+
+	return nil
+}
+
+func validateSlashFractionDoubleSign(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("Slashing fraction double sign should be less than or equal to one and greater than zero, is %s", v)
+	}
+
+	return nil
+}
+
+func validateSlashFractionDowntime(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
 	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("Slashing fraction downtime should be less than or equal to one and greater than zero, is %s", v)
+	}
+
+	return nil
+}
+
+func validateDowntimeJailDecayPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("Downtime jail decay period cannot be negative, is %s", v)
+	}
+
+	return nil
+}
+
+func validateSlashFractionDowntimeSchedule(i interface{}) error {
+	v, ok := i.([]sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for j, fraction := range v {
+		if fraction.IsNil() || fraction.IsNegative() || fraction.GT(sdk.OneDec()) {
+			return fmt.Errorf("Slashing fraction downtime schedule entries should be less than or equal to one and greater than zero, entry %d is %s", j, fraction)
+		}
+		if j > 0 && fraction.LT(v[j-1]) {
+			return fmt.Errorf("Slashing fraction downtime schedule must be non-decreasing, entry %d is %s", j, fraction)
+		}
+	}
+
+	return nil
+}
+
+func validateDowntimeJailDurationSchedule(i interface{}) error {
+	v, ok := i.([]time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for j, duration := range v {
+		if duration <= 0 {
+			return fmt.Errorf("Downtime jail duration schedule entries must be positive, entry %d is %s", j, duration)
+		}
+		if j > 0 && duration < v[j-1] {
+			return fmt.Errorf("Downtime jail duration schedule must be non-decreasing, entry %d is %s", j, duration)
+		}
+	}
+
+	return nil
 }
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDowntimePenalty(t *testing.T) {
+	params := DefaultParams()
+	params.SlashFractionDowntimeSchedule = []sdk.Dec{sdk.NewDecWithPrec(2, 2), sdk.NewDecWithPrec(5, 2)}
+	params.DowntimeJailDurationSchedule = []time.Duration{time.Hour}
+
+	tests := []struct {
+		priorJailCount int64
+		fraction       sdk.Dec
+		duration       time.Duration
+	}{
+		{0, params.SlashFractionDowntime, params.DowntimeJailDuration},
+		{1, sdk.NewDecWithPrec(2, 2), time.Hour},
+		{2, sdk.NewDecWithPrec(5, 2), time.Hour},
+		{10, sdk.NewDecWithPrec(5, 2), time.Hour},
+	}
+
+	for _, tc := range tests {
+		fraction, duration := params.DowntimePenalty(tc.priorJailCount)
+		require.True(t, tc.fraction.Equal(fraction), "prior jail count %d", tc.priorJailCount)
+		require.Equal(t, tc.duration, duration, "prior jail count %d", tc.priorJailCount)
+	}
+
+	// empty schedules always apply the base parameters
+	fraction, duration := DefaultParams().DowntimePenalty(5)
+	require.True(t, DefaultSlashFractionDowntime.Equal(fraction))
+	require.Equal(t, DefaultDowntimeJailDuration, duration)
+}
+
+func TestValidateGenesisSchedules(t *testing.T) {
+	genesis := DefaultGenesisState()
+	require.NoError(t, ValidateGenesis(genesis))
+
+	genesis.Params.SlashFractionDowntimeSchedule = []sdk.Dec{sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(2, 2)}
+	require.Error(t, ValidateGenesis(genesis))
+
+	genesis.Params.SlashFractionDowntimeSchedule = []sdk.Dec{sdk.NewDecWithPrec(2, 2), sdk.NewDec(2)}
+	require.Error(t, ValidateGenesis(genesis))
+
+	genesis.Params.SlashFractionDowntimeSchedule = []sdk.Dec{sdk.NewDecWithPrec(2, 2), sdk.NewDecWithPrec(5, 2)}
+	require.NoError(t, ValidateGenesis(genesis))
+
+	genesis.Params.DowntimeJailDurationSchedule = []time.Duration{time.Hour, time.Minute}
+	require.Error(t, ValidateGenesis(genesis))
+
+	genesis.Params.DowntimeJailDurationSchedule = []time.Duration{time.Second}
+	require.Error(t, ValidateGenesis(genesis))
+
+	genesis.Params.DowntimeJailDurationSchedule = []time.Duration{time.Hour, 2 * time.Hour}
+	require.NoError(t, ValidateGenesis(genesis))
+
+	genesis.Params.DowntimeJailDecayPeriod = -time.Hour
+	require.Error(t, ValidateGenesis(genesis))
+}
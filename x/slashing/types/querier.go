@@ -23,3 +23,23 @@ type QuerySigningInfosParams struct {
 func NewQuerySigningInfosParams(page, limit int) QuerySigningInfosParams {
 	return QuerySigningInfosParams{page, limit}
 }
+
+// QueryOffencesParams defines the params for the following queries:
+// - 'custom/slashing/offences'
+type QueryOffencesParams struct {
+	ConsAddress sdk.ConsAddress
+}
+
+func NewQueryOffencesParams(consAddr sdk.ConsAddress) QueryOffencesParams {
+	return QueryOffencesParams{consAddr}
+}
+
+// QueryMissedBlocksParams defines the params for the following queries:
+// - 'custom/slashing/missedBlocks'
+type QueryMissedBlocksParams struct {
+	ConsAddress sdk.ConsAddress
+}
+
+func NewQueryMissedBlocksParams(consAddr sdk.ConsAddress) QueryMissedBlocksParams {
+	return QueryMissedBlocksParams{consAddr}
+}
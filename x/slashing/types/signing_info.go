@@ -2,8 +2,11 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/tendermint/tendermint/crypto/multisig/bitarray"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -15,6 +18,9 @@ type ValidatorSigningInfo struct {
 	JailedUntil         time.Time       `json:"jailed_until"`          // timestamp validator cannot be unjailed until
 	Tombstoned          bool            `json:"tombstoned"`            // whether or not a validator has been tombstoned (killed out of validator set)
 	MissedBlocksCounter int64           `json:"missed_blocks_counter"` // missed blocks counter (to avoid scanning the array every time)
+
+	DowntimeJailCount    int64     `json:"downtime_jail_count"`     // number of downtime jails counted against the validator, decays over time
+	LastDowntimeJailTime time.Time `json:"last_downtime_jail_time"` // timestamp of the latest downtime jail, used to decay the jail count
 }
 
 // Construct a new `ValidatorSigningInfo` struct
@@ -41,7 +47,103 @@ func (i ValidatorSigningInfo) String() string {
   Index Offset:          %d
   Jailed Until:          %v
   Tombstoned:            %t
-  Missed Blocks Counter: %d`,
+  Missed Blocks Counter: %d
+  Downtime Jail Count:   %d
+  Last Downtime Jail:    %v`,
 		i.Address, i.StartHeight, i.IndexOffset, i.JailedUntil,
-		i.Tombstoned, i.MissedBlocksCounter)
+		i.Tombstoned, i.MissedBlocksCounter, i.DowntimeJailCount,
+		i.LastDowntimeJailTime)
+}
+
+// ValidatorSigningInfos is a collection of ValidatorSigningInfo
+type ValidatorSigningInfos []ValidatorSigningInfo
+
+func (i ValidatorSigningInfos) String() (out string) {
+	for _, info := range i {
+		out += info.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// DowntimeOffence records a single downtime slash and jail of a validator
+type DowntimeOffence struct {
+	Height        int64         `json:"height"`         // block height at which the validator was jailed
+	Time          time.Time     `json:"time"`           // block time at which the validator was jailed
+	JailCount     int64         `json:"jail_count"`     // downtime jail count of the validator including this offence
+	SlashFraction sdk.Dec       `json:"slash_fraction"` // slash fraction applied to the validator
+	JailDuration  time.Duration `json:"jail_duration"`  // duration the validator was jailed for
+}
+
+// NewDowntimeOffence creates a new DowntimeOffence object
+func NewDowntimeOffence(height int64, t time.Time, jailCount int64,
+	slashFraction sdk.Dec, jailDuration time.Duration) DowntimeOffence {
+
+	return DowntimeOffence{
+		Height:        height,
+		Time:          t,
+		JailCount:     jailCount,
+		SlashFraction: slashFraction,
+		JailDuration:  jailDuration,
+	}
+}
+
+// Return human readable downtime offence
+func (o DowntimeOffence) String() string {
+	return fmt.Sprintf(`Downtime Offence:
+  Height:         %d
+  Time:           %v
+  Jail Count:     %d
+  Slash Fraction: %s
+  Jail Duration:  %s`,
+		o.Height, o.Time, o.JailCount, o.SlashFraction, o.JailDuration)
+}
+
+// DowntimeOffences is a collection of DowntimeOffence
+type DowntimeOffences []DowntimeOffence
+
+func (o DowntimeOffences) String() (out string) {
+	for _, offence := range o {
+		out += offence.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// ValidatorMissedBlocks is the missed blocks bitmap of a validator over its
+// current signed blocks window. The bitmap is indexed by the position of a
+// block in the window, the next block being recorded at IndexOffset modulo
+// SignedBlocksWindow.
+type ValidatorMissedBlocks struct {
+	Address             sdk.ConsAddress           `json:"address"`               // validator consensus address
+	SignedBlocksWindow  int64                     `json:"signed_blocks_window"`  // size of the signed blocks window
+	IndexOffset         int64                     `json:"index_offset"`          // index offset into the signed blocks window
+	MissedBlocksCounter int64                     `json:"missed_blocks_counter"` // number of blocks missed in the window
+	MaxMissedBlocks     int64                     `json:"max_missed_blocks"`     // number of blocks that can be missed in the window without being jailed
+	MissedBlocks        *bitarray.CompactBitArray `json:"missed_blocks"`         // bitmap of the missed blocks in the window
+}
+
+// NewValidatorMissedBlocks creates a new ValidatorMissedBlocks object
+func NewValidatorMissedBlocks(consAddr sdk.ConsAddress, signedBlocksWindow, indexOffset,
+	missedBlocksCounter, maxMissedBlocks int64, missedBlocks *bitarray.CompactBitArray) ValidatorMissedBlocks {
+
+	return ValidatorMissedBlocks{
+		Address:             consAddr,
+		SignedBlocksWindow:  signedBlocksWindow,
+		IndexOffset:         indexOffset,
+		MissedBlocksCounter: missedBlocksCounter,
+		MaxMissedBlocks:     maxMissedBlocks,
+		MissedBlocks:        missedBlocks,
+	}
+}
+
+// Return human readable missed blocks
+func (m ValidatorMissedBlocks) String() string {
+	return fmt.Sprintf(`Validator Missed Blocks:
+  Address:               %s
+  Signed Blocks Window:  %d
+  Index Offset:          %d
+  Missed Blocks Counter: %d
+  Max Missed Blocks:     %d
+  Missed Blocks:         %s`,
+		m.Address, m.SignedBlocksWindow, m.IndexOffset,
+		m.MissedBlocksCounter, m.MaxMissedBlocks, m.MissedBlocks)
 }
@@ -31,8 +31,15 @@ const (
 	DefaultUnbondingTime               = types.DefaultUnbondingTime
 	DefaultMaxValidators               = types.DefaultMaxValidators
 	DefaultMaxEntries                  = types.DefaultMaxEntries
+	DefaultHistoricalEntries           = types.DefaultHistoricalEntries
+	DefaultVotingPowerCapMode          = types.DefaultVotingPowerCapMode
+	VotingPowerCapModeReject           = types.VotingPowerCapModeReject
+	VotingPowerCapModeWarn             = types.VotingPowerCapModeWarn
 	NotBondedPoolName                  = types.NotBondedPoolName
 	BondedPoolName                     = types.BondedPoolName
+	TokenizedSharesPoolName            = types.TokenizedSharesPoolName
+	TokenizedSharesBurnerName          = types.TokenizedSharesBurnerName
+	ShareTokenDenomPrefix              = types.ShareTokenDenomPrefix
 	QueryValidators                    = types.QueryValidators
 	QueryValidator                     = types.QueryValidator
 	QueryDelegatorDelegations          = types.QueryDelegatorDelegations
@@ -47,6 +54,8 @@ const (
 	QueryDelegatorValidator            = types.QueryDelegatorValidator
 	QueryPool                          = types.QueryPool
 	QueryParameters                    = types.QueryParameters
+	QueryValidatorTokenizedShares      = types.QueryValidatorTokenizedShares
+	QueryHistoricalInfo                = types.QueryHistoricalInfo
 	MaxMonikerLength                   = types.MaxMonikerLength
 	MaxIdentityLength                  = types.MaxIdentityLength
 	MaxWebsiteLength                   = types.MaxWebsiteLength
@@ -62,6 +71,7 @@ var (
 	NonNegativePowerInvariant          = keeper.NonNegativePowerInvariant
 	PositiveDelegationInvariant        = keeper.PositiveDelegationInvariant
 	DelegatorSharesInvariant           = keeper.DelegatorSharesInvariant
+	TokenizedSharesInvariant           = keeper.TokenizedSharesInvariant
 	NewKeeper                          = keeper.NewKeeper
 	ParamKeyTable                      = keeper.ParamKeyTable
 	NewQuerier                         = keeper.NewQuerier
@@ -120,6 +130,17 @@ var (
 	ErrNotMature                       = types.ErrNotMature
 	ErrNoUnbondingDelegation           = types.ErrNoUnbondingDelegation
 	ErrMaxUnbondingDelegationEntries   = types.ErrMaxUnbondingDelegationEntries
+	ErrNoUnbondingDelegationEntry      = types.ErrNoUnbondingDelegationEntry
+	ErrUnbondingDelegationEntryMature  = types.ErrUnbondingDelegationEntryMature
+	ErrBadCreationHeight               = types.ErrBadCreationHeight
+	ErrBadShareTokenDenom              = types.ErrBadShareTokenDenom
+	ErrTokenizeSelfDelegation          = types.ErrTokenizeSelfDelegation
+	ErrTokenizeVestingAccount          = types.ErrTokenizeVestingAccount
+	ErrVerySmallTokenization           = types.ErrVerySmallTokenization
+	ErrNoTokenizedShares               = types.ErrNoTokenizedShares
+	ErrNotEnoughShareTokens            = types.ErrNotEnoughShareTokens
+	ErrNoHistoricalInfo                = types.ErrNoHistoricalInfo
+	ErrVotingPowerCapExceeded          = types.ErrVotingPowerCapExceeded
 	ErrBadRedelegationAddr             = types.ErrBadRedelegationAddr
 	ErrNoRedelegation                  = types.ErrNoRedelegation
 	ErrSelfRedelegation                = types.ErrSelfRedelegation
@@ -159,11 +180,16 @@ var (
 	GetREDsFromValSrcIndexKey          = types.GetREDsFromValSrcIndexKey
 	GetREDsToValDstIndexKey            = types.GetREDsToValDstIndexKey
 	GetREDsByDelToValDstIndexKey       = types.GetREDsByDelToValDstIndexKey
+	GetTokenizedSharesKey              = types.GetTokenizedSharesKey
+	GetHistoricalInfoKey               = types.GetHistoricalInfoKey
 	NewMsgCreateValidator              = types.NewMsgCreateValidator
 	NewMsgEditValidator                = types.NewMsgEditValidator
 	NewMsgDelegate                     = types.NewMsgDelegate
 	NewMsgBeginRedelegate              = types.NewMsgBeginRedelegate
 	NewMsgUndelegate                   = types.NewMsgUndelegate
+	NewMsgCancelUnbondingDelegation    = types.NewMsgCancelUnbondingDelegation
+	NewMsgTokenizeShares               = types.NewMsgTokenizeShares
+	NewMsgRedeemTokensForShares        = types.NewMsgRedeemTokensForShares
 	NewParams                          = types.NewParams
 	DefaultParams                      = types.DefaultParams
 	MustUnmarshalParams                = types.MustUnmarshalParams
@@ -179,6 +205,18 @@ var (
 	MustUnmarshalValidator             = types.MustUnmarshalValidator
 	UnmarshalValidator                 = types.UnmarshalValidator
 	NewDescription                     = types.NewDescription
+	GetShareTokenDenom                 = types.GetShareTokenDenom
+	ParseShareTokenDenom               = types.ParseShareTokenDenom
+	NewTokenizedShares                 = types.NewTokenizedShares
+	MustMarshalTokenizedShares         = types.MustMarshalTokenizedShares
+	MustUnmarshalTokenizedShares       = types.MustUnmarshalTokenizedShares
+	UnmarshalTokenizedShares           = types.UnmarshalTokenizedShares
+	NewTokenizedSharesResponse         = types.NewTokenizedSharesResponse
+	NewHistoricalInfo                  = types.NewHistoricalInfo
+	MustMarshalHistoricalInfo          = types.MustMarshalHistoricalInfo
+	MustUnmarshalHistoricalInfo        = types.MustUnmarshalHistoricalInfo
+	UnmarshalHistoricalInfo            = types.UnmarshalHistoricalInfo
+	NewQueryHistoricalInfoParams       = types.NewQueryHistoricalInfoParams
 
 	// variable aliases
 	ModuleCdc                        = types.ModuleCdc
@@ -197,50 +235,63 @@ var (
 	UnbondingQueueKey                = types.UnbondingQueueKey
 	RedelegationQueueKey             = types.RedelegationQueueKey
 	ValidatorQueueKey                = types.ValidatorQueueKey
+	TokenizedSharesKey               = types.TokenizedSharesKey
+	HistoricalInfoKey                = types.HistoricalInfoKey
 	KeyUnbondingTime                 = types.KeyUnbondingTime
 	KeyMaxValidators                 = types.KeyMaxValidators
 	KeyMaxEntries                    = types.KeyMaxEntries
+	KeyHistoricalEntries             = types.KeyHistoricalEntries
 	KeyBondDenom                     = types.KeyBondDenom
+	KeyMaxVotingPowerRatio           = types.KeyMaxVotingPowerRatio
+	KeyVotingPowerCapMode            = types.KeyVotingPowerCapMode
+	DefaultMaxVotingPowerRatio       = types.DefaultMaxVotingPowerRatio
 )
 
 type (
-	Keeper                    = keeper.Keeper
-	Commission                = types.Commission
-	CommissionRates           = types.CommissionRates
-	DVPair                    = types.DVPair
-	DVVTriplet                = types.DVVTriplet
-	Delegation                = types.Delegation
-	Delegations               = types.Delegations
-	UnbondingDelegation       = types.UnbondingDelegation
-	UnbondingDelegationEntry  = types.UnbondingDelegationEntry
-	UnbondingDelegations      = types.UnbondingDelegations
-	Redelegation              = types.Redelegation
-	RedelegationEntry         = types.RedelegationEntry
-	Redelegations             = types.Redelegations
-	DelegationResponse        = types.DelegationResponse
-	DelegationResponses       = types.DelegationResponses
-	RedelegationResponse      = types.RedelegationResponse
-	RedelegationEntryResponse = types.RedelegationEntryResponse
-	RedelegationResponses     = types.RedelegationResponses
-	CodeType                  = types.CodeType
-	GenesisState              = types.GenesisState
-	LastValidatorPower        = types.LastValidatorPower
-	MultiStakingHooks         = types.MultiStakingHooks
-	MsgCreateValidator        = types.MsgCreateValidator
-	MsgEditValidator          = types.MsgEditValidator
-	MsgDelegate               = types.MsgDelegate
-	MsgBeginRedelegate        = types.MsgBeginRedelegate
-	MsgUndelegate             = types.MsgUndelegate
-	Params                    = types.Params
-	Pool                      = types.Pool
-	QueryDelegatorParams      = types.QueryDelegatorParams
-	QueryValidatorParams      = types.QueryValidatorParams
-	QueryBondsParams          = types.QueryBondsParams
-	QueryRedelegationParams   = types.QueryRedelegationParams
-	QueryValidatorsParams     = types.QueryValidatorsParams
-	Validator                 = types.Validator
-	Validators                = types.Validators
-	Description               = types.Description
-	DelegationI               = exported.DelegationI
-	ValidatorI                = exported.ValidatorI
+	Keeper                       = keeper.Keeper
+	Commission                   = types.Commission
+	CommissionRates              = types.CommissionRates
+	DVPair                       = types.DVPair
+	DVVTriplet                   = types.DVVTriplet
+	Delegation                   = types.Delegation
+	Delegations                  = types.Delegations
+	UnbondingDelegation          = types.UnbondingDelegation
+	UnbondingDelegationEntry     = types.UnbondingDelegationEntry
+	UnbondingDelegations         = types.UnbondingDelegations
+	Redelegation                 = types.Redelegation
+	RedelegationEntry            = types.RedelegationEntry
+	Redelegations                = types.Redelegations
+	DelegationResponse           = types.DelegationResponse
+	DelegationResponses          = types.DelegationResponses
+	RedelegationResponse         = types.RedelegationResponse
+	RedelegationEntryResponse    = types.RedelegationEntryResponse
+	RedelegationResponses        = types.RedelegationResponses
+	CodeType                     = types.CodeType
+	GenesisState                 = types.GenesisState
+	LastValidatorPower           = types.LastValidatorPower
+	MultiStakingHooks            = types.MultiStakingHooks
+	MsgCreateValidator           = types.MsgCreateValidator
+	MsgEditValidator             = types.MsgEditValidator
+	MsgDelegate                  = types.MsgDelegate
+	MsgBeginRedelegate           = types.MsgBeginRedelegate
+	MsgUndelegate                = types.MsgUndelegate
+	MsgCancelUnbondingDelegation = types.MsgCancelUnbondingDelegation
+	MsgTokenizeShares            = types.MsgTokenizeShares
+	MsgRedeemTokensForShares     = types.MsgRedeemTokensForShares
+	Params                       = types.Params
+	Pool                         = types.Pool
+	QueryDelegatorParams         = types.QueryDelegatorParams
+	QueryValidatorParams         = types.QueryValidatorParams
+	QueryBondsParams             = types.QueryBondsParams
+	QueryRedelegationParams      = types.QueryRedelegationParams
+	QueryValidatorsParams        = types.QueryValidatorsParams
+	Validator                    = types.Validator
+	Validators                   = types.Validators
+	Description                  = types.Description
+	TokenizedShares              = types.TokenizedShares
+	TokenizedSharesResponse      = types.TokenizedSharesResponse
+	HistoricalInfo               = types.HistoricalInfo
+	QueryHistoricalInfoParams    = types.QueryHistoricalInfoParams
+	DelegationI                  = exported.DelegationI
+	ValidatorI                   = exported.ValidatorI
 )
@@ -87,6 +87,14 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, accountKeeper types.AccountKeep
 		}
 	}
 
+	for _, record := range data.TokenizedShares {
+		keeper.SetTokenizedShares(ctx, record)
+	}
+
+	for _, hi := range data.HistoricalInfos {
+		keeper.SetHistoricalInfo(ctx, hi.Header.Height, hi)
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -154,6 +162,8 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		redelegations = append(redelegations, red)
 		return false
 	})
+	tokenizedShares := keeper.GetAllTokenizedShares(ctx)
+	historicalInfos := keeper.GetAllHistoricalInfo(ctx)
 	var lastValidatorPowers []types.LastValidatorPower
 	keeper.IterateLastValidatorPowers(ctx, func(addr sdk.ValAddress, power int64) (stop bool) {
 		lastValidatorPowers = append(lastValidatorPowers, types.LastValidatorPower{addr, power})
@@ -168,6 +178,8 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		Delegations:          delegations,
 		UnbondingDelegations: unbondingDelegations,
 		Redelegations:        redelegations,
+		TokenizedShares:      tokenizedShares,
+		HistoricalInfos:      historicalInfos,
 		Exported:             true,
 	}
 }
@@ -199,6 +211,41 @@ func ValidateGenesis(data types.GenesisState) error {
 		return err
 	}
 
+	err = validateGenesisStateTokenizedShares(data.TokenizedShares)
+	if err != nil {
+		return err
+	}
+
+	return validateGenesisStateHistoricalInfos(data.HistoricalInfos)
+}
+
+func validateGenesisStateHistoricalInfos(infos []types.HistoricalInfo) error {
+	heightMap := make(map[int64]bool, len(infos))
+	for _, hi := range infos {
+		height := hi.Header.Height
+		if _, ok := heightMap[height]; ok {
+			return fmt.Errorf("duplicate historical info in genesis state: height %d", height)
+		}
+		if err := hi.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid historical info at height %d: %s", height, err)
+		}
+		heightMap[height] = true
+	}
+	return nil
+}
+
+func validateGenesisStateTokenizedShares(records []types.TokenizedShares) error {
+	valMap := make(map[string]bool, len(records))
+	for _, record := range records {
+		strKey := record.ValidatorAddress.String()
+		if _, ok := valMap[strKey]; ok {
+			return fmt.Errorf("duplicate tokenized shares record in genesis state: validator %s", strKey)
+		}
+		if !record.Shares.IsPositive() {
+			return fmt.Errorf("tokenized shares record must have positive shares, validator: %s", strKey)
+		}
+		valMap[strKey] = true
+	}
 	return nil
 }
 
@@ -13,6 +13,7 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	keep "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -1324,3 +1325,284 @@ func TestInvalidCoinDenom(t *testing.T) {
 	got = handleMsgBeginRedelegate(ctx, msgRedelegate, keeper)
 	require.True(t, got.IsOK())
 }
+
+func TestCancelUnbondingDelegation(t *testing.T) {
+	ctx, accKeeper, keeper, _ := keep.CreateTestInput(t, false, 1000)
+	valAddr, delAddr := sdk.ValAddress(keep.Addrs[0]), keep.Addrs[1]
+
+	// set the unbonding time
+	params := keeper.GetParams(ctx)
+	params.UnbondingTime = 7 * time.Second
+	keeper.SetParams(ctx, params)
+
+	// make the delegator a vesting account so that delegated vesting coins are tracked
+	baseAcc := accKeeper.GetAccount(ctx, delAddr).(*auth.BaseAccount)
+	vestingAcc := auth.NewContinuousVestingAccount(baseAcc, 0, ctx.BlockHeader().Time.Add(1000*time.Hour).Unix())
+	accKeeper.SetAccount(ctx, vestingAcc)
+
+	// create the validator and delegate to it
+	valTokens := sdk.TokensFromConsensusPower(10)
+	msgCreateValidator := NewTestMsgCreateValidator(valAddr, keep.PKs[0], valTokens)
+	got := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.True(t, got.IsOK(), "expected no error on runMsgCreateValidator")
+
+	delTokens := sdk.TokensFromConsensusPower(10)
+	msgDelegate := NewTestMsgDelegate(delAddr, valAddr, delTokens)
+	got = handleMsgDelegate(ctx, msgDelegate, keeper)
+	require.True(t, got.IsOK(), "expected no error on runMsgDelegate")
+
+	// end block to bond
+	EndBlocker(ctx, keeper)
+	bondedTokens := keeper.TotalBondedTokens(ctx)
+	delegatedVesting := accKeeper.GetAccount(ctx, delAddr).(auth.VestingAccount).GetDelegatedVesting()
+
+	// begin an unbonding delegation
+	ctx = ctx.WithBlockHeight(10)
+	unbondAmt := sdk.NewCoin(sdk.DefaultBondDenom, delTokens.QuoRaw(2))
+	got = handleMsgUndelegate(ctx, NewMsgUndelegate(delAddr, valAddr, unbondAmt), keeper)
+	require.True(t, got.IsOK(), "expected no error, %v", got)
+	require.Equal(t, bondedTokens.Sub(unbondAmt.Amount), keeper.TotalBondedTokens(ctx))
+
+	ubd, found := keeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	completionTime := ubd.Entries[0].CompletionTime
+
+	// cancelling an unknown entry must fail
+	ctx = ctx.WithBlockHeight(11).WithBlockTime(ctx.BlockHeader().Time.Add(time.Second))
+	got = handleMsgCancelUnbondingDelegation(ctx, NewMsgCancelUnbondingDelegation(delAddr, valAddr, 11), keeper)
+	require.False(t, got.IsOK(), "expected error, %v", got)
+
+	// only the delegator's own entries can be cancelled
+	got = handleMsgCancelUnbondingDelegation(ctx, NewMsgCancelUnbondingDelegation(keep.Addrs[2], valAddr, 10), keeper)
+	require.False(t, got.IsOK(), "expected error, %v", got)
+
+	got = handleMsgCancelUnbondingDelegation(ctx, NewMsgCancelUnbondingDelegation(delAddr, valAddr, 10), keeper)
+	require.True(t, got.IsOK(), "expected no error, %v", got)
+
+	// the unbonding delegation and its queue entry are removed
+	_, found = keeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.False(t, found)
+	require.Empty(t, keeper.GetUBDQueueTimeSlice(ctx, completionTime))
+
+	// the tokens are bonded again and the full delegation is restored
+	require.Equal(t, bondedTokens, keeper.TotalBondedTokens(ctx))
+	delegation, found := keeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, delTokens.ToDec(), delegation.Shares)
+
+	// the vesting account still tracks the coins as delegated
+	require.Equal(t, delegatedVesting, accKeeper.GetAccount(ctx, delAddr).(auth.VestingAccount).GetDelegatedVesting())
+
+	// the entry can't be cancelled twice
+	got = handleMsgCancelUnbondingDelegation(ctx, NewMsgCancelUnbondingDelegation(delAddr, valAddr, 10), keeper)
+	require.False(t, got.IsOK(), "expected error, %v", got)
+}
+
+func TestTokenizeSharesSlashing(t *testing.T) {
+	ctx, accKeeper, keeper, _ := keep.CreateTestInput(t, false, 1000)
+	valAddr, delAddr, holderAddr := sdk.ValAddress(keep.Addrs[0]), keep.Addrs[1], keep.Addrs[2]
+	consAddr := sdk.ConsAddress(keep.PKs[0].Address())
+	shareDenom := types.GetShareTokenDenom(valAddr)
+
+	// create the validator and delegate to it
+	valTokens := sdk.TokensFromConsensusPower(10)
+	got := handleMsgCreateValidator(ctx, NewTestMsgCreateValidator(valAddr, keep.PKs[0], valTokens), keeper)
+	require.True(t, got.IsOK(), "expected no error on runMsgCreateValidator")
+
+	delTokens := sdk.TokensFromConsensusPower(10)
+	got = handleMsgDelegate(ctx, NewTestMsgDelegate(delAddr, valAddr, delTokens), keeper)
+	require.True(t, got.IsOK(), "expected no error on runMsgDelegate")
+	EndBlocker(ctx, keeper)
+
+	// share tokens must be requested in the bond denomination
+	tokenizeAmt := delTokens.QuoRaw(2)
+	msgTokenize := NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin("foocoin", tokenizeAmt))
+	got = handleMsgTokenizeShares(ctx, msgTokenize, keeper)
+	require.False(t, got.IsOK(), "expected error, %v", got)
+
+	msgTokenize = NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(sdk.DefaultBondDenom, tokenizeAmt))
+	got = handleMsgTokenizeShares(ctx, msgTokenize, keeper)
+	require.True(t, got.IsOK(), "expected no error, %v", got)
+
+	// transfer the share tokens to another holder
+	shareTokens := sdk.NewCoins(sdk.NewCoin(shareDenom, tokenizeAmt))
+	delAcc := accKeeper.GetAccount(ctx, delAddr)
+	require.NoError(t, delAcc.SetCoins(delAcc.GetCoins().Sub(shareTokens)))
+	accKeeper.SetAccount(ctx, delAcc)
+	holderAcc := accKeeper.GetAccount(ctx, holderAddr)
+	require.NoError(t, holderAcc.SetCoins(holderAcc.GetCoins().Add(shareTokens)))
+	accKeeper.SetAccount(ctx, holderAcc)
+
+	// slash the validator by half
+	ctx = ctx.WithBlockHeight(1)
+	keeper.Slash(ctx, consAddr, 0, 20, sdk.NewDecWithPrec(5, 1))
+
+	// the query reports the reduced value of the tokenized shares
+	querier := keep.NewQuerier(keeper)
+	bz, err := types.ModuleCdc.MarshalJSON(types.NewQueryValidatorParams(valAddr))
+	require.NoError(t, err)
+	res, err := querier(ctx, []string{types.QueryValidatorTokenizedShares}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	var resp types.TokenizedSharesResponse
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(res, &resp))
+	require.Equal(t, tokenizeAmt.ToDec(), resp.Shares)
+	require.True(sdk.IntEq(t, tokenizeAmt.QuoRaw(2), resp.Balance))
+
+	// the holder redeems the share tokens and bears the loss pro rata
+	got = handleMsgRedeemTokensForShares(ctx, NewMsgRedeemTokensForShares(holderAddr, shareTokens[0]), keeper)
+	require.True(t, got.IsOK(), "expected no error, %v", got)
+
+	validator, found := keeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+
+	holderDelegation, found := keeper.GetDelegation(ctx, holderAddr, valAddr)
+	require.True(t, found)
+	delDelegation, found := keeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+
+	holderTokens := validator.TokensFromShares(holderDelegation.Shares).TruncateInt()
+	delTokensLeft := validator.TokensFromShares(delDelegation.Shares).TruncateInt()
+	require.True(sdk.IntEq(t, tokenizeAmt.QuoRaw(2), holderTokens))
+	require.True(sdk.IntEq(t, delTokensLeft, holderTokens))
+
+	require.True(t, accKeeper.GetAccount(ctx, holderAddr).GetCoins().AmountOf(shareDenom).IsZero())
+	_, found = keeper.GetTokenizedShares(ctx, valAddr)
+	require.False(t, found)
+	require.NoError(t, keep.DelegatorSharesInvariant(keeper)(ctx))
+	require.NoError(t, keep.TokenizedSharesInvariant(keeper)(ctx))
+}
+
+func TestDelegationVotingPowerCap(t *testing.T) {
+	ctx, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
+	delAddr := keep.Addrs[3]
+
+	// create three validators with equal power
+	valTokens := sdk.TokensFromConsensusPower(10)
+	for i := 0; i < 3; i++ {
+		msg := NewTestMsgCreateValidator(sdk.ValAddress(keep.Addrs[i]), keep.PKs[i], valTokens)
+		got := handleMsgCreateValidator(ctx, msg, keeper)
+		require.True(t, got.IsOK(), "expected no error on runMsgCreateValidator")
+	}
+	EndBlocker(ctx, keeper)
+
+	params := keeper.GetParams(ctx)
+	params.MaxVotingPowerRatio = sdk.NewDecWithPrec(5, 1)
+	params.VotingPowerCapMode = types.VotingPowerCapModeReject
+	keeper.SetParams(ctx, params)
+
+	// a delegation up to the cap is accepted
+	valAddr := sdk.ValAddress(keep.Addrs[0])
+	got := handleMsgDelegate(ctx, NewTestMsgDelegate(delAddr, valAddr, sdk.TokensFromConsensusPower(10)), keeper)
+	require.True(t, got.IsOK(), "expected delegation up to the cap to be ok, got %v", got)
+
+	// a delegation over the cap is rejected
+	delTokens := sdk.TokensFromConsensusPower(5)
+	got = handleMsgDelegate(ctx, NewTestMsgDelegate(delAddr, valAddr, delTokens), keeper)
+	require.False(t, got.IsOK(), "expected delegation over the cap to fail")
+	require.Equal(t, types.ErrVotingPowerCapExceeded(types.DefaultCodespace).Code(), got.Code)
+
+	// so is a redelegation over the cap
+	got = handleMsgBeginRedelegate(ctx, NewMsgBeginRedelegate(keep.Addrs[2], sdk.ValAddress(keep.Addrs[2]), valAddr,
+		sdk.NewCoin(sdk.DefaultBondDenom, delTokens)), keeper)
+	require.False(t, got.IsOK(), "expected redelegation over the cap to fail")
+	require.Equal(t, types.ErrVotingPowerCapExceeded(types.DefaultCodespace).Code(), got.Code)
+
+	// cancelling an unbonding delegation re-bonds its tokens and is capped too
+	got = handleMsgUndelegate(ctx, NewMsgUndelegate(delAddr, valAddr, sdk.NewCoin(sdk.DefaultBondDenom, delTokens)), keeper)
+	require.True(t, got.IsOK(), "expected undelegation to be ok, got %v", got)
+	holderAddr := keep.Addrs[4]
+	got = handleMsgDelegate(ctx, NewTestMsgDelegate(holderAddr, valAddr, delTokens), keeper)
+	require.True(t, got.IsOK(), "expected delegation up to the cap to be ok, got %v", got)
+
+	got = handleMsgCancelUnbondingDelegation(ctx, NewMsgCancelUnbondingDelegation(delAddr, valAddr, ctx.BlockHeight()), keeper)
+	require.False(t, got.IsOK(), "expected cancelling the unbonding over the cap to fail")
+	require.Equal(t, types.ErrVotingPowerCapExceeded(types.DefaultCodespace).Code(), got.Code)
+	_, found := keeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+
+	// redeeming share tokens moves existing shares and leaves the validator
+	// power unchanged, so it can't push a validator over the cap
+	validator, found := keeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	shareTokens := sdk.NewCoin(types.GetShareTokenDenom(valAddr), delTokens)
+	got = handleMsgTokenizeShares(ctx, NewMsgTokenizeShares(holderAddr, valAddr, sdk.NewCoin(sdk.DefaultBondDenom, delTokens)), keeper)
+	require.True(t, got.IsOK(), "expected tokenization to be ok, got %v", got)
+	got = handleMsgRedeemTokensForShares(ctx, NewMsgRedeemTokensForShares(holderAddr, shareTokens), keeper)
+	require.True(t, got.IsOK(), "expected redemption at the cap to be ok, got %v", got)
+
+	redeemed, found := keeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, validator.Tokens, redeemed.Tokens)
+	require.False(t, keeper.ExceedsVotingPowerCap(ctx, redeemed, sdk.ZeroInt()))
+
+	// while delegations to the other validators are still accepted
+	got = handleMsgDelegate(ctx, NewTestMsgDelegate(delAddr, sdk.ValAddress(keep.Addrs[1]), delTokens), keeper)
+	require.True(t, got.IsOK(), "expected delegation below the cap to be ok, got %v", got)
+
+	// in warn mode the delegation is accepted and flagged
+	params.VotingPowerCapMode = types.VotingPowerCapModeWarn
+	keeper.SetParams(ctx, params)
+
+	delTokens = sdk.TokensFromConsensusPower(10)
+	got = handleMsgDelegate(ctx, NewTestMsgDelegate(delAddr, valAddr, delTokens), keeper)
+	require.True(t, got.IsOK(), "expected delegation over the cap to be ok in warn mode, got %v", got)
+
+	var warned bool
+	for _, event := range got.Events {
+		if event.Type == types.EventTypeVotingPowerCap {
+			warned = true
+		}
+	}
+	require.True(t, warned, "expected a voting power cap event")
+}
+
+func TestCreateValidatorVotingPowerCap(t *testing.T) {
+	ctx, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
+
+	params := keeper.GetParams(ctx)
+	params.MaxVotingPowerRatio = sdk.NewDecWithPrec(5, 1)
+	params.VotingPowerCapMode = types.VotingPowerCapModeReject
+	keeper.SetParams(ctx, params)
+
+	// the genesis validators are not capped
+	valTokens := sdk.TokensFromConsensusPower(10)
+	for i := 0; i < 2; i++ {
+		msg := NewTestMsgCreateValidator(sdk.ValAddress(keep.Addrs[i]), keep.PKs[i], valTokens)
+		got := handleMsgCreateValidator(ctx, msg, keeper)
+		require.True(t, got.IsOK(), "expected genesis validator to be ok, got %v", got)
+	}
+	EndBlocker(ctx, keeper)
+	ctx = ctx.WithBlockHeight(1)
+
+	// a self-bond up to the cap is accepted
+	valAddr := sdk.ValAddress(keep.Addrs[2])
+	msg := NewTestMsgCreateValidator(valAddr, keep.PKs[2], valTokens)
+	got := handleMsgCreateValidator(ctx, msg, keeper)
+	require.True(t, got.IsOK(), "expected self-bond up to the cap to be ok, got %v", got)
+
+	// a self-bond over the cap is rejected and no validator is created
+	valAddr = sdk.ValAddress(keep.Addrs[3])
+	msg = NewTestMsgCreateValidator(valAddr, keep.PKs[3], sdk.TokensFromConsensusPower(31))
+	got = handleMsgCreateValidator(ctx, msg, keeper)
+	require.False(t, got.IsOK(), "expected self-bond over the cap to fail")
+	require.Equal(t, types.ErrVotingPowerCapExceeded(types.DefaultCodespace).Code(), got.Code)
+	_, found := keeper.GetValidator(ctx, valAddr)
+	require.False(t, found)
+
+	// in warn mode the validator is created and flagged
+	params.VotingPowerCapMode = types.VotingPowerCapModeWarn
+	keeper.SetParams(ctx, params)
+
+	got = handleMsgCreateValidator(ctx, msg, keeper)
+	require.True(t, got.IsOK(), "expected self-bond over the cap to be ok in warn mode, got %v", got)
+
+	var warned bool
+	for _, event := range got.Events {
+		if event.Type == types.EventTypeVotingPowerCap {
+			warned = true
+		}
+	}
+	require.True(t, warned, "expected a voting power cap event")
+}
@@ -242,6 +242,29 @@ func (k Keeper) InsertUBDQueue(ctx sdk.Context, ubd types.UnbondingDelegation,
 	}
 }
 
+// Removes a single occurrence of an unbonding delegation from the given
+// timeslice in the unbonding queue
+func (k Keeper) RemoveUBDQueueEntry(ctx sdk.Context, ubd types.UnbondingDelegation,
+	completionTime time.Time) {
+
+	timeSlice := k.GetUBDQueueTimeSlice(ctx, completionTime)
+	for i, dvPair := range timeSlice {
+		if dvPair.DelegatorAddress.Equals(ubd.DelegatorAddress) &&
+			dvPair.ValidatorAddress.Equals(ubd.ValidatorAddress) {
+
+			timeSlice = append(timeSlice[:i], timeSlice[i+1:]...)
+			break
+		}
+	}
+
+	if len(timeSlice) == 0 {
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(types.GetUnbondingDelegationTimeKey(completionTime))
+	} else {
+		k.SetUBDQueueTimeSlice(ctx, completionTime, timeSlice)
+	}
+}
+
 // Returns all the unbonding queue timeslices from time 0 until endTime
 func (k Keeper) UBDQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
@@ -696,6 +719,64 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress,
 	return nil
 }
 
+// CancelUnbondingDelegation cancels the unbonding delegation entry created at
+// the given height and delegates its remaining balance back to the validator.
+// The tokens never leave the not bonded pool while unbonding, so the delegation
+// is performed between the staking pools only and the (vesting) account of the
+// delegator keeps tracking them as delegated.
+func (k Keeper) CancelUnbondingDelegation(ctx sdk.Context, delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress, creationHeight int64) (sdk.Int, sdk.Error) {
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.ZeroInt(), types.ErrNoValidatorFound(k.Codespace())
+	}
+
+	ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return sdk.ZeroInt(), types.ErrNoUnbondingDelegation(k.Codespace())
+	}
+
+	entryIndex := -1
+	for i, entry := range ubd.Entries {
+		if entry.CreationHeight == creationHeight {
+			entryIndex = i
+			break
+		}
+	}
+	if entryIndex == -1 {
+		return sdk.ZeroInt(), types.ErrNoUnbondingDelegationEntry(k.Codespace(), creationHeight)
+	}
+
+	entry := ubd.Entries[entryIndex]
+	if entry.IsMature(ctx.BlockHeader().Time) {
+		return sdk.ZeroInt(), types.ErrUnbondingDelegationEntryMature(k.Codespace())
+	}
+
+	// the entry may have been slashed down to zero, in which case there is
+	// nothing left to delegate
+	if entry.Balance.IsPositive() {
+		if err := k.CheckVotingPowerCap(ctx, validator, entry.Balance); err != nil {
+			return sdk.ZeroInt(), err
+		}
+
+		_, err := k.Delegate(ctx, delAddr, entry.Balance, sdk.Unbonding, validator, false)
+		if err != nil {
+			return sdk.ZeroInt(), err
+		}
+	}
+
+	ubd.RemoveEntry(int64(entryIndex))
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+	k.RemoveUBDQueueEntry(ctx, ubd, entry.CompletionTime)
+
+	return entry.Balance, nil
+}
+
 // begin unbonding / redelegation; create a redelegation record
 func (k Keeper) BeginRedelegation(ctx sdk.Context, delAddr sdk.AccAddress,
 	valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount sdk.Dec) (
@@ -733,6 +814,10 @@ func (k Keeper) BeginRedelegation(ctx sdk.Context, delAddr sdk.AccAddress,
 		return time.Time{}, types.ErrVerySmallRedelegation(k.Codespace())
 	}
 
+	if err := k.CheckVotingPowerCap(ctx, dstValidator, returnAmount); err != nil {
+		return time.Time{}, err
+	}
+
 	sharesCreated, err := k.Delegate(ctx, delAddr, returnAmount, srcValidator.GetStatus(), dstValidator, false)
 	if err != nil {
 		return time.Time{}, err
@@ -280,6 +280,66 @@ func TestUnbondingDelegationsMaxEntries(t *testing.T) {
 	require.True(sdk.IntEq(t, notBondedPool.GetCoins().AmountOf(bondDenom), oldNotBonded.AddRaw(1)))
 }
 
+func TestCancelUnbondingDelegation(t *testing.T) {
+	ctx, _, keeper, _ := CreateTestInput(t, false, 1)
+	startTokens := sdk.TokensFromConsensusPower(10)
+	bondDenom := keeper.BondDenom(ctx)
+
+	notBondedPool := keeper.GetNotBondedPool(ctx)
+	err := notBondedPool.SetCoins(sdk.NewCoins(sdk.NewCoin(bondDenom, startTokens)))
+	require.NoError(t, err)
+	keeper.supplyKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	// create a validator and a delegator to that validator
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+	require.Equal(t, startTokens, issuedShares.RoundInt())
+
+	validator = TestingUpdateValidator(keeper, ctx, validator, true)
+	require.True(t, validator.IsBonded())
+
+	delegation := types.NewDelegation(addrDels[0], addrVals[0], issuedShares)
+	keeper.SetDelegation(ctx, delegation)
+
+	oldBonded := keeper.GetBondedPool(ctx).GetCoins().AmountOf(bondDenom)
+	oldNotBonded := keeper.GetNotBondedPool(ctx).GetCoins().AmountOf(bondDenom)
+
+	// create two unbonding entries at different heights that complete at the same time
+	ctx = ctx.WithBlockHeight(10)
+	completionTime, err := keeper.Undelegate(ctx, addrDels[0], addrVals[0], sdk.NewDec(1))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(11)
+	_, err = keeper.Undelegate(ctx, addrDels[0], addrVals[0], sdk.NewDec(2))
+	require.NoError(t, err)
+	require.Len(t, keeper.GetUBDQueueTimeSlice(ctx, completionTime), 2)
+
+	// cancel the second entry
+	amount, err := keeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], 11)
+	require.NoError(t, err)
+	require.True(sdk.IntEq(t, sdk.NewInt(2), amount))
+
+	// only the first entry remains in the unbonding delegation and in the queue
+	ubd, found := keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, int64(10), ubd.Entries[0].CreationHeight)
+	require.Len(t, keeper.GetUBDQueueTimeSlice(ctx, completionTime), 1)
+
+	// the cancelled tokens are moved back to the bonded pool
+	require.True(sdk.IntEq(t, oldBonded.SubRaw(1), keeper.GetBondedPool(ctx).GetCoins().AmountOf(bondDenom)))
+	require.True(sdk.IntEq(t, oldNotBonded.AddRaw(1), keeper.GetNotBondedPool(ctx).GetCoins().AmountOf(bondDenom)))
+
+	delegation, found = keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, issuedShares.Sub(sdk.NewDec(1)), delegation.Shares)
+
+	// a mature entry can't be cancelled
+	ctx = ctx.WithBlockTime(completionTime)
+	_, err = keeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], 10)
+	require.Error(t, err)
+}
+
 // test undelegating self delegation from a validator pushing it below MinSelfDelegation
 // shift it from the bonded to unbonding state and jailed
 func TestUndelegateSelfDelegationBelowMinSelfDelegation(t *testing.T) {
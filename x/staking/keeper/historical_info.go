@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetHistoricalInfo gets the historical info at a given height
+func (k Keeper) GetHistoricalInfo(ctx sdk.Context, height int64) (hi types.HistoricalInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetHistoricalInfoKey(height))
+	if value == nil {
+		return hi, false
+	}
+
+	hi = types.MustUnmarshalHistoricalInfo(k.cdc, value)
+	return hi, true
+}
+
+// SetHistoricalInfo sets the historical info at a given height
+func (k Keeper) SetHistoricalInfo(ctx sdk.Context, height int64, hi types.HistoricalInfo) {
+	store := ctx.KVStore(k.storeKey)
+	value := types.MustMarshalHistoricalInfo(k.cdc, hi)
+	store.Set(types.GetHistoricalInfoKey(height), value)
+}
+
+// DeleteHistoricalInfo deletes the historical info at a given height
+func (k Keeper) DeleteHistoricalInfo(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetHistoricalInfoKey(height))
+}
+
+// IterateHistoricalInfo iterates through all the stored historical info
+func (k Keeper) IterateHistoricalInfo(ctx sdk.Context, cb func(hi types.HistoricalInfo) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.HistoricalInfoKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		hi := types.MustUnmarshalHistoricalInfo(k.cdc, iterator.Value())
+		if cb(hi) {
+			break
+		}
+	}
+}
+
+// GetAllHistoricalInfo returns all the stored historical info
+func (k Keeper) GetAllHistoricalInfo(ctx sdk.Context) (infos []types.HistoricalInfo) {
+	k.IterateHistoricalInfo(ctx, func(hi types.HistoricalInfo) bool {
+		infos = append(infos, hi)
+		return false
+	})
+	return infos
+}
+
+// TrackHistoricalInfo saves the latest historical info and deletes the
+// entries that fall out of the window defined by the HistoricalEntries param
+func (k Keeper) TrackHistoricalInfo(ctx sdk.Context) {
+	entryNum := k.HistoricalEntries(ctx)
+
+	// Prune the store so that only the last entryNum entries are kept. In
+	// most cases this removes a single entry, but when the param has been
+	// lowered all the entries below the new window have to go. They are always
+	// a contiguous range ending at the previous height, so iterate downwards
+	// from the most recent height to prune and stop at the first missing entry
+	// below the current height (which has not been stored yet).
+	for i := ctx.BlockHeight() - int64(entryNum); i >= 0; i-- {
+		_, found := k.GetHistoricalInfo(ctx, i)
+		if found {
+			k.DeleteHistoricalInfo(ctx, i)
+		} else if i < ctx.BlockHeight() {
+			break
+		}
+	}
+
+	// if there is no need to persist historical info, return
+	if entryNum == 0 {
+		return
+	}
+
+	lastVals := k.GetLastValidators(ctx)
+	historicalEntry := types.NewHistoricalInfo(ctx.BlockHeader(), lastVals)
+	k.SetHistoricalInfo(ctx, ctx.BlockHeight(), historicalEntry)
+}
@@ -20,6 +20,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		PositiveDelegationInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "delegator-shares",
 		DelegatorSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "tokenized-shares",
+		TokenizedSharesInvariant(k))
 }
 
 // AllInvariants runs all invariants of the staking module.
@@ -41,7 +43,12 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			return err
 		}
 
-		return DelegatorSharesInvariant(k)(ctx)
+		err = DelegatorSharesInvariant(k)(ctx)
+		if err != nil {
+			return err
+		}
+
+		return TokenizedSharesInvariant(k)(ctx)
 	}
 }
 
@@ -168,3 +175,61 @@ func DelegatorSharesInvariant(k Keeper) sdk.Invariant {
 		return nil
 	}
 }
+
+// TokenizedSharesInvariant checks that the tokenized shares record of each
+// validator matches the delegation held by the tokenized shares pool, and that
+// the share tokens in circulation are worth at least one share each, as the
+// rewards compounded into the pool delegation only raise their worth
+func TokenizedSharesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		poolAddr := k.supplyKeeper.GetModuleAddress(types.TokenizedSharesPoolName)
+		supply := k.supplyKeeper.GetSupply(ctx).Total
+
+		// every share token in circulation must be backed by a record
+		for _, coin := range supply {
+			valAddr, err := types.ParseShareTokenDenom(coin.Denom)
+			if err != nil {
+				continue
+			}
+
+			if _, found := k.GetTokenizedShares(ctx, valAddr); !found {
+				return fmt.Errorf("share tokens without tokenized shares record:\n"+
+					"\tsupply of %s: %v", coin.Denom, coin.Amount)
+			}
+		}
+
+		records := make(map[string]bool)
+		for _, record := range k.GetAllTokenizedShares(ctx) {
+			records[record.ValidatorAddress.String()] = true
+
+			poolShares := sdk.ZeroDec()
+			if poolAddr != nil {
+				if delegation, found := k.GetDelegation(ctx, poolAddr, record.ValidatorAddress); found {
+					poolShares = delegation.Shares
+				}
+			}
+
+			tokenSupply := supply.AmountOf(record.Denom()).ToDec()
+
+			if !record.Shares.Equal(poolShares) || !tokenSupply.IsPositive() || record.Shares.LT(tokenSupply) {
+				return fmt.Errorf("broken tokenized shares invariance:\n"+
+					"\tvalidator: %s\n"+
+					"\ttokenized shares: %v\n"+
+					"\tpool delegation shares: %v\n"+
+					"\tshare token supply: %v",
+					record.ValidatorAddress, record.Shares, poolShares, tokenSupply)
+			}
+		}
+
+		// every delegation held by the pool must be backed by a record
+		if poolAddr != nil {
+			for _, delegation := range k.GetAllDelegatorDelegations(ctx, poolAddr) {
+				if !records[delegation.ValidatorAddress.String()] {
+					return fmt.Errorf("tokenized shares pool delegation without record: %+v", delegation)
+				}
+			}
+		}
+
+		return nil
+	}
+}
@@ -37,19 +37,42 @@ func (k Keeper) MaxEntries(ctx sdk.Context) (res uint16) {
 	return
 }
 
+// HistoricalEntries - Number of historical info entries
+// to persist in the store
+func (k Keeper) HistoricalEntries(ctx sdk.Context) (res uint16) {
+	k.paramstore.Get(ctx, types.KeyHistoricalEntries, &res)
+	return
+}
+
 // BondDenom - Bondable coin denomination
 func (k Keeper) BondDenom(ctx sdk.Context) (res string) {
 	k.paramstore.Get(ctx, types.KeyBondDenom, &res)
 	return
 }
 
+// MaxVotingPowerRatio - Maximum ratio of the total bonded power
+// reported to Tendermint for a single validator
+func (k Keeper) MaxVotingPowerRatio(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMaxVotingPowerRatio, &res)
+	return
+}
+
+// VotingPowerCapMode - Handling of delegations over the voting power cap
+func (k Keeper) VotingPowerCapMode(ctx sdk.Context) (res string) {
+	k.paramstore.Get(ctx, types.KeyVotingPowerCapMode, &res)
+	return
+}
+
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
 		k.UnbondingTime(ctx),
 		k.MaxValidators(ctx),
 		k.MaxEntries(ctx),
+		k.HistoricalEntries(ctx),
 		k.BondDenom(ctx),
+		k.MaxVotingPowerRatio(ctx),
+		k.VotingPowerCapMode(ctx),
 	)
 }
 
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// maxConsensusPower returns the maximum consensus power reported to Tendermint
+// for a single validator given the total bonded power. It returns zero if the
+// voting power cap is disabled.
+func (k Keeper) maxConsensusPower(ctx sdk.Context, totalPower int64) int64 {
+	ratio := k.MaxVotingPowerRatio(ctx)
+	if !ratio.IsPositive() {
+		return 0
+	}
+
+	// never clip a validator to zero power, it would be removed from the set
+	maxPower := ratio.MulInt64(totalPower).TruncateInt64()
+	if maxPower < 1 {
+		maxPower = 1
+	}
+	return maxPower
+}
+
+// ExceedsVotingPowerCap returns true if delegating the given amount of tokens
+// to the validator would push its power above the voting power cap. The
+// validator's tokens are considered bonded even if it isn't in the bonded set
+// yet.
+func (k Keeper) ExceedsVotingPowerCap(ctx sdk.Context, validator types.Validator, tokens sdk.Int) bool {
+	totalTokens := k.TotalBondedTokens(ctx).Add(tokens)
+	if !validator.IsBonded() {
+		totalTokens = totalTokens.Add(validator.GetTokens())
+	}
+
+	maxPower := k.maxConsensusPower(ctx, sdk.TokensToConsensusPower(totalTokens))
+	if maxPower == 0 {
+		return false
+	}
+
+	return sdk.TokensToConsensusPower(validator.GetTokens().Add(tokens)) > maxPower
+}
+
+// CheckVotingPowerCap checks whether delegating the amount of tokens to the
+// validator pushes it over the voting power cap. Depending on the cap mode the
+// delegation is either rejected or accepted with a warning event. It must be
+// called by every path bonding new tokens to an existing validator.
+func (k Keeper) CheckVotingPowerCap(ctx sdk.Context, validator types.Validator, amount sdk.Int) sdk.Error {
+	if !k.ExceedsVotingPowerCap(ctx, validator, amount) {
+		return nil
+	}
+
+	if k.VotingPowerCapMode(ctx) == types.VotingPowerCapModeReject {
+		return types.ErrVotingPowerCapExceeded(k.Codespace())
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("delegation pushes validator %s over the voting power cap", validator.OperatorAddress))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVotingPowerCap,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+	return nil
+}
+
+// clippedPowerToBondedPower converts the power of a validator reported by
+// Tendermint back to its bonded power if it has been clipped to the voting
+// power cap. The bonded power at the time of the infraction is not known in
+// that case, so it is approximated with the validator's current power.
+func (k Keeper) clippedPowerToBondedPower(ctx sdk.Context, validator types.Validator, power int64) int64 {
+	if !validator.IsBonded() {
+		return power
+	}
+
+	// a clipped validator was reported with exactly the cap at the last update
+	maxPower := k.maxConsensusPower(ctx, k.GetLastTotalPower(ctx).Int64())
+	if maxPower == 0 || power != maxPower || k.GetLastValidatorPower(ctx, validator.GetOperator()) != maxPower {
+		return power
+	}
+
+	if currentPower := validator.ConsensusPower(); currentPower > power {
+		return currentPower
+	}
+	return power
+}
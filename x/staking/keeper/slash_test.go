@@ -240,6 +240,52 @@ func TestSlashValidatorAtCurrentHeight(t *testing.T) {
 	require.Equal(t, sdk.TokensFromConsensusPower(5), diffTokens)
 }
 
+// tests Slash of a validator whose power is clipped to the voting power cap
+func TestSlashClippedValidator(t *testing.T) {
+	ctx, keeper, params := setupHelper(t, 10)
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	fraction := sdk.NewDecWithPrec(5, 1)
+
+	params.MaxVotingPowerRatio = sdk.NewDecWithPrec(4, 1)
+	keeper.SetParams(ctx, params)
+
+	// double the power of the validator, it is clipped to 40% of the total power of 40
+	extraTokens := sdk.TokensFromConsensusPower(10)
+	bondedPool := keeper.GetBondedPool(ctx)
+	err := bondedPool.SetCoins(bondedPool.GetCoins().Add(sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), extraTokens))))
+	require.NoError(t, err)
+	keeper.supplyKeeper.SetModuleAccount(ctx, bondedPool)
+
+	validator, found := keeper.GetValidatorByConsAddr(ctx, consAddr)
+	require.True(t, found)
+	validator, _ = validator.AddTokensFromDel(extraTokens)
+	validator = TestingUpdateValidator(keeper, ctx, validator, true)
+	require.Equal(t, int64(20), validator.GetConsensusPower())
+	require.Equal(t, int64(16), keeper.GetLastValidatorPower(ctx, validator.OperatorAddress))
+
+	// Tendermint reports the clipped power, the bonded power is slashed
+	oldBondedPool := keeper.GetBondedPool(ctx)
+	keeper.Slash(ctx, consAddr, ctx.BlockHeight(), 16, fraction)
+
+	validator = keeper.mustGetValidator(ctx, validator.OperatorAddress)
+	require.Equal(t, int64(10), validator.GetConsensusPower())
+	newBondedPool := keeper.GetBondedPool(ctx)
+	diffTokens := oldBondedPool.GetCoins().Sub(newBondedPool.GetCoins()).AmountOf(keeper.BondDenom(ctx))
+	require.Equal(t, sdk.TokensFromConsensusPower(10), diffTokens)
+
+	// the slashed validator falls below the cap of the reduced total power
+	updates := keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, 1, len(updates))
+	require.Equal(t, validator.ABCIValidatorUpdate(), updates[0])
+
+	// a validator below the cap is slashed by its reported power
+	consAddr = sdk.ConsAddress(PKs[1].Address())
+	keeper.Slash(ctx, consAddr, ctx.BlockHeight(), 10, fraction)
+	validator, found = keeper.GetValidatorByConsAddr(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, int64(5), validator.GetConsensusPower())
+}
+
 // tests Slash at a previous height with an unbonding delegation
 func TestSlashWithUnbondingDelegation(t *testing.T) {
 	ctx, keeper, _ := setupHelper(t, 10)
@@ -0,0 +1,281 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetTokenizedShares returns the tokenized shares record of a validator
+func (k Keeper) GetTokenizedShares(ctx sdk.Context, valAddr sdk.ValAddress) (record types.TokenizedShares, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetTokenizedSharesKey(valAddr))
+	if value == nil {
+		return record, false
+	}
+
+	record = types.MustUnmarshalTokenizedShares(k.cdc, value)
+	return record, true
+}
+
+// SetTokenizedShares sets the tokenized shares record of a validator
+func (k Keeper) SetTokenizedShares(ctx sdk.Context, record types.TokenizedShares) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalTokenizedShares(k.cdc, record)
+	store.Set(types.GetTokenizedSharesKey(record.ValidatorAddress), bz)
+}
+
+// RemoveTokenizedShares removes the tokenized shares record of a validator
+func (k Keeper) RemoveTokenizedShares(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizedSharesKey(valAddr))
+}
+
+// IterateTokenizedShares iterates through all the tokenized shares records
+func (k Keeper) IterateTokenizedShares(ctx sdk.Context, cb func(record types.TokenizedShares) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenizedSharesKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.MustUnmarshalTokenizedShares(k.cdc, iterator.Value())
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetAllTokenizedShares returns all the tokenized shares records
+func (k Keeper) GetAllTokenizedShares(ctx sdk.Context) (records []types.TokenizedShares) {
+	k.IterateTokenizedShares(ctx, func(record types.TokenizedShares) bool {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// TokenizeShares moves delegation shares from a delegator to the tokenized
+// shares pool and mints share tokens to the delegator at the current
+// redemption rate of the validator's share tokens. Only whole share tokens are
+// minted, the shares worth a fraction of a token remain in the delegation.
+func (k Keeper) TokenizeShares(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress,
+	shares sdk.Dec) (shareTokens sdk.Coin, err sdk.Error) {
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return shareTokens, types.ErrNoValidatorFound(k.Codespace())
+	}
+
+	// the self delegation must remain with the operator, otherwise it could be
+	// transferred away without triggering the minimum self delegation check
+	if delAddr.Equals(validator.OperatorAddress) {
+		return shareTokens, types.ErrTokenizeSelfDelegation(k.Codespace())
+	}
+
+	// the share tokens would be spendable while the delegated coins are still
+	// locked by the vesting schedule
+	if k.isVestingAccount(ctx, delAddr) {
+		return shareTokens, types.ErrTokenizeVestingAccount(k.Codespace())
+	}
+
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return shareTokens, types.ErrNoDelegation(k.Codespace())
+	}
+
+	poolAddr := k.supplyKeeper.GetModuleAddress(types.TokenizedSharesPoolName)
+	if poolAddr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.TokenizedSharesPoolName))
+	}
+
+	// the rewards of the pool are compounded first so that the new share
+	// tokens don't get a part of them
+	if err := k.compoundTokenizedSharesRewards(ctx, valAddr); err != nil {
+		return shareTokens, err
+	}
+
+	record, found := k.GetTokenizedShares(ctx, valAddr)
+	if !found {
+		record = types.NewTokenizedShares(valAddr, sdk.ZeroDec())
+	}
+
+	amount, tokenizedShares := shares.TruncateInt(), shares.TruncateInt().ToDec()
+	if supply := k.shareTokenSupply(ctx, valAddr); supply.IsPositive() {
+		amount = shares.MulInt(supply).QuoTruncate(record.Shares).TruncateInt()
+		tokenizedShares = record.Shares.MulInt(amount).QuoRoundUp(supply.ToDec())
+	}
+
+	if !amount.IsPositive() {
+		return shareTokens, types.ErrVerySmallTokenization(k.Codespace())
+	}
+
+	if delegation.Shares.LT(tokenizedShares) {
+		return shareTokens, types.ErrNotEnoughDelegationShares(k.Codespace(), delegation.Shares.String())
+	}
+
+	k.transferDelegationShares(ctx, delegation, poolAddr, tokenizedShares)
+
+	shareTokens = sdk.NewCoin(types.GetShareTokenDenom(valAddr), amount)
+	if err := k.supplyKeeper.MintCoins(ctx, types.TokenizedSharesPoolName, sdk.NewCoins(shareTokens)); err != nil {
+		panic(err)
+	}
+
+	err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.TokenizedSharesPoolName, delAddr, sdk.NewCoins(shareTokens))
+	if err != nil {
+		panic(err)
+	}
+
+	record.Shares = record.Shares.Add(tokenizedShares)
+	k.SetTokenizedShares(ctx, record)
+
+	return shareTokens, nil
+}
+
+// RedeemTokensForShares burns the given share tokens and moves the delegation
+// shares they are worth from the tokenized shares pool to the delegator. It
+// returns the address of the validator the share tokens were derived from.
+func (k Keeper) RedeemTokensForShares(ctx sdk.Context, delAddr sdk.AccAddress,
+	shareTokens sdk.Coin) (valAddr sdk.ValAddress, err sdk.Error) {
+
+	valAddr, parseErr := types.ParseShareTokenDenom(shareTokens.Denom)
+	if parseErr != nil {
+		return nil, types.ErrBadShareTokenDenom(k.Codespace(), shareTokens.Denom)
+	}
+
+	// the redeemed delegation would not be tracked as delegated free coins,
+	// so undelegating it would reduce the delegated vesting coins instead
+	if k.isVestingAccount(ctx, delAddr) {
+		return nil, types.ErrTokenizeVestingAccount(k.Codespace())
+	}
+
+	if _, found := k.GetTokenizedShares(ctx, valAddr); !found {
+		return nil, types.ErrNoTokenizedShares(k.Codespace())
+	}
+
+	supply := k.shareTokenSupply(ctx, valAddr)
+	if supply.LT(shareTokens.Amount) {
+		return nil, types.ErrNotEnoughShareTokens(k.Codespace(), supply.String())
+	}
+
+	coins := sdk.NewCoins(shareTokens)
+	err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.TokenizedSharesBurnerName, coins)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.supplyKeeper.BurnCoins(ctx, types.TokenizedSharesBurnerName, coins); err != nil {
+		panic(err)
+	}
+
+	// the share tokens are redeemed with the rewards of the pool compounded
+	if err := k.compoundTokenizedSharesRewards(ctx, valAddr); err != nil {
+		return nil, err
+	}
+
+	record, _ := k.GetTokenizedShares(ctx, valAddr)
+	shares := record.Shares
+	if shareTokens.Amount.LT(supply) {
+		shares = record.Shares.MulInt(shareTokens.Amount).QuoInt(supply)
+	}
+
+	poolAddr := k.supplyKeeper.GetModuleAddress(types.TokenizedSharesPoolName)
+	delegation, found := k.GetDelegation(ctx, poolAddr, valAddr)
+	if !found {
+		panic(fmt.Sprintf("tokenized shares of validator %s have no delegation", valAddr))
+	}
+
+	k.transferDelegationShares(ctx, delegation, delAddr, shares)
+
+	record.Shares = record.Shares.Sub(shares)
+	if record.Shares.IsZero() {
+		k.RemoveTokenizedShares(ctx, valAddr)
+	} else {
+		k.SetTokenizedShares(ctx, record)
+	}
+
+	return valAddr, nil
+}
+
+// shareTokenSupply returns the total supply of the share tokens of a validator
+func (k Keeper) shareTokenSupply(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Int {
+	return k.supplyKeeper.GetSupply(ctx).Total.AmountOf(types.GetShareTokenDenom(valAddr))
+}
+
+// compoundTokenizedSharesRewards withdraws the rewards of the tokenized shares
+// pool delegation to a validator and delegates the ones in the bond denom back
+// to the validator, which raises the amount of shares each share token is
+// redeemed for. The rewards are withdrawn to the pool account by the
+// distribution hooks.
+func (k Keeper) compoundTokenizedSharesRewards(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Error {
+	poolAddr := k.supplyKeeper.GetModuleAddress(types.TokenizedSharesPoolName)
+	if _, found := k.GetDelegation(ctx, poolAddr, valAddr); !found {
+		return nil
+	}
+
+	bondDenom := k.BondDenom(ctx)
+	balance := k.supplyKeeper.GetModuleAccount(ctx, types.TokenizedSharesPoolName).GetCoins().AmountOf(bondDenom)
+
+	k.BeforeDelegationSharesModified(ctx, poolAddr, valAddr)
+	k.AfterDelegationModified(ctx, poolAddr, valAddr)
+
+	rewards := k.supplyKeeper.GetModuleAccount(ctx, types.TokenizedSharesPoolName).GetCoins().AmountOf(bondDenom).Sub(balance)
+	if !rewards.IsPositive() {
+		return nil
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		panic(fmt.Sprintf("tokenized shares of validator %s have no validator", valAddr))
+	}
+
+	newShares, err := k.Delegate(ctx, poolAddr, rewards, sdk.Unbonded, validator, true)
+	if err != nil {
+		return err
+	}
+
+	record, _ := k.GetTokenizedShares(ctx, valAddr)
+	record.Shares = record.Shares.Add(newShares)
+	k.SetTokenizedShares(ctx, record)
+
+	return nil
+}
+
+// transferDelegationShares moves shares from a delegation to another delegator
+// of the same validator. The validator's tokens and shares are left untouched.
+func (k Keeper) transferDelegationShares(ctx sdk.Context, src types.Delegation,
+	dstAddr sdk.AccAddress, shares sdk.Dec) {
+
+	valAddr := src.ValidatorAddress
+
+	// call the before-delegation hooks of both delegations
+	k.BeforeDelegationSharesModified(ctx, src.DelegatorAddress, valAddr)
+
+	dst, found := k.GetDelegation(ctx, dstAddr, valAddr)
+	if found {
+		k.BeforeDelegationSharesModified(ctx, dstAddr, valAddr)
+	} else {
+		dst = types.NewDelegation(dstAddr, valAddr, sdk.ZeroDec())
+		k.BeforeDelegationCreated(ctx, dstAddr, valAddr)
+	}
+
+	src.Shares = src.Shares.Sub(shares)
+	if src.Shares.IsZero() {
+		k.RemoveDelegation(ctx, src)
+	} else {
+		k.SetDelegation(ctx, src)
+		k.AfterDelegationModified(ctx, src.DelegatorAddress, valAddr)
+	}
+
+	dst.Shares = dst.Shares.Add(shares)
+	k.SetDelegation(ctx, dst)
+	k.AfterDelegationModified(ctx, dstAddr, valAddr)
+}
+
+// isVestingAccount returns true if the account at the given address is a
+// vesting account
+func (k Keeper) isVestingAccount(ctx sdk.Context, addr sdk.AccAddress) bool {
+	_, ok := k.accountKeeper.GetAccount(ctx, addr).(authexported.VestingAccount)
+	return ok
+}
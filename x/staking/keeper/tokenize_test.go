@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestTokenizeShares(t *testing.T) {
+	ctx, accKeeper, keeper, supplyKeeper := CreateTestInput(t, false, 1000)
+	delTokens := sdk.TokensFromConsensusPower(10)
+	shareDenom := types.GetShareTokenDenom(addrVals[0])
+
+	// create a validator and a delegation to it
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	validator = TestingUpdateValidator(keeper, ctx, validator, true)
+	_, err := keeper.Delegate(ctx, addrDels[0], delTokens, sdk.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	validator, found := keeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+
+	// the validator operator can't tokenize its self delegation
+	_, err = keeper.Delegate(ctx, sdk.AccAddress(addrVals[0]), delTokens, sdk.Unbonded, validator, true)
+	require.NoError(t, err)
+	bondedTokens := keeper.TotalBondedTokens(ctx)
+	_, err = keeper.TokenizeShares(ctx, sdk.AccAddress(addrVals[0]), addrVals[0], delTokens.ToDec())
+	require.Error(t, err)
+
+	// fractional shares can't be tokenized
+	_, err = keeper.TokenizeShares(ctx, addrDels[0], addrVals[0], sdk.NewDecWithPrec(5, 1))
+	require.Error(t, err)
+
+	// more shares than delegated can't be tokenized
+	_, err = keeper.TokenizeShares(ctx, addrDels[0], addrVals[0], delTokens.AddRaw(1).ToDec())
+	require.Error(t, err)
+
+	// tokenize a third of the delegation
+	tokenizeAmt := delTokens.QuoRaw(3)
+	shareTokens, err := keeper.TokenizeShares(ctx, addrDels[0], addrVals[0], tokenizeAmt.ToDec())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin(shareDenom, tokenizeAmt), shareTokens)
+	require.True(sdk.IntEq(t, tokenizeAmt, accKeeper.GetAccount(ctx, addrDels[0]).GetCoins().AmountOf(shareDenom)))
+	require.True(sdk.IntEq(t, tokenizeAmt, supplyKeeper.GetSupply(ctx).Total.AmountOf(shareDenom)))
+
+	// the shares are moved to the pool without changing the bonded tokens
+	delegation, found := keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, delTokens.Sub(tokenizeAmt).ToDec(), delegation.Shares)
+
+	poolAddr := supplyKeeper.GetModuleAddress(types.TokenizedSharesPoolName)
+	poolDelegation, found := keeper.GetDelegation(ctx, poolAddr, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, tokenizeAmt.ToDec(), poolDelegation.Shares)
+
+	record, found := keeper.GetTokenizedShares(ctx, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, tokenizeAmt.ToDec(), record.Shares)
+	require.Equal(t, bondedTokens, keeper.TotalBondedTokens(ctx))
+	require.NoError(t, TokenizedSharesInvariant(keeper)(ctx))
+
+	// transfer the share tokens to another account
+	holder := accKeeper.GetAccount(ctx, addrDels[1])
+	require.NoError(t, holder.SetCoins(holder.GetCoins().Add(sdk.NewCoins(shareTokens))))
+	accKeeper.SetAccount(ctx, holder)
+	owner := accKeeper.GetAccount(ctx, addrDels[0])
+	require.NoError(t, owner.SetCoins(owner.GetCoins().Sub(sdk.NewCoins(shareTokens))))
+	accKeeper.SetAccount(ctx, owner)
+
+	// redeeming unknown or unowned share tokens fails
+	_, err = keeper.RedeemTokensForShares(ctx, addrDels[1], sdk.NewCoin(types.GetShareTokenDenom(addrVals[1]), tokenizeAmt))
+	require.Error(t, err)
+	_, err = keeper.RedeemTokensForShares(ctx, addrDels[0], shareTokens)
+	require.Error(t, err)
+
+	// the new holder redeems part of the share tokens
+	redeemAmt := tokenizeAmt.QuoRaw(2)
+	valAddr, err := keeper.RedeemTokensForShares(ctx, addrDels[1], sdk.NewCoin(shareDenom, redeemAmt))
+	require.NoError(t, err)
+	require.Equal(t, addrVals[0], valAddr)
+
+	delegation, found = keeper.GetDelegation(ctx, addrDels[1], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, redeemAmt.ToDec(), delegation.Shares)
+	require.True(sdk.IntEq(t, tokenizeAmt.Sub(redeemAmt), supplyKeeper.GetSupply(ctx).Total.AmountOf(shareDenom)))
+
+	record, found = keeper.GetTokenizedShares(ctx, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, tokenizeAmt.Sub(redeemAmt).ToDec(), record.Shares)
+	require.NoError(t, TokenizedSharesInvariant(keeper)(ctx))
+
+	// redeeming the remaining share tokens removes the record and the pool delegation
+	_, err = keeper.RedeemTokensForShares(ctx, addrDels[1], sdk.NewCoin(shareDenom, tokenizeAmt.Sub(redeemAmt)))
+	require.NoError(t, err)
+
+	_, found = keeper.GetTokenizedShares(ctx, addrVals[0])
+	require.False(t, found)
+	_, found = keeper.GetDelegation(ctx, poolAddr, addrVals[0])
+	require.False(t, found)
+	require.True(t, supplyKeeper.GetSupply(ctx).Total.AmountOf(shareDenom).IsZero())
+	require.Equal(t, bondedTokens, keeper.TotalBondedTokens(ctx))
+	require.NoError(t, TokenizedSharesInvariant(keeper)(ctx))
+}
+
+func TestTokenizeSharesVestingAccount(t *testing.T) {
+	ctx, accKeeper, keeper, _ := CreateTestInput(t, false, 1000)
+	delTokens := sdk.TokensFromConsensusPower(10)
+	shareDenom := types.GetShareTokenDenom(addrVals[0])
+
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	validator = TestingUpdateValidator(keeper, ctx, validator, true)
+
+	// turn the delegator into a vesting account, vesting over the next year
+	acc := accKeeper.GetAccount(ctx, addrDels[0])
+	baseAcc := auth.NewBaseAccount(acc.GetAddress(), acc.GetCoins(), acc.GetPubKey(), acc.GetAccountNumber(), acc.GetSequence())
+	startTime := ctx.BlockHeader().Time.Unix()
+	accKeeper.SetAccount(ctx, auth.NewContinuousVestingAccount(baseAcc, startTime, startTime+60*60*24*365))
+
+	_, err := keeper.Delegate(ctx, addrDels[0], delTokens, sdk.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	// vesting accounts can't tokenize their delegation
+	_, err = keeper.TokenizeShares(ctx, addrDels[0], addrVals[0], delTokens.ToDec())
+	require.Error(t, err)
+
+	delegation, found := keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, delTokens.ToDec(), delegation.Shares)
+
+	// nor redeem share tokens received from another delegator
+	_, err = keeper.Delegate(ctx, addrDels[1], delTokens, sdk.Unbonded, validator, true)
+	require.NoError(t, err)
+	shareTokens, err := keeper.TokenizeShares(ctx, addrDels[1], addrVals[0], delTokens.ToDec())
+	require.NoError(t, err)
+
+	holder := accKeeper.GetAccount(ctx, addrDels[1])
+	require.NoError(t, holder.SetCoins(holder.GetCoins().Sub(sdk.NewCoins(shareTokens))))
+	accKeeper.SetAccount(ctx, holder)
+	vestingAcc := accKeeper.GetAccount(ctx, addrDels[0])
+	require.NoError(t, vestingAcc.SetCoins(vestingAcc.GetCoins().Add(sdk.NewCoins(shareTokens))))
+	accKeeper.SetAccount(ctx, vestingAcc)
+
+	_, err = keeper.RedeemTokensForShares(ctx, addrDels[0], sdk.NewCoin(shareDenom, delTokens))
+	require.Error(t, err)
+	require.True(sdk.IntEq(t, delTokens, accKeeper.GetAccount(ctx, addrDels[0]).GetCoins().AmountOf(shareDenom)))
+	require.NoError(t, TokenizedSharesInvariant(keeper)(ctx))
+}
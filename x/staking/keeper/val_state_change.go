@@ -35,6 +35,13 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 	// (see LastValidatorPowerKey).
 	last := k.getLastValidatorsByAddr(ctx)
 
+	// Validators of the new bonded set, highest power to lowest, with their
+	// last reported power bytes (nil for validators not in the last set).
+	var (
+		bonded          []types.Validator
+		bondedLastPower [][]byte
+	)
+
 	// Iterate over validators, highest power to lowest.
 	iterator := sdk.KVStoreReversePrefixIterator(store, types.ValidatorsByPowerIndexKey)
 	defer iterator.Close()
@@ -74,26 +81,42 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		// fetch the old power bytes
 		var valAddrBytes [sdk.AddrLen]byte
 		copy(valAddrBytes[:], valAddr[:])
-		oldPowerBytes, found := last[valAddrBytes]
+		oldPowerBytes := last[valAddrBytes]
+
+		// validator still in the validator set, so delete from the copy
+		delete(last, valAddrBytes)
+
+		// keep count
+		count++
+		bonded = append(bonded, validator)
+		bondedLastPower = append(bondedLastPower, oldPowerBytes)
+		totalPower = totalPower.Add(sdk.NewInt(validator.ConsensusPower()))
+	}
+
+	// The power reported to Tendermint is clipped to the voting power cap, the
+	// tokens above the cap remain bonded. As the cap depends on the total
+	// power, it can only be applied once the whole bonded set is known.
+	maxPower := k.maxConsensusPower(ctx, totalPower.Int64())
+
+	for i, validator := range bonded {
+		oldPowerBytes := bondedLastPower[i]
 
 		// calculate the new power bytes
 		newPower := validator.ConsensusPower()
+		if maxPower > 0 && newPower > maxPower {
+			newPower = maxPower
+		}
 		newPowerBytes := k.cdc.MustMarshalBinaryLengthPrefixed(newPower)
 
 		// update the validator set if power has changed
-		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) {
-			updates = append(updates, validator.ABCIValidatorUpdate())
+		if oldPowerBytes == nil || !bytes.Equal(oldPowerBytes, newPowerBytes) {
+			update := validator.ABCIValidatorUpdate()
+			update.Power = newPower
+			updates = append(updates, update)
 
 			// set validator power on lookup index
-			k.SetLastValidatorPower(ctx, valAddr, newPower)
+			k.SetLastValidatorPower(ctx, validator.GetOperator(), newPower)
 		}
-
-		// validator still in the validator set, so delete from the copy
-		delete(last, valAddrBytes)
-
-		// keep count
-		count++
-		totalPower = totalPower.Add(sdk.NewInt(newPower))
 	}
 
 	// sort the no-longer-bonded validators
@@ -132,8 +155,10 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		// equal amounts of tokens; no update required
 	}
 
-	// set total power on lookup index if there are any updates
-	if len(updates) > 0 {
+	// set total power on lookup index if there are any updates, the total
+	// power includes the power above the voting power cap and thus can change
+	// without any update while the cap is enabled
+	if len(updates) > 0 || maxPower > 0 {
 		k.SetLastTotalPower(ctx, totalPower)
 	}
 
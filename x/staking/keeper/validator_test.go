@@ -1028,6 +1028,62 @@ func TestApplyAndReturnValidatorSetUpdatesBondTransition(t *testing.T) {
 	require.Equal(t, 0, len(keeper.ApplyAndReturnValidatorSetUpdates(ctx)))
 }
 
+func TestApplyAndReturnValidatorSetUpdatesVotingPowerCap(t *testing.T) {
+	ctx, _, keeper, _ := CreateTestInput(t, false, 1000)
+	params := keeper.GetParams(ctx)
+	params.MaxVotingPowerRatio = sdk.NewDecWithPrec(4, 1)
+	keeper.SetParams(ctx, params)
+
+	powers := []int64{100, 200, 300}
+	var validators [3]types.Validator
+	for i, power := range powers {
+		validators[i] = types.NewValidator(sdk.ValAddress(Addrs[i]), PKs[i], types.Description{})
+		tokens := sdk.TokensFromConsensusPower(power)
+		validators[i], _ = validators[i].AddTokensFromDel(tokens)
+		keeper.SetValidator(ctx, validators[i])
+		keeper.SetValidatorByPowerIndex(ctx, validators[i])
+	}
+
+	// the validator above 40% of the total power of 600 is reported clipped
+	updates := keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, 3, len(updates))
+	require.Equal(t, int64(240), updates[0].Power)
+	require.Equal(t, int64(200), updates[1].Power)
+	require.Equal(t, int64(100), updates[2].Power)
+	require.Equal(t, int64(240), keeper.GetLastValidatorPower(ctx, validators[2].OperatorAddress))
+
+	// the power above the cap still counts as bonded
+	validators[2] = keeper.mustGetValidator(ctx, validators[2].OperatorAddress)
+	require.True(t, validators[2].IsBonded())
+	require.Equal(t, int64(300), validators[2].GetConsensusPower())
+	require.Equal(t, sdk.NewInt(600), keeper.GetLastTotalPower(ctx))
+	require.Equal(t, sdk.TokensFromConsensusPower(600), keeper.TotalBondedTokens(ctx))
+
+	require.Equal(t, 0, len(keeper.ApplyAndReturnValidatorSetUpdates(ctx)))
+
+	// raising the total power raises the cap of the clipped validator
+	validators[0] = keeper.mustGetValidator(ctx, validators[0].OperatorAddress)
+	keeper.DeleteValidatorByPowerIndex(ctx, validators[0])
+	validators[0], _ = validators[0].AddTokensFromDel(sdk.TokensFromConsensusPower(100))
+	keeper.SetValidator(ctx, validators[0])
+	keeper.SetValidatorByPowerIndex(ctx, validators[0])
+
+	updates = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, 2, len(updates))
+	require.Equal(t, validators[2].ABCIValidatorUpdate().PubKey, updates[0].PubKey)
+	require.Equal(t, int64(280), updates[0].Power)
+	require.Equal(t, validators[0].ABCIValidatorUpdate(), updates[1])
+	require.Equal(t, sdk.NewInt(700), keeper.GetLastTotalPower(ctx))
+
+	// disabling the cap reports the full power
+	params.MaxVotingPowerRatio = sdk.ZeroDec()
+	keeper.SetParams(ctx, params)
+
+	updates = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, 1, len(updates))
+	require.Equal(t, validators[2].ABCIValidatorUpdate(), updates[0])
+}
+
 func TestUpdateValidatorCommission(t *testing.T) {
 	ctx, _, keeper, _ := CreateTestInput(t, false, 1000)
 	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Now().UTC()})
@@ -220,3 +220,121 @@ func SimulateMsgBeginRedelegate(m auth.AccountKeeper, k staking.Keeper) simulati
 		return opMsg, nil, nil
 	}
 }
+
+// SimulateMsgCancelUnbondingDelegation
+func SimulateMsgCancelUnbondingDelegation(m auth.AccountKeeper, k staking.Keeper) simulation.Operation {
+	handler := staking.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		delegatorAcc := simulation.RandomAcc(r, accs)
+		delegatorAddress := delegatorAcc.Address
+		ubds := k.GetUnbondingDelegations(ctx, delegatorAddress, 10)
+		if len(ubds) == 0 {
+			return simulation.NoOpMsg(), nil, nil
+		}
+		ubd := ubds[r.Intn(len(ubds))]
+		entry := ubd.Entries[r.Intn(len(ubd.Entries))]
+
+		msg := staking.NewMsgCancelUnbondingDelegation(delegatorAddress, ubd.ValidatorAddress, entry.CreationHeight)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s, got error %v",
+				msg.GetSignBytes(), msg.ValidateBasic())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := handler(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgTokenizeShares
+func SimulateMsgTokenizeShares(k staking.Keeper) simulation.Operation {
+	handler := staking.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		delegatorAcc := simulation.RandomAcc(r, accs)
+		delegatorAddress := delegatorAcc.Address
+		delegations := k.GetAllDelegatorDelegations(ctx, delegatorAddress)
+		if len(delegations) == 0 {
+			return simulation.NoOpMsg(), nil, nil
+		}
+		delegation := delegations[r.Intn(len(delegations))]
+
+		validator, found := k.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found {
+			return simulation.NoOpMsg(), nil, nil
+		}
+
+		totalBond := validator.TokensFromShares(delegation.GetShares()).TruncateInt()
+		tokenizeAmt := simulation.RandomAmount(r, totalBond)
+		if tokenizeAmt.Equal(sdk.ZeroInt()) {
+			return simulation.NoOpMsg(), nil, nil
+		}
+
+		msg := staking.NewMsgTokenizeShares(
+			delegatorAddress, delegation.ValidatorAddress, sdk.NewCoin(k.GetParams(ctx).BondDenom, tokenizeAmt),
+		)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s, got error %v",
+				msg.GetSignBytes(), msg.ValidateBasic())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := handler(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgRedeemTokensForShares
+func SimulateMsgRedeemTokensForShares(m auth.AccountKeeper, k staking.Keeper) simulation.Operation {
+	handler := staking.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		delegatorAcc := simulation.RandomAcc(r, accs)
+		delegatorAddress := delegatorAcc.Address
+
+		var shareTokens sdk.Coins
+		for _, coin := range m.GetAccount(ctx, delegatorAddress).GetCoins() {
+			if _, err := staking.ParseShareTokenDenom(coin.Denom); err == nil {
+				shareTokens = append(shareTokens, coin)
+			}
+		}
+		if len(shareTokens) == 0 {
+			return simulation.NoOpMsg(), nil, nil
+		}
+		coin := shareTokens[r.Intn(len(shareTokens))]
+
+		redeemAmt := simulation.RandomAmount(r, coin.Amount)
+		if redeemAmt.Equal(sdk.ZeroInt()) {
+			return simulation.NoOpMsg(), nil, nil
+		}
+
+		msg := staking.NewMsgRedeemTokensForShares(delegatorAddress, sdk.NewCoin(coin.Denom, redeemAmt))
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s, got error %v",
+				msg.GetSignBytes(), msg.ValidateBasic())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := handler(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
@@ -37,6 +37,10 @@ func ErrNoValidatorFound(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidValidator, "validator does not exist for that address")
 }
 
+func ErrVotingPowerCapExceeded(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidValidator, "delegation would exceed the voting power cap of the validator")
+}
+
 func ErrValidatorOwnerExists(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidValidator, "validator already exist for this operator address, must use new validator operator address")
 }
@@ -166,6 +170,43 @@ func ErrMaxUnbondingDelegationEntries(codespace sdk.CodespaceType) sdk.Error {
 		"too many unbonding delegation entries in this delegator/validator duo, please wait for some entries to mature")
 }
 
+func ErrNoUnbondingDelegationEntry(codespace sdk.CodespaceType, creationHeight int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation,
+		fmt.Sprintf("no unbonding delegation entry found at creation height %d", creationHeight))
+}
+
+func ErrUnbondingDelegationEntryMature(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "unbonding delegation entry is already mature")
+}
+
+func ErrBadCreationHeight(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "creation height cannot be negative")
+}
+
+func ErrBadShareTokenDenom(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, fmt.Sprintf("%s is not a valid share token denomination", denom))
+}
+
+func ErrTokenizeSelfDelegation(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "validator operators cannot tokenize their self delegation")
+}
+
+func ErrTokenizeVestingAccount(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "vesting accounts cannot tokenize or redeem delegation shares")
+}
+
+func ErrVerySmallTokenization(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "too few shares to tokenize, truncates to zero share tokens")
+}
+
+func ErrNoTokenizedShares(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "no tokenized shares found for this validator")
+}
+
+func ErrNotEnoughShareTokens(codespace sdk.CodespaceType, supply string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, fmt.Sprintf("not enough share tokens in circulation, only %v", supply))
+}
+
 func ErrBadRedelegationAddr(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidInput, "unexpected address length for this (address, srcValidator, dstValidator) tuple")
 }
@@ -212,3 +253,7 @@ func ErrNeitherShareMsgsGiven(codespace sdk.CodespaceType) sdk.Error {
 func ErrMissingSignature(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidValidator, "missing signature")
 }
+
+func ErrNoHistoricalInfo(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "no historical info found")
+}
@@ -9,6 +9,10 @@ var (
 	EventTypeDelegate             = "delegate"
 	EventTypeUnbond               = "unbond"
 	EventTypeRedelegate           = "redelegate"
+	EventTypeCancelUnbonding      = "cancel_unbonding_delegation"
+	EventTypeTokenizeShares       = "tokenize_shares"
+	EventTypeRedeemShares         = "redeem_tokens_for_shares"
+	EventTypeVotingPowerCap       = "voting_power_cap_exceeded"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -18,5 +22,7 @@ var (
 	AttributeKeyDelegator         = "delegator"
 	AttributeKeyAmount            = "amount"
 	AttributeKeyCompletionTime    = "completion_time"
+	AttributeKeyCreationHeight    = "creation_height"
+	AttributeKeyShareTokens       = "share_tokens"
 	AttributeValueCategory        = ModuleName
 )
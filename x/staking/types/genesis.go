@@ -13,6 +13,8 @@ type GenesisState struct {
 	Delegations          Delegations           `json:"delegations"`
 	UnbondingDelegations []UnbondingDelegation `json:"unbonding_delegations"`
 	Redelegations        []Redelegation        `json:"redelegations"`
+	TokenizedShares      []TokenizedShares     `json:"tokenized_shares"`
+	HistoricalInfos      []HistoricalInfo      `json:"historical_infos"`
 	Exported             bool                  `json:"exported"`
 }
 
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// HistoricalInfo contains the historical information that gets stored at each
+// height: the block header and the validator set sorted by operator address
+type HistoricalInfo struct {
+	Header abci.Header `json:"header"`
+	ValSet Validators  `json:"valset"`
+}
+
+// NewHistoricalInfo will create a historical information struct from header
+// and valset. It will first sort valset before inclusion into the historical
+// info
+func NewHistoricalInfo(header abci.Header, valSet Validators) HistoricalInfo {
+	valSet.Sort()
+	return HistoricalInfo{
+		Header: header,
+		ValSet: valSet,
+	}
+}
+
+// return the historical info
+func MustMarshalHistoricalInfo(cdc *codec.Codec, hi HistoricalInfo) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(hi)
+}
+
+// return the historical info
+func MustUnmarshalHistoricalInfo(cdc *codec.Codec, value []byte) HistoricalInfo {
+	hi, err := UnmarshalHistoricalInfo(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return hi
+}
+
+// return the historical info
+func UnmarshalHistoricalInfo(cdc *codec.Codec, value []byte) (hi HistoricalInfo, err error) {
+	err = cdc.UnmarshalBinaryLengthPrefixed(value, &hi)
+	return hi, err
+}
+
+// ValidateBasic will ensure HistoricalInfo is not nil and sorted
+func (hi HistoricalInfo) ValidateBasic() error {
+	if len(hi.ValSet) == 0 {
+		return fmt.Errorf("validator set is empty")
+	}
+	if !sort.IsSorted(hi.ValSet) {
+		return fmt.Errorf("validator set is not sorted by address")
+	}
+	return nil
+}
+
+// String returns a human readable string representation of a HistoricalInfo.
+func (hi HistoricalInfo) String() string {
+	return fmt.Sprintf(`Historical Info:
+  Height:        %d
+  Time:          %s
+  App Hash:      %X
+  Validator Set:
+%s`, hi.Header.Height, hi.Header.Time, hi.Header.AppHash, hi.ValSet)
+}
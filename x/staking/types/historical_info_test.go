@@ -0,0 +1,82 @@
+package types
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	validators = []Validator{
+		NewValidator(valAddr1, pk1, Description{}),
+		NewValidator(valAddr2, pk2, Description{}),
+		NewValidator(valAddr3, pk3, Description{}),
+	}
+	header = abci.Header{
+		ChainID: "hello",
+		Height:  5,
+	}
+)
+
+func TestHistoricalInfo(t *testing.T) {
+	hi := NewHistoricalInfo(header, validators)
+	require.True(t, sort.IsSorted(hi.ValSet), "Validators are not sorted")
+
+	var value []byte
+	require.NotPanics(t, func() {
+		value = MustMarshalHistoricalInfo(ModuleCdc, hi)
+	})
+
+	require.NotNil(t, value, "Marshalled HistoricalInfo is nil")
+
+	recv, err := UnmarshalHistoricalInfo(ModuleCdc, value)
+	require.Nil(t, err, "Unmarshalling HistoricalInfo failed")
+	require.Equal(t, hi, recv, "Unmarshalled HistoricalInfo is different from original")
+	require.True(t, sort.IsSorted(hi.ValSet), "Validators are not sorted")
+}
+
+func TestValidateBasic(t *testing.T) {
+	hi := HistoricalInfo{
+		Header: header,
+	}
+	err := hi.ValidateBasic()
+	require.Error(t, err, "ValidateBasic passed on nil ValSet")
+
+	valSet := make([]Validator, len(validators))
+	copy(valSet, validators)
+
+	// Ensure validators are not sorted
+	for sort.IsSorted(Validators(valSet)) {
+		rand.Shuffle(len(valSet), func(i, j int) {
+			valSet[i], valSet[j] = valSet[j], valSet[i]
+		})
+	}
+
+	hi = HistoricalInfo{
+		Header: header,
+		ValSet: valSet,
+	}
+	err = hi.ValidateBasic()
+	require.Error(t, err, "ValidateBasic passed on unsorted ValSet")
+
+	hi = NewHistoricalInfo(header, validators)
+	err = hi.ValidateBasic()
+	require.NoError(t, err, "ValidateBasic failed on valid HistoricalInfo")
+}
+
+func TestValidatorsSort(t *testing.T) {
+	valSet := Validators{
+		NewValidator(sdk.ValAddress([]byte{0x3}), pk1, Description{}),
+		NewValidator(sdk.ValAddress([]byte{0x1}), pk2, Description{}),
+		NewValidator(sdk.ValAddress([]byte{0x2}), pk3, Description{}),
+	}
+	valSet.Sort()
+	for i, val := range valSet {
+		require.Equal(t, sdk.ValAddress([]byte{byte(i + 1)}), val.OperatorAddress)
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/binary"
+	"strconv"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -47,6 +48,9 @@ var (
 	UnbondingQueueKey    = []byte{0x41} // prefix for the timestamps in unbonding queue
 	RedelegationQueueKey = []byte{0x42} // prefix for the timestamps in redelegations queue
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
+
+	HistoricalInfoKey  = []byte{0x50} // prefix for the historical info of each height
+	TokenizedSharesKey = []byte{0x51} // prefix for the tokenized shares record of each validator
 )
 
 // gets the key for the validator with address
@@ -280,3 +284,17 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 		GetREDsToValDstIndexKey(valDstAddr),
 		delAddr.Bytes()...)
 }
+
+//______________
+
+// gets the key for the historical info of a height
+// VALUE: staking/HistoricalInfo
+func GetHistoricalInfoKey(height int64) []byte {
+	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
+}
+
+// gets the key for the tokenized shares record of a validator
+// VALUE: staking/TokenizedShares
+func GetTokenizedSharesKey(valAddr sdk.ValAddress) []byte {
+	return append(TokenizedSharesKey, valAddr.Bytes()...)
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -21,14 +22,36 @@ const (
 
 	// Default maximum entries in a UBD/RED pair
 	DefaultMaxEntries uint16 = 7
+
+	// Default number of historical info entries kept in the store
+	DefaultHistoricalEntries uint16 = 100
+
+	// Default voting power cap enforcement mode for delegations
+	DefaultVotingPowerCapMode = VotingPowerCapModeWarn
+)
+
+// Voting power cap enforcement modes. They define how delegations that would
+// push a validator over the maximum voting power ratio are handled.
+const (
+	// delegations over the cap are rejected
+	VotingPowerCapModeReject = "reject"
+	// delegations over the cap are accepted, but flagged with an event
+	VotingPowerCapModeWarn = "warn"
 )
 
+// Default maximum ratio of the total bonded power a single validator may hold.
+// A zero ratio disables the cap.
+var DefaultMaxVotingPowerRatio = sdk.ZeroDec()
+
 // nolint - Keys for parameter access
 var (
-	KeyUnbondingTime = []byte("UnbondingTime")
-	KeyMaxValidators = []byte("MaxValidators")
-	KeyMaxEntries    = []byte("KeyMaxEntries")
-	KeyBondDenom     = []byte("BondDenom")
+	KeyUnbondingTime       = []byte("UnbondingTime")
+	KeyMaxValidators       = []byte("MaxValidators")
+	KeyMaxEntries          = []byte("KeyMaxEntries")
+	KeyHistoricalEntries   = []byte("HistoricalEntries")
+	KeyBondDenom           = []byte("BondDenom")
+	KeyMaxVotingPowerRatio = []byte("MaxVotingPowerRatio")
+	KeyVotingPowerCapMode  = []byte("VotingPowerCapMode")
 )
 
 var _ params.ParamSet = (*Params)(nil)
@@ -38,28 +61,40 @@ type Params struct {
 	UnbondingTime time.Duration `json:"unbonding_time"` // time duration of unbonding
 	MaxValidators uint16        `json:"max_validators"` // maximum number of validators (max uint16 = 65535)
 	MaxEntries    uint16        `json:"max_entries"`    // max entries for either unbonding delegation or redelegation (per pair/trio)
+	// number of historical info entries (header and validator set) kept for past heights
+	HistoricalEntries uint16 `json:"historical_entries"`
 	// note: we need to be a bit careful about potential overflow here, since this is user-determined
 	BondDenom string `json:"bond_denom"` // bondable coin denomination
+	// maximum ratio of the total bonded power reported to Tendermint for a single validator, zero disables the cap
+	MaxVotingPowerRatio sdk.Dec `json:"max_voting_power_ratio"`
+	// handling of delegations that push a validator over the cap, either "reject" or "warn"
+	VotingPowerCapMode string `json:"voting_power_cap_mode"`
 }
 
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries uint16,
-	bondDenom string) Params {
+func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint16,
+	bondDenom string, maxVotingPowerRatio sdk.Dec, votingPowerCapMode string) Params {
 
 	return Params{
-		UnbondingTime: unbondingTime,
-		MaxValidators: maxValidators,
-		MaxEntries:    maxEntries,
-		BondDenom:     bondDenom,
+		UnbondingTime:       unbondingTime,
+		MaxValidators:       maxValidators,
+		MaxEntries:          maxEntries,
+		HistoricalEntries:   historicalEntries,
+		BondDenom:           bondDenom,
+		MaxVotingPowerRatio: maxVotingPowerRatio,
+		VotingPowerCapMode:  votingPowerCapMode,
 	}
 }
 
 // Implements params.ParamSet
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
-		{KeyUnbondingTime, &p.UnbondingTime},
-		{KeyMaxValidators, &p.MaxValidators},
-		{KeyMaxEntries, &p.MaxEntries},
-		{KeyBondDenom, &p.BondDenom},
+		params.NewParamSetPair(KeyUnbondingTime, &p.UnbondingTime, validateUnbondingTime),
+		params.NewParamSetPair(KeyMaxValidators, &p.MaxValidators, validateMaxValidators),
+		params.NewParamSetPair(KeyMaxEntries, &p.MaxEntries, validateMaxEntries),
+		params.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateHistoricalEntries),
+		params.NewParamSetPair(KeyBondDenom, &p.BondDenom, validateBondDenom),
+		params.NewParamSetPair(KeyMaxVotingPowerRatio, &p.MaxVotingPowerRatio, validateMaxVotingPowerRatio),
+		params.NewParamSetPair(KeyVotingPowerCapMode, &p.VotingPowerCapMode, validateVotingPowerCapMode),
 	}
 }
 
@@ -73,17 +108,22 @@ func (p Params) Equal(p2 Params) bool {
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams(DefaultUnbondingTime, DefaultMaxValidators, DefaultMaxEntries, sdk.DefaultBondDenom)
+	return NewParams(DefaultUnbondingTime, DefaultMaxValidators, DefaultMaxEntries,
+		DefaultHistoricalEntries, sdk.DefaultBondDenom, DefaultMaxVotingPowerRatio, DefaultVotingPowerCapMode)
 }
 
 // String returns a human readable string representation of the parameters.
 func (p Params) String() string {
 	return fmt.Sprintf(`Params:
-  Unbonding Time:    %s
-  Max Validators:    %d
-  Max Entries:       %d
-  Bonded Coin Denom: %s`, p.UnbondingTime,
-		p.MaxValidators, p.MaxEntries, p.BondDenom)
+  Unbonding Time:     %s
+  Max Validators:     %d
+  Max Entries:        %d
+  Historical Entries: %d
+  Bonded Coin Denom:  %s
+  Max Voting Power:   %s
+  Voting Power Cap:   %s`, p.UnbondingTime,
+		p.MaxValidators, p.MaxEntries, p.HistoricalEntries, p.BondDenom,
+		p.MaxVotingPowerRatio, p.VotingPowerCapMode)
 }
 
 // unmarshal the current staking params value from store key or panic
@@ -106,11 +146,113 @@ func UnmarshalParams(cdc *codec.Codec, value []byte) (params Params, err error)
 
 // validate a set of params
 func (p Params) Validate() error {
-	if p.BondDenom == "" {
-		return fmt.Errorf("staking parameter BondDenom can't be an empty string")
+	if err := validateUnbondingTime(p.UnbondingTime); err != nil {
+		return err
+	}
+	if err := validateMaxValidators(p.MaxValidators); err != nil {
+		return err
+	}
+	if err := validateMaxEntries(p.MaxEntries); err != nil {
+		return err
+	}
+	if err := validateHistoricalEntries(p.HistoricalEntries); err != nil {
+		return err
+	}
+	if err := validateBondDenom(p.BondDenom); err != nil {
+		return err
+	}
+	if err := validateMaxVotingPowerRatio(p.MaxVotingPowerRatio); err != nil {
+		return err
+	}
+	return validateVotingPowerCapMode(p.VotingPowerCapMode)
+}
+
+func validateUnbondingTime(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
 	}
-	if p.MaxValidators == 0 {
+
+	if v < 0 {
+		return fmt.Errorf("staking parameter UnbondingTime can't be negative, is %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxValidators(i interface{}) error {
+	v, ok := i.(uint16)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
 		return fmt.Errorf("staking parameter MaxValidators must be a positive integer")
 	}
+
+	return nil
+}
+
+func validateMaxEntries(i interface{}) error {
+	v, ok := i.(uint16)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("staking parameter MaxEntries must be a positive integer")
+	}
+
+	return nil
+}
+
+func validateHistoricalEntries(i interface{}) error {
+	if _, ok := i.(uint16); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateBondDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if strings.TrimSpace(v) == "" {
+		return fmt.Errorf("staking parameter BondDenom can't be an empty string")
+	}
+	if err := sdk.ValidateDenom(v); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateMaxVotingPowerRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("staking parameter MaxVotingPowerRatio must be between 0 and 1, is %s", v)
+	}
+
+	return nil
+}
+
+func validateVotingPowerCapMode(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v != VotingPowerCapModeReject && v != VotingPowerCapModeWarn {
+		return fmt.Errorf("staking parameter VotingPowerCapMode must be %q or %q, is %q",
+			VotingPowerCapModeReject, VotingPowerCapModeWarn, v)
+	}
+
 	return nil
 }
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestParamsEqual(t *testing.T) {
@@ -19,3 +21,30 @@ func TestParamsEqual(t *testing.T) {
 	ok = p1.Equal(p2)
 	require.False(t, ok)
 }
+
+func TestParamsValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		mutate     func(*Params)
+		expectPass bool
+	}{
+		{"default", func(*Params) {}, true},
+		{"empty bond denom", func(p *Params) { p.BondDenom = "" }, false},
+		{"zero max validators", func(p *Params) { p.MaxValidators = 0 }, false},
+		{"voting power cap", func(p *Params) { p.MaxVotingPowerRatio = sdk.NewDecWithPrec(1, 1) }, true},
+		{"negative voting power cap", func(p *Params) { p.MaxVotingPowerRatio = sdk.NewDec(-1) }, false},
+		{"voting power cap above one", func(p *Params) { p.MaxVotingPowerRatio = sdk.NewDecWithPrec(11, 1) }, false},
+		{"reject mode", func(p *Params) { p.VotingPowerCapMode = VotingPowerCapModeReject }, true},
+		{"unknown mode", func(p *Params) { p.VotingPowerCapMode = "ignore" }, false},
+	}
+
+	for _, tc := range tests {
+		params := DefaultParams()
+		tc.mutate(&params)
+		if tc.expectPass {
+			require.NoError(t, params.Validate(), "test: %v", tc.name)
+		} else {
+			require.Error(t, params.Validate(), "test: %v", tc.name)
+		}
+	}
+}
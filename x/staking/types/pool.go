@@ -11,9 +11,19 @@ import (
 // - NotBondedPool -> "NotBondedTokensPool"
 //
 // - BondedPool -> "BondedTokensPool"
+//
+// - TokenizedSharesPool -> "TokenizedSharesPool"
+//
+// - TokenizedSharesBurner -> "TokenizedSharesBurner"
 const (
 	NotBondedPoolName = "NotBondedTokensPool"
 	BondedPoolName    = "BondedTokensPool"
+
+	// TokenizedSharesPoolName holds the delegations backing share tokens and
+	// mints new share tokens
+	TokenizedSharesPoolName = "TokenizedSharesPool"
+	// TokenizedSharesBurnerName burns the share tokens that are redeemed
+	TokenizedSharesBurnerName = "TokenizedSharesBurner"
 )
 
 // Pool - tracking bonded and not-bonded token supply of the bond denomination
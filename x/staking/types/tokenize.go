@@ -0,0 +1,97 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ShareTokenDenomPrefix is the prefix of every share token denomination. The
+// full denomination has the form "share/{validator operator address}".
+const ShareTokenDenomPrefix = "share"
+
+// GetShareTokenDenom returns the denomination of the share tokens that
+// represent tokenized delegations to the given validator
+func GetShareTokenDenom(valAddr sdk.ValAddress) string {
+	return strings.Join([]string{ShareTokenDenomPrefix, valAddr.String()}, "/")
+}
+
+// ParseShareTokenDenom returns the validator operator address a share token
+// denomination was derived from
+func ParseShareTokenDenom(denom string) (sdk.ValAddress, error) {
+	parts := strings.Split(denom, "/")
+	if len(parts) != 2 || parts[0] != ShareTokenDenomPrefix {
+		return nil, fmt.Errorf("%s is not a share token denomination", denom)
+	}
+
+	return sdk.ValAddressFromBech32(parts[1])
+}
+
+// TokenizedShares records the amount of delegation shares of a validator that
+// have been converted into share tokens. The shares are delegated by the
+// TokenizedSharesPool module account and are backed 1:1 by the supply of the
+// validator's share token.
+type TokenizedShares struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address"`
+	Shares           sdk.Dec        `json:"shares"`
+}
+
+// NewTokenizedShares creates a new TokenizedShares instance
+func NewTokenizedShares(valAddr sdk.ValAddress, shares sdk.Dec) TokenizedShares {
+	return TokenizedShares{
+		ValidatorAddress: valAddr,
+		Shares:           shares,
+	}
+}
+
+// return the tokenized shares record
+func MustMarshalTokenizedShares(cdc *codec.Codec, record TokenizedShares) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(record)
+}
+
+// return the tokenized shares record
+func MustUnmarshalTokenizedShares(cdc *codec.Codec, value []byte) TokenizedShares {
+	record, err := UnmarshalTokenizedShares(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return record
+}
+
+// return the tokenized shares record
+func UnmarshalTokenizedShares(cdc *codec.Codec, value []byte) (record TokenizedShares, err error) {
+	err = cdc.UnmarshalBinaryLengthPrefixed(value, &record)
+	return record, err
+}
+
+// Denom returns the denomination of the share tokens backed by the record
+func (t TokenizedShares) Denom() string {
+	return GetShareTokenDenom(t.ValidatorAddress)
+}
+
+// String returns a human readable string representation of a TokenizedShares.
+func (t TokenizedShares) String() string {
+	return fmt.Sprintf(`Tokenized Shares:
+  Validator: %s
+  Denom:     %s
+  Shares:    %s`, t.ValidatorAddress, t.Denom(), t.Shares)
+}
+
+// TokenizedSharesResponse is equivalent to TokenizedShares except that it
+// contains the amount of bond tokens the tokenized shares are worth.
+type TokenizedSharesResponse struct {
+	TokenizedShares
+	Balance sdk.Int `json:"balance"`
+}
+
+// NewTokenizedSharesResponse creates a new TokenizedSharesResponse instance
+func NewTokenizedSharesResponse(record TokenizedShares, balance sdk.Int) TokenizedSharesResponse {
+	return TokenizedSharesResponse{record, balance}
+}
+
+// String implements the Stringer interface for TokenizedSharesResponse.
+func (t TokenizedSharesResponse) String() string {
+	return fmt.Sprintf("%s\n  Balance:   %s", t.TokenizedShares.String(), t.Balance)
+}
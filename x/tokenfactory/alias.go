@@ -0,0 +1,101 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/tokenfactory/internal/keeper
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/tokenfactory/internal/types
+package tokenfactory
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/internal/types"
+)
+
+const (
+	ModuleName                  = types.ModuleName
+	StoreKey                    = types.StoreKey
+	RouterKey                   = types.RouterKey
+	QuerierRoute                = types.QuerierRoute
+	DefaultParamspace           = types.DefaultParamspace
+	BurnerAccountName           = types.BurnerAccountName
+	DefaultCodespace            = types.DefaultCodespace
+	CodeInvalidDenom            = types.CodeInvalidDenom
+	CodeDenomExists             = types.CodeDenomExists
+	CodeUnknownDenom            = types.CodeUnknownDenom
+	CodeUnauthorized            = types.CodeUnauthorized
+	CodeInvalidAmount           = types.CodeInvalidAmount
+	DenomPrefix                 = types.DenomPrefix
+	MaxSubdenomLength           = types.MaxSubdenomLength
+	EventTypeCreateDenom        = types.EventTypeCreateDenom
+	EventTypeMint               = types.EventTypeMint
+	EventTypeBurn               = types.EventTypeBurn
+	EventTypeChangeAdmin        = types.EventTypeChangeAdmin
+	AttributeKeyCreator         = types.AttributeKeyCreator
+	AttributeKeyDenom           = types.AttributeKeyDenom
+	AttributeKeyAmount          = types.AttributeKeyAmount
+	AttributeKeyNewAdmin        = types.AttributeKeyNewAdmin
+	AttributeValueCategory      = types.AttributeValueCategory
+	TypeMsgCreateDenom          = types.TypeMsgCreateDenom
+	TypeMsgMint                 = types.TypeMsgMint
+	TypeMsgBurn                 = types.TypeMsgBurn
+	TypeMsgChangeAdmin          = types.TypeMsgChangeAdmin
+	QueryParameters             = types.QueryParameters
+	QueryDenomAuthorityMetadata = types.QueryDenomAuthorityMetadata
+	QueryDenomsFromCreator      = types.QueryDenomsFromCreator
+)
+
+var (
+	// functions aliases
+	NewKeeper                            = keeper.NewKeeper
+	NewQuerier                           = keeper.NewQuerier
+	RegisterInvariants                   = keeper.RegisterInvariants
+	AllInvariants                        = keeper.AllInvariants
+	FactoryDenomsInvariant               = keeper.FactoryDenomsInvariant
+	NewDenomAuthorityMetadata            = types.NewDenomAuthorityMetadata
+	RegisterCodec                        = types.RegisterCodec
+	GetTokenDenom                        = types.GetTokenDenom
+	DeconstructDenom                     = types.DeconstructDenom
+	ValidateSubdenom                     = types.ValidateSubdenom
+	ErrInvalidDenom                      = types.ErrInvalidDenom
+	ErrDenomExists                       = types.ErrDenomExists
+	ErrUnknownDenom                      = types.ErrUnknownDenom
+	ErrUnauthorized                      = types.ErrUnauthorized
+	ErrInvalidAmount                     = types.ErrInvalidAmount
+	NewGenesisDenom                      = types.NewGenesisDenom
+	NewGenesisState                      = types.NewGenesisState
+	DefaultGenesisState                  = types.DefaultGenesisState
+	ValidateGenesis                      = types.ValidateGenesis
+	GetDenomAuthorityMetadataKey         = types.GetDenomAuthorityMetadataKey
+	GetCreatorDenomsPrefix               = types.GetCreatorDenomsPrefix
+	GetCreatorDenomKey                   = types.GetCreatorDenomKey
+	NewMsgCreateDenom                    = types.NewMsgCreateDenom
+	NewMsgMint                           = types.NewMsgMint
+	NewMsgBurn                           = types.NewMsgBurn
+	NewMsgChangeAdmin                    = types.NewMsgChangeAdmin
+	ParamKeyTable                        = types.ParamKeyTable
+	NewParams                            = types.NewParams
+	DefaultParams                        = types.DefaultParams
+	ValidateParams                       = types.ValidateParams
+	NewQueryDenomAuthorityMetadataParams = types.NewQueryDenomAuthorityMetadataParams
+	NewQueryDenomsFromCreatorParams      = types.NewQueryDenomsFromCreatorParams
+
+	// variable aliases
+	ModuleCdc                       = types.ModuleCdc
+	DenomAuthorityMetadataKeyPrefix = types.DenomAuthorityMetadataKeyPrefix
+	CreatorDenomsKeyPrefix          = types.CreatorDenomsKeyPrefix
+	KeyDenomCreationFee             = types.KeyDenomCreationFee
+)
+
+type (
+	Keeper                            = keeper.Keeper
+	DenomAuthorityMetadata            = types.DenomAuthorityMetadata
+	GenesisDenom                      = types.GenesisDenom
+	GenesisState                      = types.GenesisState
+	MsgCreateDenom                    = types.MsgCreateDenom
+	MsgMint                           = types.MsgMint
+	MsgBurn                           = types.MsgBurn
+	MsgChangeAdmin                    = types.MsgChangeAdmin
+	Params                            = types.Params
+	QueryDenomAuthorityMetadataParams = types.QueryDenomAuthorityMetadataParams
+	QueryDenomsFromCreatorParams      = types.QueryDenomsFromCreatorParams
+	QueryResDenoms                    = types.QueryResDenoms
+)
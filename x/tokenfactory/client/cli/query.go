@@ -0,0 +1,144 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/internal/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	tokenfactoryQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the token factory module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	tokenfactoryQueryCmd.AddCommand(client.GetCommands(
+		GetCmdQueryParams(cdc),
+		GetCmdQueryDenomAuthorityMetadata(cdc),
+		GetCmdQueryDenomsFromCreator(cdc),
+	)...)
+
+	return tokenfactoryQueryCmd
+}
+
+// GetCmdQueryParams implements a command to return the current token factory
+// parameters.
+func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Query the current token factory parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParameters)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := cdc.UnmarshalJSON(res, &params); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+// GetCmdQueryDenomAuthorityMetadata implements the query denom authority
+// metadata command.
+func GetCmdQueryDenomAuthorityMetadata(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "denom-authority-metadata [denom]",
+		Short: "Query the authority metadata of a factory denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the admin of a factory denom.
+
+Example:
+$ %s query %s denom-authority-metadata factory/cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj/mytoken
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(types.NewQueryDenomAuthorityMetadataParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDenomAuthorityMetadata)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var metadata types.DenomAuthorityMetadata
+			if err := cdc.UnmarshalJSON(res, &metadata); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(metadata)
+		},
+	}
+}
+
+// GetCmdQueryDenomsFromCreator implements the query denoms from creator command.
+func GetCmdQueryDenomsFromCreator(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "denoms-from-creator [creator-address]",
+		Short: "Query all the denoms created by an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the factory denoms created by an account.
+
+Example:
+$ %s query %s denoms-from-creator cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			creator, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryDenomsFromCreatorParams(creator))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDenomsFromCreator)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var denoms types.QueryResDenoms
+			if err := cdc.UnmarshalJSON(res, &denoms); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(denoms)
+		},
+	}
+}
@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/internal/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Token factory transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(client.PostCommands(
+		GetCmdCreateDenom(cdc),
+		GetCmdMint(cdc),
+		GetCmdBurn(cdc),
+		GetCmdChangeAdmin(cdc),
+	)...)
+	return txCmd
+}
+
+// GetCmdCreateDenom implements the create denom command.
+func GetCmdCreateDenom(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "create-denom [subdenom]",
+		Short: "Create a new denom of the form factory/{sender}/{subdenom}",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a new token factory denom of the form factory/{sender}/{subdenom}.
+The sender pays the denom creation fee and becomes the admin of the new denom.
+
+Example:
+$ %s tx %s create-denom mytoken --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgCreateDenom(cliCtx.GetFromAddress(), args[0])
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdMint implements the mint command.
+func GetCmdMint(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "mint [amount]",
+		Short: "Mint factory tokens to the admin account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Mint new tokens of a factory denom. Only the admin of the denom can mint.
+
+Example:
+$ %s tx %s mint 1000factory/cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj/mytoken --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			amount, err := sdk.ParseCoin(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMint(cliCtx.GetFromAddress(), amount)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdBurn implements the burn command.
+func GetCmdBurn(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "burn [amount]",
+		Short: "Burn factory tokens from the admin account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn tokens of a factory denom held by the admin. Only the admin of the denom can burn.
+
+Example:
+$ %s tx %s burn 1000factory/cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj/mytoken --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			amount, err := sdk.ParseCoin(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurn(cliCtx.GetFromAddress(), amount)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdChangeAdmin implements the change admin command.
+func GetCmdChangeAdmin(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "change-admin [denom] [new-admin-address]",
+		Short: "Transfer the admin rights of a factory denom to another account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer the admin rights of a factory denom to another account.
+
+Example:
+$ %s tx %s change-admin factory/cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj/mytoken cosmos1ahtw7zr2qggk0r3yqsmw0qyfv2xpe4tnhfhkwd --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			newAdmin, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgChangeAdmin(cliCtx.GetFromAddress(), args[0], newAdmin)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
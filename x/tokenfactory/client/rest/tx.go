@@ -0,0 +1,174 @@
+package rest
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/internal/types"
+)
+
+func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc(
+		"/tokenfactory/denoms",
+		createDenomHandlerFn(cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/tokenfactory/denoms/{creator}/{subdenom}/mint",
+		mintHandlerFn(cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/tokenfactory/denoms/{creator}/{subdenom}/burn",
+		burnHandlerFn(cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/tokenfactory/denoms/{creator}/{subdenom}/admin",
+		changeAdminHandlerFn(cliCtx),
+	).Methods("POST")
+}
+
+type (
+	// CreateDenomReq defines the properties of a create denom request's body.
+	CreateDenomReq struct {
+		BaseReq  rest.BaseReq `json:"base_req" yaml:"base_req"`
+		Subdenom string       `json:"subdenom" yaml:"subdenom"`
+	}
+
+	// MintBurnReq defines the properties of a mint or burn request's body.
+	MintBurnReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+		Amount  sdk.Int      `json:"amount" yaml:"amount"`
+	}
+
+	// ChangeAdminReq defines the properties of a change admin request's body.
+	ChangeAdminReq struct {
+		BaseReq  rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		NewAdmin sdk.AccAddress `json:"new_admin" yaml:"new_admin"`
+	}
+)
+
+func createDenomHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreateDenomReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgCreateDenom(fromAddr, req.Subdenom)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func mintHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req MintBurnReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgMint(fromAddr, sdk.NewCoin(denomFromVars(r), req.Amount))
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func burnHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req MintBurnReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgBurn(fromAddr, sdk.NewCoin(denomFromVars(r), req.Amount))
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func changeAdminHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ChangeAdminReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgChangeAdmin(fromAddr, denomFromVars(r), req.NewAdmin)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// denomFromVars rebuilds the factory denom from the URL's variables
+func denomFromVars(r *http.Request) string {
+	vars := mux.Vars(r)
+	return strings.Join([]string{types.DenomPrefix, vars["creator"], vars["subdenom"]}, "/")
+}
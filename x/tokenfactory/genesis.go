@@ -0,0 +1,41 @@
+package tokenfactory
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/internal/types"
+)
+
+// InitGenesis sets the token factory parameters and factory denoms for genesis.
+func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper, data GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+
+	for _, genDenom := range data.FactoryDenoms {
+		creator, _, err := types.DeconstructDenom(genDenom.Denom)
+		if err != nil {
+			panic(err)
+		}
+		keeper.SetAuthorityMetadata(ctx, genDenom.Denom, genDenom.AuthorityMetadata)
+		keeper.AddDenomFromCreator(ctx, creator, genDenom.Denom)
+	}
+
+	// ensure the module accounts are set
+	if moduleAcc := supplyKeeper.GetModuleAccount(ctx, ModuleName); moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", ModuleName))
+	}
+	if burnerAcc := supplyKeeper.GetModuleAccount(ctx, BurnerAccountName); burnerAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", BurnerAccountName))
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	factoryDenoms := []GenesisDenom{}
+	keeper.IterateAuthorityMetadata(ctx, func(denom string, metadata DenomAuthorityMetadata) (stop bool) {
+		factoryDenoms = append(factoryDenoms, NewGenesisDenom(denom, metadata))
+		return false
+	})
+
+	return NewGenesisState(keeper.GetParams(ctx), factoryDenoms)
+}
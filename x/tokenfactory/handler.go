@@ -0,0 +1,99 @@
+package tokenfactory
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/internal/types"
+)
+
+// NewHandler returns a handler for "tokenfactory" type messages.
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgCreateDenom:
+			return handleMsgCreateDenom(ctx, k, msg)
+
+		case types.MsgMint:
+			return handleMsgMint(ctx, k, msg)
+
+		case types.MsgBurn:
+			return handleMsgBurn(ctx, k, msg)
+
+		case types.MsgChangeAdmin:
+			return handleMsgChangeAdmin(ctx, k, msg)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized tokenfactory message type: %T", msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgCreateDenom(ctx sdk.Context, k keeper.Keeper, msg types.MsgCreateDenom) sdk.Result {
+	denom, err := k.CreateDenom(ctx, msg.Sender, msg.Subdenom)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	)
+
+	return sdk.Result{Data: []byte(denom), Events: ctx.EventManager().Events()}
+}
+
+func handleMsgMint(ctx sdk.Context, k keeper.Keeper, msg types.MsgMint) sdk.Result {
+	if err := k.Mint(ctx, msg.Sender, msg.Amount); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgBurn(ctx sdk.Context, k keeper.Keeper, msg types.MsgBurn) sdk.Result {
+	if err := k.Burn(ctx, msg.Sender, msg.Amount); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgChangeAdmin(ctx sdk.Context, k keeper.Keeper, msg types.MsgChangeAdmin) sdk.Result {
+	if err := k.ChangeAdmin(ctx, msg.Sender, msg.Denom, msg.NewAdmin); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
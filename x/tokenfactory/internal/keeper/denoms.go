@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/internal/types"
+)
+
+// CreateDenom creates a new factory denom of the form factory/{creator}/{subdenom}
+// after charging the creator the denom creation fee. The creator is set as the
+// admin of the new denom.
+func (k Keeper) CreateDenom(ctx sdk.Context, creator sdk.AccAddress, subdenom string) (string, sdk.Error) {
+	denom, err := types.GetTokenDenom(creator, subdenom)
+	if err != nil {
+		return "", types.ErrInvalidDenom(k.codespace, err.Error())
+	}
+
+	if _, found := k.GetAuthorityMetadata(ctx, denom); found {
+		return "", types.ErrDenomExists(k.codespace, denom)
+	}
+
+	// a denom with an existing supply can't be taken over by the factory
+	if !k.supplyKeeper.GetSupply(ctx).Total.AmountOf(denom).IsZero() {
+		return "", types.ErrDenomExists(k.codespace, denom)
+	}
+
+	fee := k.GetParams(ctx).DenomCreationFee
+	if !fee.IsZero() {
+		if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, creator, k.feeCollectorName, fee); err != nil {
+			return "", err
+		}
+	}
+
+	k.SetAuthorityMetadata(ctx, denom, types.NewDenomAuthorityMetadata(creator))
+	k.AddDenomFromCreator(ctx, creator, denom)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateDenom,
+			sdk.NewAttribute(types.AttributeKeyCreator, creator.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		),
+	)
+
+	return denom, nil
+}
+
+// Mint mints new factory tokens into the token factory module account through
+// the supply keeper and sends them to the denom admin.
+func (k Keeper) Mint(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin) sdk.Error {
+	if err := k.assertAdmin(ctx, sender, amount.Denom); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.supplyKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, coins); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMint,
+			sdk.NewAttribute(types.AttributeKeyDenom, amount.Denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.Amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// Burn burns factory tokens held by the denom admin.
+func (k Keeper) Burn(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin) sdk.Error {
+	if err := k.assertAdmin(ctx, sender, amount.Denom); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, types.BurnerAccountName, coins); err != nil {
+		return err
+	}
+
+	if err := k.supplyKeeper.BurnCoins(ctx, types.BurnerAccountName, coins); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurn,
+			sdk.NewAttribute(types.AttributeKeyDenom, amount.Denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.Amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// ChangeAdmin transfers the admin rights over a factory denom to a new account.
+func (k Keeper) ChangeAdmin(ctx sdk.Context, sender sdk.AccAddress, denom string, newAdmin sdk.AccAddress) sdk.Error {
+	if err := k.assertAdmin(ctx, sender, denom); err != nil {
+		return err
+	}
+
+	k.SetAuthorityMetadata(ctx, denom, types.NewDenomAuthorityMetadata(newAdmin))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChangeAdmin,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyNewAdmin, newAdmin.String()),
+		),
+	)
+
+	return nil
+}
+
+// assertAdmin returns an error if the denom wasn't created by the token factory
+// or if the given address is not its current admin.
+func (k Keeper) assertAdmin(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Error {
+	metadata, found := k.GetAuthorityMetadata(ctx, denom)
+	if !found {
+		return types.ErrUnknownDenom(k.codespace, denom)
+	}
+
+	if !metadata.Admin.Equals(addr) {
+		return types.ErrUnauthorized(k.codespace, denom)
+	}
+	return nil
+}
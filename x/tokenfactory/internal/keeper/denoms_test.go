@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/internal/types"
+)
+
+func TestCreateDenom(t *testing.T) {
+	input := newTestInput(t)
+	ctx, keeper := input.ctx, input.tokenFactoryKeeper
+	fee := keeper.GetParams(ctx).DenomCreationFee
+
+	denom, err := keeper.CreateDenom(ctx, addrs[0], "bitcoin")
+	require.NoError(t, err)
+	require.Equal(t, "factory/"+addrs[0].String()+"/bitcoin", denom)
+
+	metadata, found := keeper.GetAuthorityMetadata(ctx, denom)
+	require.True(t, found)
+	require.Equal(t, addrs[0], metadata.Admin)
+	require.Equal(t, []string{denom}, keeper.GetDenomsFromCreator(ctx, addrs[0]))
+
+	// the creation fee is sent to the fee collector
+	require.Equal(t, initCoins.Sub(fee), input.accountKeeper.GetAccount(ctx, addrs[0]).GetCoins())
+	require.Equal(t, fee, input.supplyKeeper.GetModuleAccount(ctx, auth.FeeCollectorName).GetCoins())
+
+	// the same denom can't be created twice
+	_, err = keeper.CreateDenom(ctx, addrs[0], "bitcoin")
+	require.Error(t, err)
+	require.Equal(t, types.CodeDenomExists, err.Code())
+
+	// another creator gets its own namespace
+	_, err = keeper.CreateDenom(ctx, addrs[1], "bitcoin")
+	require.NoError(t, err)
+
+	// invalid subdenom
+	_, err = keeper.CreateDenom(ctx, addrs[0], "Bitcoin")
+	require.Error(t, err)
+	require.Equal(t, types.CodeInvalidDenom, err.Code())
+
+	// not enough funds to pay the fee
+	keeper.SetParams(ctx, types.NewParams(initCoins.Add(initCoins)))
+	_, err = keeper.CreateDenom(ctx, addrs[2], "bitcoin")
+	require.Error(t, err)
+}
+
+func TestMintBurn(t *testing.T) {
+	input := newTestInput(t)
+	ctx, keeper := input.ctx, input.tokenFactoryKeeper
+
+	denom, err := keeper.CreateDenom(ctx, addrs[0], "bitcoin")
+	require.NoError(t, err)
+
+	// only the admin can mint
+	err = keeper.Mint(ctx, addrs[1], sdk.NewInt64Coin(denom, 100))
+	require.Error(t, err)
+	require.Equal(t, types.CodeUnauthorized, err.Code())
+
+	// denoms not created by the factory can't be minted
+	err = keeper.Mint(ctx, addrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	require.Error(t, err)
+	require.Equal(t, types.CodeUnknownDenom, err.Code())
+
+	err = keeper.Mint(ctx, addrs[0], sdk.NewInt64Coin(denom, 100))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(100), input.accountKeeper.GetAccount(ctx, addrs[0]).GetCoins().AmountOf(denom))
+	require.Equal(t, sdk.NewInt(100), input.supplyKeeper.GetSupply(ctx).Total.AmountOf(denom))
+
+	// only the admin can burn
+	err = keeper.Burn(ctx, addrs[1], sdk.NewInt64Coin(denom, 40))
+	require.Error(t, err)
+	require.Equal(t, types.CodeUnauthorized, err.Code())
+
+	err = keeper.Burn(ctx, addrs[0], sdk.NewInt64Coin(denom, 40))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(60), input.accountKeeper.GetAccount(ctx, addrs[0]).GetCoins().AmountOf(denom))
+	require.Equal(t, sdk.NewInt(60), input.supplyKeeper.GetSupply(ctx).Total.AmountOf(denom))
+
+	// can't burn more than the admin holds
+	err = keeper.Burn(ctx, addrs[0], sdk.NewInt64Coin(denom, 61))
+	require.Error(t, err)
+
+	require.NoError(t, FactoryDenomsInvariant(keeper)(ctx))
+}
+
+func TestChangeAdmin(t *testing.T) {
+	input := newTestInput(t)
+	ctx, keeper := input.ctx, input.tokenFactoryKeeper
+
+	denom, err := keeper.CreateDenom(ctx, addrs[0], "bitcoin")
+	require.NoError(t, err)
+
+	// only the admin can change the admin
+	err = keeper.ChangeAdmin(ctx, addrs[1], denom, addrs[1])
+	require.Error(t, err)
+	require.Equal(t, types.CodeUnauthorized, err.Code())
+
+	err = keeper.ChangeAdmin(ctx, addrs[0], denom, addrs[1])
+	require.NoError(t, err)
+
+	metadata, found := keeper.GetAuthorityMetadata(ctx, denom)
+	require.True(t, found)
+	require.Equal(t, addrs[1], metadata.Admin)
+
+	// the previous admin lost its rights
+	err = keeper.Mint(ctx, addrs[0], sdk.NewInt64Coin(denom, 100))
+	require.Error(t, err)
+
+	err = keeper.Mint(ctx, addrs[1], sdk.NewInt64Coin(denom, 100))
+	require.NoError(t, err)
+}
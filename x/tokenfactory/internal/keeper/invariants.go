@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/internal/types"
+)
+
+// RegisterInvariants registers all token factory invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "factory-denoms", FactoryDenomsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the token factory module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		return FactoryDenomsInvariant(k)(ctx)
+	}
+}
+
+// FactoryDenomsInvariant checks that every factory denom with a non-zero total
+// supply has its authority metadata set
+func FactoryDenomsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		var unknownDenoms []string
+
+		for _, coin := range k.supplyKeeper.GetSupply(ctx).Total {
+			if !strings.HasPrefix(coin.Denom, types.DenomPrefix+"/") {
+				continue
+			}
+			if _, found := k.GetAuthorityMetadata(ctx, coin.Denom); !found {
+				unknownDenoms = append(unknownDenoms, coin.Denom)
+			}
+		}
+
+		if len(unknownDenoms) != 0 {
+			return fmt.Errorf("factory denoms invariance:\n"+
+				"\tfactory denoms without authority metadata: %v", unknownDenoms)
+		}
+
+		return nil
+	}
+}
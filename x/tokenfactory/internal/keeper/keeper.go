@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/internal/types"
+)
+
+// Keeper of the token factory store
+type Keeper struct {
+	cdc          *codec.Codec
+	storeKey     sdk.StoreKey
+	paramSpace   params.Subspace
+	supplyKeeper types.SupplyKeeper
+	codespace    sdk.CodespaceType
+
+	feeCollectorName string // name of the FeeCollector ModuleAccount
+}
+
+// NewKeeper creates a new token factory Keeper instance
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace,
+	supplyKeeper types.SupplyKeeper, codespace sdk.CodespaceType, feeCollectorName string) Keeper {
+
+	// ensure the token factory module accounts are set
+	if addr := supplyKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+	if addr := supplyKeeper.GetModuleAddress(types.BurnerAccountName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.BurnerAccountName))
+	}
+
+	return Keeper{
+		cdc:              cdc,
+		storeKey:         key,
+		paramSpace:       paramSpace.WithKeyTable(types.ParamKeyTable()),
+		supplyKeeper:     supplyKeeper,
+		codespace:        codespace,
+		feeCollectorName: feeCollectorName,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// Codespace returns the keeper's codespace.
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// GetParams returns the total set of token factory parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of token factory parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetAuthorityMetadata returns the authority metadata of a factory denom
+func (k Keeper) GetAuthorityMetadata(ctx sdk.Context, denom string) (metadata types.DenomAuthorityMetadata, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetDenomAuthorityMetadataKey(denom))
+	if b == nil {
+		return metadata, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &metadata)
+	return metadata, true
+}
+
+// SetAuthorityMetadata sets the authority metadata of a factory denom
+func (k Keeper) SetAuthorityMetadata(ctx sdk.Context, denom string, metadata types.DenomAuthorityMetadata) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(metadata)
+	store.Set(types.GetDenomAuthorityMetadataKey(denom), b)
+}
+
+// IterateAuthorityMetadata iterates over the authority metadata of all the
+// factory denoms
+func (k Keeper) IterateAuthorityMetadata(ctx sdk.Context,
+	handler func(denom string, metadata types.DenomAuthorityMetadata) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DenomAuthorityMetadataKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		denom := string(iter.Key()[len(types.DenomAuthorityMetadataKeyPrefix):])
+		var metadata types.DenomAuthorityMetadata
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &metadata)
+		if handler(denom, metadata) {
+			break
+		}
+	}
+}
+
+// AddDenomFromCreator adds a denom to the index of denoms created by an account
+func (k Keeper) AddDenomFromCreator(ctx sdk.Context, creator sdk.AccAddress, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetCreatorDenomKey(creator, denom), []byte(denom))
+}
+
+// GetDenomsFromCreator returns all the denoms created by an account
+func (k Keeper) GetDenomsFromCreator(ctx sdk.Context, creator sdk.AccAddress) []string {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetCreatorDenomsPrefix(creator))
+	defer iter.Close()
+
+	denoms := []string{}
+	for ; iter.Valid(); iter.Next() {
+		denoms = append(denoms, string(iter.Value()))
+	}
+	return denoms
+}
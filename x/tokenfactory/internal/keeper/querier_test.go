@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/internal/types"
+)
+
+func TestNewQuerier(t *testing.T) {
+	input := newTestInput(t)
+	querier := NewQuerier(input.tokenFactoryKeeper)
+
+	query := abci.RequestQuery{
+		Path: "",
+		Data: []byte{},
+	}
+
+	_, err := querier(input.ctx, []string{types.QueryParameters}, query)
+	require.NoError(t, err)
+
+	_, err = querier(input.ctx, []string{"foo"}, query)
+	require.Error(t, err)
+}
+
+func TestQueryParams(t *testing.T) {
+	input := newTestInput(t)
+
+	var params types.Params
+
+	res, sdkErr := queryParams(input.ctx, input.tokenFactoryKeeper)
+	require.NoError(t, sdkErr)
+
+	err := input.cdc.UnmarshalJSON(res, &params)
+	require.NoError(t, err)
+
+	require.Equal(t, input.tokenFactoryKeeper.GetParams(input.ctx), params)
+}
+
+func TestQueryDenomAuthorityMetadata(t *testing.T) {
+	input := newTestInput(t)
+
+	denom, sdkErr := input.tokenFactoryKeeper.CreateDenom(input.ctx, addrs[0], "bitcoin")
+	require.NoError(t, sdkErr)
+
+	query := abci.RequestQuery{
+		Path: "",
+		Data: input.cdc.MustMarshalJSON(types.NewQueryDenomAuthorityMetadataParams(denom)),
+	}
+
+	res, sdkErr := queryDenomAuthorityMetadata(input.ctx, query, input.tokenFactoryKeeper)
+	require.NoError(t, sdkErr)
+
+	var metadata types.DenomAuthorityMetadata
+	err := input.cdc.UnmarshalJSON(res, &metadata)
+	require.NoError(t, err)
+	require.Equal(t, types.NewDenomAuthorityMetadata(addrs[0]), metadata)
+
+	// unknown denom
+	query.Data = input.cdc.MustMarshalJSON(types.NewQueryDenomAuthorityMetadataParams("factory/foo/bar"))
+	_, sdkErr = queryDenomAuthorityMetadata(input.ctx, query, input.tokenFactoryKeeper)
+	require.Error(t, sdkErr)
+}
+
+func TestQueryDenomsFromCreator(t *testing.T) {
+	input := newTestInput(t)
+
+	denomA, sdkErr := input.tokenFactoryKeeper.CreateDenom(input.ctx, addrs[0], "bitcoin")
+	require.NoError(t, sdkErr)
+	denomB, sdkErr := input.tokenFactoryKeeper.CreateDenom(input.ctx, addrs[0], "litecoin")
+	require.NoError(t, sdkErr)
+
+	query := abci.RequestQuery{
+		Path: "",
+		Data: input.cdc.MustMarshalJSON(types.NewQueryDenomsFromCreatorParams(addrs[0])),
+	}
+
+	res, sdkErr := queryDenomsFromCreator(input.ctx, query, input.tokenFactoryKeeper)
+	require.NoError(t, sdkErr)
+
+	var denoms types.QueryResDenoms
+	err := input.cdc.UnmarshalJSON(res, &denoms)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{denomA, denomB}, []string(denoms))
+
+	// creator without denoms
+	query.Data = input.cdc.MustMarshalJSON(types.NewQueryDenomsFromCreatorParams(addrs[1]))
+	res, sdkErr = queryDenomsFromCreator(input.ctx, query, input.tokenFactoryKeeper)
+	require.NoError(t, sdkErr)
+
+	denoms = nil
+	err = input.cdc.UnmarshalJSON(res, &denoms)
+	require.NoError(t, err)
+	require.Empty(t, denoms)
+}
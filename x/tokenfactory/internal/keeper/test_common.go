@@ -0,0 +1,109 @@
+// nolint:deadcode unused
+package keeper
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/internal/types"
+)
+
+var (
+	initCoins = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000000))
+
+	addrs = []sdk.AccAddress{
+		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+	}
+)
+
+type testInput struct {
+	ctx                sdk.Context
+	cdc                *codec.Codec
+	accountKeeper      auth.AccountKeeper
+	supplyKeeper       supply.Keeper
+	tokenFactoryKeeper Keeper
+}
+
+// create a codec used only for testing
+func makeTestCodec() *codec.Codec {
+	var cdc = codec.New()
+
+	bank.RegisterCodec(cdc)
+	auth.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	return cdc
+}
+
+func newTestInput(t *testing.T) testInput {
+	db := dbm.NewMemDB()
+
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	keyTokenFactory := sdk.NewKVStoreKey(types.StoreKey)
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyTokenFactory, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	err := ms.LoadLatestVersion()
+	require.Nil(t, err)
+
+	ctx := sdk.NewContext(ms, abci.Header{Time: time.Unix(0, 0)}, false, log.NewTMLogger(os.Stdout))
+	cdc := makeTestCodec()
+
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tkeyParams, params.DefaultCodespace)
+	accountKeeper := auth.NewAccountKeeper(cdc, keyAcc, paramsKeeper.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, paramsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace)
+	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bankKeeper, supply.DefaultCodespace,
+		[]string{auth.FeeCollectorName}, []string{types.ModuleName}, []string{types.BurnerAccountName})
+
+	tokenFactoryKeeper := NewKeeper(cdc, keyTokenFactory, paramsKeeper.Subspace(types.DefaultParamspace),
+		supplyKeeper, types.DefaultCodespace, auth.FeeCollectorName)
+
+	// set module accounts
+	feeCollectorAcc := supply.NewEmptyModuleAccount(auth.FeeCollectorName, supply.Basic)
+	minterAcc := supply.NewEmptyModuleAccount(types.ModuleName, supply.Minter)
+	burnerAcc := supply.NewEmptyModuleAccount(types.BurnerAccountName, supply.Burner)
+
+	supplyKeeper.SetModuleAccount(ctx, feeCollectorAcc)
+	supplyKeeper.SetModuleAccount(ctx, minterAcc)
+	supplyKeeper.SetModuleAccount(ctx, burnerAcc)
+
+	// fund the test accounts
+	totalSupply := sdk.NewCoins()
+	for _, addr := range addrs {
+		_, err := bankKeeper.AddCoins(ctx, addr, initCoins)
+		require.Nil(t, err)
+		totalSupply = totalSupply.Add(initCoins)
+	}
+	supplyKeeper.SetSupply(ctx, supply.NewSupply(totalSupply))
+
+	tokenFactoryKeeper.SetParams(ctx, types.DefaultParams())
+
+	return testInput{ctx, cdc, accountKeeper, supplyKeeper, tokenFactoryKeeper}
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DenomAuthorityMetadata holds the accounts with authority over a factory denom
+type DenomAuthorityMetadata struct {
+	Admin sdk.AccAddress `json:"admin" yaml:"admin"` // account allowed to mint, burn and change the admin
+}
+
+// NewDenomAuthorityMetadata creates a new DenomAuthorityMetadata instance
+func NewDenomAuthorityMetadata(admin sdk.AccAddress) DenomAuthorityMetadata {
+	return DenomAuthorityMetadata{
+		Admin: admin,
+	}
+}
+
+// Validate performs a basic validation of the authority metadata
+func (metadata DenomAuthorityMetadata) Validate() error {
+	if metadata.Admin.Empty() {
+		return fmt.Errorf("denom admin cannot be empty")
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (metadata DenomAuthorityMetadata) String() string {
+	return fmt.Sprintf("Admin: %s", metadata.Admin)
+}
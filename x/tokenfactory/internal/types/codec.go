@@ -0,0 +1,27 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// RegisterCodec registers concrete types on the codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgCreateDenom{}, "cosmos-sdk/MsgCreateDenom", nil)
+	cdc.RegisterConcrete(MsgMint{}, "cosmos-sdk/MsgTokenFactoryMint", nil)
+	cdc.RegisterConcrete(MsgBurn{}, "cosmos-sdk/MsgTokenFactoryBurn", nil)
+	cdc.RegisterConcrete(MsgChangeAdmin{}, "cosmos-sdk/MsgChangeAdmin", nil)
+}
+
+// ModuleCdc generic sealed codec to be used throughout module
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+
+	// governance can administer a denom through a MsgsProposal
+	govtypes.RegisterProposalMsgCodec(MsgChangeAdmin{}, "cosmos-sdk/MsgChangeAdmin")
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DenomPrefix is the prefix of every denom created through the token factory
+	DenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of a subdenom
+	MaxSubdenomLength = 44
+)
+
+var reSubdenom = regexp.MustCompile(`^[a-z0-9]+$`)
+
+// GetTokenDenom returns the namespaced denom of the form
+// factory/{creator}/{subdenom}. An error is returned if the subdenom or the
+// resulting denom is invalid.
+func GetTokenDenom(creator sdk.AccAddress, subdenom string) (string, error) {
+	if err := ValidateSubdenom(subdenom); err != nil {
+		return "", err
+	}
+
+	denom := strings.Join([]string{DenomPrefix, creator.String(), subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", err
+	}
+	return denom, nil
+}
+
+// DeconstructDenom splits a factory denom into its creator and subdenom,
+// returning an error if the denom wasn't created by the token factory.
+func DeconstructDenom(denom string) (creator sdk.AccAddress, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, "", err
+	}
+
+	parts := strings.Split(denom, "/")
+	if len(parts) != 3 || parts[0] != DenomPrefix {
+		return nil, "", fmt.Errorf("denom %s is not a token factory denom", denom)
+	}
+
+	creator, err = sdk.AccAddressFromBech32(parts[1])
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid creator address in denom %s: %s", denom, err)
+	}
+
+	if err := ValidateSubdenom(parts[2]); err != nil {
+		return nil, "", err
+	}
+	return creator, parts[2], nil
+}
+
+// ValidateSubdenom checks that a subdenom is non-empty, lowercase alphanumeric
+// and not longer than MaxSubdenomLength.
+func ValidateSubdenom(subdenom string) error {
+	if len(subdenom) > MaxSubdenomLength {
+		return fmt.Errorf("subdenom too long, max length is %d", MaxSubdenomLength)
+	}
+	if !reSubdenom.MatchString(subdenom) {
+		return fmt.Errorf("invalid subdenom: %s", subdenom)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGetTokenDenom(t *testing.T) {
+	creator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	tests := []struct {
+		subdenom string
+		expPass  bool
+	}{
+		{"bitcoin", true},
+		{"atom2", true},
+		{strings.Repeat("a", MaxSubdenomLength), true},
+		{strings.Repeat("a", MaxSubdenomLength+1), false},
+		{"", false},
+		{"Bitcoin", false},
+		{"bit/coin", false},
+		{"bit-coin", false},
+	}
+
+	for i, tc := range tests {
+		denom, err := GetTokenDenom(creator, tc.subdenom)
+		if !tc.expPass {
+			require.Error(t, err, "test: %d", i)
+			continue
+		}
+
+		require.NoError(t, err, "test: %d", i)
+		require.Equal(t, "factory/"+creator.String()+"/"+tc.subdenom, denom, "test: %d", i)
+
+		resCreator, resSubdenom, err := DeconstructDenom(denom)
+		require.NoError(t, err, "test: %d", i)
+		require.Equal(t, creator, resCreator, "test: %d", i)
+		require.Equal(t, tc.subdenom, resSubdenom, "test: %d", i)
+	}
+}
+
+func TestDeconstructDenom(t *testing.T) {
+	creator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	tests := []struct {
+		denom   string
+		expPass bool
+	}{
+		{"factory/" + creator.String() + "/bitcoin", true},
+		{"stake", false},
+		{"factory/" + creator.String(), false},
+		{"factory/" + creator.String() + "/bit/coin", false},
+		{"foundry/" + creator.String() + "/bitcoin", false},
+		{"factory/cosmos1invalid/bitcoin", false},
+	}
+
+	for i, tc := range tests {
+		_, _, err := DeconstructDenom(tc.denom)
+		if tc.expPass {
+			require.NoError(t, err, "test: %d", i)
+		} else {
+			require.Error(t, err, "test: %d", i)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Token factory errors reserve 100 ~ 199.
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeInvalidDenom  sdk.CodeType = 101
+	CodeDenomExists   sdk.CodeType = 102
+	CodeUnknownDenom  sdk.CodeType = 103
+	CodeUnauthorized  sdk.CodeType = 104
+	CodeInvalidAmount sdk.CodeType = 105
+)
+
+// ErrInvalidDenom is an error
+func ErrInvalidDenom(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDenom, msg)
+}
+
+// ErrDenomExists is an error
+func ErrDenomExists(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeDenomExists, fmt.Sprintf("denom %s already exists", denom))
+}
+
+// ErrUnknownDenom is an error
+func ErrUnknownDenom(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownDenom, fmt.Sprintf("denom %s was not created by the token factory", denom))
+}
+
+// ErrUnauthorized is an error
+func ErrUnauthorized(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnauthorized, fmt.Sprintf("sender is not the admin of denom %s", denom))
+}
+
+// ErrInvalidAmount is an error
+func ErrInvalidAmount(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidAmount, msg)
+}
@@ -0,0 +1,16 @@
+package types
+
+// Token factory module event types
+const (
+	EventTypeCreateDenom = "create_denom"
+	EventTypeMint        = "tf_mint"
+	EventTypeBurn        = "tf_burn"
+	EventTypeChangeAdmin = "change_admin"
+
+	AttributeKeyCreator  = "creator"
+	AttributeKeyDenom    = "denom"
+	AttributeKeyAmount   = "amount"
+	AttributeKeyNewAdmin = "new_admin"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisDenom defines a factory denom and its authority metadata in genesis
+type GenesisDenom struct {
+	Denom             string                 `json:"denom" yaml:"denom"`
+	AuthorityMetadata DenomAuthorityMetadata `json:"authority_metadata" yaml:"authority_metadata"`
+}
+
+// NewGenesisDenom creates a new GenesisDenom instance
+func NewGenesisDenom(denom string, metadata DenomAuthorityMetadata) GenesisDenom {
+	return GenesisDenom{
+		Denom:             denom,
+		AuthorityMetadata: metadata,
+	}
+}
+
+// GenesisState - token factory genesis state
+type GenesisState struct {
+	Params        Params         `json:"params" yaml:"params"`
+	FactoryDenoms []GenesisDenom `json:"factory_denoms" yaml:"factory_denoms"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, factoryDenoms []GenesisDenom) GenesisState {
+	return GenesisState{
+		Params:        params,
+		FactoryDenoms: factoryDenoms,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), []GenesisDenom{})
+}
+
+// ValidateGenesis performs basic validation of token factory genesis data
+// returning an error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	if err := ValidateParams(data.Params); err != nil {
+		return err
+	}
+
+	seenDenoms := make(map[string]bool)
+	for _, denom := range data.FactoryDenoms {
+		if seenDenoms[denom.Denom] {
+			return fmt.Errorf("duplicate factory denom %s", denom.Denom)
+		}
+		seenDenoms[denom.Denom] = true
+
+		if _, _, err := DeconstructDenom(denom.Denom); err != nil {
+			return err
+		}
+		if err := denom.AuthorityMetadata.Validate(); err != nil {
+			return fmt.Errorf("invalid authority metadata for denom %s: %s", denom.Denom, err)
+		}
+	}
+	return nil
+}
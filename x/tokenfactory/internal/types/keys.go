@@ -0,0 +1,55 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "tokenfactory"
+
+	// StoreKey is the store key string for tokenfactory
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for tokenfactory
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for tokenfactory
+	QuerierRoute = ModuleName
+
+	// DefaultParamspace is the default paramspace for the params keeper
+	DefaultParamspace = ModuleName
+
+	// BurnerAccountName is the name of the module account that factory tokens
+	// are sent to before being burned
+	BurnerAccountName = "tokenfactory_burner"
+)
+
+// Keys for tokenfactory store
+// Items are stored with the following key: values
+//
+// - 0x01<denom_Bytes>: DenomAuthorityMetadata
+//
+// - 0x02<creatorAddr_Bytes><denom_Bytes>: denom_Bytes
+var (
+	DenomAuthorityMetadataKeyPrefix = []byte{0x01}
+	CreatorDenomsKeyPrefix          = []byte{0x02}
+)
+
+// GetDenomAuthorityMetadataKey returns the store key to retrieve the authority
+// metadata of a factory denom
+func GetDenomAuthorityMetadataKey(denom string) []byte {
+	return append(DenomAuthorityMetadataKeyPrefix, []byte(denom)...)
+}
+
+// GetCreatorDenomsPrefix returns the store key prefix of all the denoms
+// created by an account
+func GetCreatorDenomsPrefix(creator sdk.AccAddress) []byte {
+	return append(CreatorDenomsKeyPrefix, creator.Bytes()...)
+}
+
+// GetCreatorDenomKey returns the store key of a denom in the index of denoms
+// created by an account
+func GetCreatorDenomKey(creator sdk.AccAddress, denom string) []byte {
+	return append(GetCreatorDenomsPrefix(creator), []byte(denom)...)
+}
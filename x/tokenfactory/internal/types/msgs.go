@@ -0,0 +1,198 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Token factory message types
+const (
+	TypeMsgCreateDenom = "create_denom"
+	TypeMsgMint        = "tf_mint"
+	TypeMsgBurn        = "tf_burn"
+	TypeMsgChangeAdmin = "change_admin"
+)
+
+// ensure Msg interface compliance at compile time
+var (
+	_ sdk.Msg = MsgCreateDenom{}
+	_ sdk.Msg = MsgMint{}
+	_ sdk.Msg = MsgBurn{}
+	_ sdk.Msg = MsgChangeAdmin{}
+)
+
+// MsgCreateDenom defines a message to create a new factory denom of the form
+// factory/{sender}/{subdenom}. The sender becomes the admin of the new denom.
+type MsgCreateDenom struct {
+	Sender   sdk.AccAddress `json:"sender" yaml:"sender"`
+	Subdenom string         `json:"subdenom" yaml:"subdenom"`
+}
+
+// NewMsgCreateDenom creates a new MsgCreateDenom instance
+func NewMsgCreateDenom(sender sdk.AccAddress, subdenom string) MsgCreateDenom {
+	return MsgCreateDenom{
+		Sender:   sender,
+		Subdenom: subdenom,
+	}
+}
+
+// Route implements Msg
+func (msg MsgCreateDenom) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgCreateDenom) Type() string { return TypeMsgCreateDenom }
+
+// ValidateBasic implements Msg
+func (msg MsgCreateDenom) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if _, err := GetTokenDenom(msg.Sender, msg.Subdenom); err != nil {
+		return ErrInvalidDenom(DefaultCodespace, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgCreateDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements Msg
+func (msg MsgCreateDenom) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgMint defines a message for the admin of a factory denom to mint new
+// tokens to its own account
+type MsgMint struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Amount sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+// NewMsgMint creates a new MsgMint instance
+func NewMsgMint(sender sdk.AccAddress, amount sdk.Coin) MsgMint {
+	return MsgMint{
+		Sender: sender,
+		Amount: amount,
+	}
+}
+
+// Route implements Msg
+func (msg MsgMint) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgMint) Type() string { return TypeMsgMint }
+
+// ValidateBasic implements Msg
+func (msg MsgMint) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	return validateFactoryCoin(msg.Amount)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgMint) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements Msg
+func (msg MsgMint) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgBurn defines a message for the admin of a factory denom to burn tokens
+// from its own account
+type MsgBurn struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Amount sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+// NewMsgBurn creates a new MsgBurn instance
+func NewMsgBurn(sender sdk.AccAddress, amount sdk.Coin) MsgBurn {
+	return MsgBurn{
+		Sender: sender,
+		Amount: amount,
+	}
+}
+
+// Route implements Msg
+func (msg MsgBurn) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgBurn) Type() string { return TypeMsgBurn }
+
+// ValidateBasic implements Msg
+func (msg MsgBurn) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	return validateFactoryCoin(msg.Amount)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgBurn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements Msg
+func (msg MsgBurn) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgChangeAdmin defines a message for the admin of a factory denom to
+// transfer its admin rights to another account
+type MsgChangeAdmin struct {
+	Sender   sdk.AccAddress `json:"sender" yaml:"sender"`
+	Denom    string         `json:"denom" yaml:"denom"`
+	NewAdmin sdk.AccAddress `json:"new_admin" yaml:"new_admin"`
+}
+
+// NewMsgChangeAdmin creates a new MsgChangeAdmin instance
+func NewMsgChangeAdmin(sender sdk.AccAddress, denom string, newAdmin sdk.AccAddress) MsgChangeAdmin {
+	return MsgChangeAdmin{
+		Sender:   sender,
+		Denom:    denom,
+		NewAdmin: newAdmin,
+	}
+}
+
+// Route implements Msg
+func (msg MsgChangeAdmin) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgChangeAdmin) Type() string { return TypeMsgChangeAdmin }
+
+// ValidateBasic implements Msg
+func (msg MsgChangeAdmin) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if msg.NewAdmin.Empty() {
+		return sdk.ErrInvalidAddress("missing new admin address")
+	}
+	if _, _, err := DeconstructDenom(msg.Denom); err != nil {
+		return ErrInvalidDenom(DefaultCodespace, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgChangeAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements Msg
+func (msg MsgChangeAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func validateFactoryCoin(coin sdk.Coin) sdk.Error {
+	if _, _, err := DeconstructDenom(coin.Denom); err != nil {
+		return ErrInvalidDenom(DefaultCodespace, err.Error())
+	}
+	if !coin.IsPositive() {
+		return ErrInvalidAmount(DefaultCodespace, "amount must be positive")
+	}
+	return nil
+}
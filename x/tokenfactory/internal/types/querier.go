@@ -0,0 +1,47 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// query endpoints supported by the token factory Querier
+const (
+	QueryParameters             = "parameters"
+	QueryDenomAuthorityMetadata = "denom_authority_metadata"
+	QueryDenomsFromCreator      = "denoms_from_creator"
+)
+
+// QueryDenomAuthorityMetadataParams defines the params for the following queries:
+//
+// - 'custom/tokenfactory/denom_authority_metadata'
+type QueryDenomAuthorityMetadataParams struct {
+	Denom string
+}
+
+// NewQueryDenomAuthorityMetadataParams creates a new instance of QueryDenomAuthorityMetadataParams
+func NewQueryDenomAuthorityMetadataParams(denom string) QueryDenomAuthorityMetadataParams {
+	return QueryDenomAuthorityMetadataParams{denom}
+}
+
+// QueryDenomsFromCreatorParams defines the params for the following queries:
+//
+// - 'custom/tokenfactory/denoms_from_creator'
+type QueryDenomsFromCreatorParams struct {
+	Creator sdk.AccAddress
+}
+
+// NewQueryDenomsFromCreatorParams creates a new instance of QueryDenomsFromCreatorParams
+func NewQueryDenomsFromCreatorParams(creator sdk.AccAddress) QueryDenomsFromCreatorParams {
+	return QueryDenomsFromCreatorParams{creator}
+}
+
+// QueryResDenoms defines the response of the 'custom/tokenfactory/denoms_from_creator'
+// query
+type QueryResDenoms []string
+
+// String implements fmt.Stringer
+func (d QueryResDenoms) String() string {
+	return strings.Join(d, "\n")
+}
@@ -0,0 +1,167 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+// SimulateMsgCreateDenom
+func SimulateMsgCreateDenom(k tokenfactory.Keeper) simulation.Operation {
+	handler := tokenfactory.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		account := simulation.RandomAcc(r, accs)
+		subdenom := randomSubdenom(r)
+		msg := tokenfactory.NewMsgCreateDenom(account.Address, subdenom)
+
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := handler(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgMint
+func SimulateMsgMint(k tokenfactory.Keeper) simulation.Operation {
+	handler := tokenfactory.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		account := simulation.RandomAcc(r, accs)
+		denom, found := randomAdminDenom(r, ctx, k, account.Address)
+		if !found {
+			return simulation.NoOpMsg(), nil, nil
+		}
+
+		amount := sdk.NewCoin(denom, sdk.NewInt(int64(simulation.RandIntBetween(r, 1, 1000000))))
+		msg := tokenfactory.NewMsgMint(account.Address, amount)
+
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := handler(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgBurn
+func SimulateMsgBurn(m auth.AccountKeeper, k tokenfactory.Keeper) simulation.Operation {
+	handler := tokenfactory.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		account := simulation.RandomAcc(r, accs)
+		denom, found := randomAdminDenom(r, ctx, k, account.Address)
+		if !found {
+			return simulation.NoOpMsg(), nil, nil
+		}
+
+		balance := m.GetAccount(ctx, account.Address).GetCoins().AmountOf(denom)
+		if !balance.IsPositive() {
+			return simulation.NoOpMsg(), nil, nil
+		}
+
+		amount := balance
+		if balance.GT(sdk.OneInt()) {
+			amount, err = simulation.RandPositiveInt(r, balance)
+			if err != nil {
+				return simulation.NoOpMsg(), nil, err
+			}
+		}
+
+		msg := tokenfactory.NewMsgBurn(account.Address, sdk.NewCoin(denom, amount))
+
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := handler(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgChangeAdmin
+func SimulateMsgChangeAdmin(k tokenfactory.Keeper) simulation.Operation {
+	handler := tokenfactory.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		account := simulation.RandomAcc(r, accs)
+		denom, found := randomAdminDenom(r, ctx, k, account.Address)
+		if !found {
+			return simulation.NoOpMsg(), nil, nil
+		}
+
+		newAdmin := simulation.RandomAcc(r, accs)
+		msg := tokenfactory.NewMsgChangeAdmin(account.Address, denom, newAdmin.Address)
+
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := handler(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// randomSubdenom returns a random valid subdenom
+func randomSubdenom(r *rand.Rand) string {
+	const letters = "abcdefghijklmnopqrstuvwxyz0123456789"
+	b := make([]byte, simulation.RandIntBetween(r, 1, 12))
+	for i := range b {
+		b[i] = letters[r.Intn(len(letters))]
+	}
+	return string(b)
+}
+
+// randomAdminDenom returns a random denom created by the given account of
+// which it is still the admin
+func randomAdminDenom(r *rand.Rand, ctx sdk.Context, k tokenfactory.Keeper, addr sdk.AccAddress) (string, bool) {
+	var denoms []string
+	for _, denom := range k.GetDenomsFromCreator(ctx, addr) {
+		metadata, found := k.GetAuthorityMetadata(ctx, denom)
+		if found && metadata.Admin.Equals(addr) {
+			denoms = append(denoms, denom)
+		}
+	}
+
+	if len(denoms) == 0 {
+		return "", false
+	}
+
+	return denoms[r.Intn(len(denoms))], true
+}